@@ -3,6 +3,7 @@ package goVPSie
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -10,6 +11,7 @@ var accessTokenBasePath = "/apps/v2/profile/security"
 
 type AccessTokenService interface {
 	List(ctx context.Context, options *ListOptions) ([]AccessToken, error)
+	All(ctx context.Context, opts ...IterOption) iter.Seq2[AccessToken, error]
 	Create(ctx context.Context, name, accessToken, expirationDate string) error
 	Delete(ctx context.Context, accessTokenIdentifier string) error
 	Update(ctx context.Context, accessTokenIdentifier, name, expirationDate string) error
@@ -22,10 +24,10 @@ type accessTokenServiceHandler struct {
 var _ AccessTokenService = &accessTokenServiceHandler{}
 
 type AccessToken struct {
-	AccessTokenIdentifier string `json:"identifier"`
-	Name                  string `json:"name"`
-	CreatedOn             string `json:"created_on"`
-	ExpirationDate        string `json:"expiration_date"`
+	AccessTokenIdentifier string    `json:"identifier"`
+	Name                  string    `json:"name"`
+	CreatedOn             Timestamp `json:"created_on"`
+	ExpirationDate        Timestamp `json:"expiration_date"`
 }
 
 type ListAccessTokensRoot struct {
@@ -34,7 +36,10 @@ type ListAccessTokensRoot struct {
 }
 
 func (s *accessTokenServiceHandler) List(ctx context.Context, options *ListOptions) ([]AccessToken, error) {
-	path := fmt.Sprintf("%s/access/token", accessTokenBasePath)
+	path, err := addOptions(fmt.Sprintf("%s/access/token", accessTokenBasePath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -46,9 +51,15 @@ func (s *accessTokenServiceHandler) List(ctx context.Context, options *ListOptio
 		return nil, err
 	}
 
+	options.setMeta(0, len(accessTokens.Data))
 	return accessTokens.Data, nil
 }
 
+// All iterates over every access token, fetching pages lazily.
+func (s *accessTokenServiceHandler) All(ctx context.Context, opts ...IterOption) iter.Seq2[AccessToken, error] {
+	return paginate(ctx, s.List, opts)
+}
+
 func (s *accessTokenServiceHandler) Create(ctx context.Context, name, accessToken, expirationDate string) error {
 	path := fmt.Sprintf("%s/access/token", accessTokenBasePath)
 
@@ -7,6 +7,7 @@ import (
 
 type AccountService interface {
 	Login(ctx context.Context, loginCredentials *LoginReq) (*Token, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*Token, error)
 }
 
 type accountServiceHandler struct {
@@ -20,14 +21,33 @@ type LoginReq struct {
 	ClientSecret string `json:"clientSecret"`
 }
 
+// Validate reports the missing credentials of r.
+func (r LoginReq) Validate() error {
+	var errs fieldErrors
+	errs.required("clientId", r.ClientID)
+	errs.required("clientSecret", r.ClientSecret)
+	return errs.err()
+}
+
+type RefreshTokenReq struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// Validate reports whether r carries a refresh token.
+func (r RefreshTokenReq) Validate() error {
+	var errs fieldErrors
+	errs.required("refreshToken", r.RefreshToken)
+	return errs.err()
+}
+
 type Token struct {
 	Access  TokenDetails `json:"access"`
 	Refresh TokenDetails `json:"refresh"`
 }
 
 type TokenDetails struct {
-	Token   string `json:"token"`
-	Expires string `json:"expires"`
+	Token   string    `json:"token"`
+	Expires Timestamp `json:"expires"`
 }
 
 type TokenRoot struct {
@@ -48,3 +68,17 @@ func (a *accountServiceHandler) Login(ctx context.Context, loginCredentials *Log
 
 	return &token.Token, nil
 }
+
+func (a *accountServiceHandler) RefreshToken(ctx context.Context, refreshToken string) (*Token, error) {
+	req, err := a.client.NewRequest(ctx, http.MethodPost, "/apps/v2/auth/refresh", &RefreshTokenReq{RefreshToken: refreshToken})
+	if err != nil {
+		return nil, err
+	}
+
+	token := new(TokenRoot)
+	if err = a.client.Do(ctx, req, token); err != nil {
+		return nil, err
+	}
+
+	return &token.Token, nil
+}
@@ -0,0 +1,190 @@
+package goVPSie
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// authHeader carries the access token on every API request.
+	authHeader = "Vpsie-Auth"
+
+	// tokenExpiryLeeway is how long before its expiry a token is renewed, so
+	// requests in flight never carry a token that expires on the way.
+	tokenExpiryLeeway = time.Minute
+)
+
+// LoginTokenSource logs in with API client credentials and keeps the access
+// token fresh. It renews the token shortly before it expires, preferring the
+// refresh token and falling back to a new login when the refresh token has
+// expired or is rejected. It implements oauth2.TokenSource and is safe for
+// concurrent use.
+type LoginTokenSource struct {
+	account     AccountService
+	credentials LoginReq
+
+	mu             sync.Mutex
+	token          *Token
+	accessExpires  time.Time
+	refreshExpires time.Time
+}
+
+var _ oauth2.TokenSource = &LoginTokenSource{}
+
+// NewLoginTokenSource returns a token source that authenticates through
+// account, usually the Account service of the client it is installed on.
+func NewLoginTokenSource(account AccountService, credentials *LoginReq) *LoginTokenSource {
+	return &LoginTokenSource{
+		account:     account,
+		credentials: *credentials,
+	}
+}
+
+// Token returns a valid access token, logging in or refreshing as needed.
+func (s *LoginTokenSource) Token() (*oauth2.Token, error) {
+	return s.TokenContext(context.Background())
+}
+
+// TokenContext is like Token but uses ctx for any login or refresh request.
+func (s *LoginTokenSource) TokenContext(ctx context.Context) (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.token == nil || !valid(s.accessExpires, now) {
+		if err := s.renew(withoutAuth(ctx), now); err != nil {
+			return nil, err
+		}
+	}
+
+	return &oauth2.Token{
+		AccessToken:  s.token.Access.Token,
+		RefreshToken: s.token.Refresh.Token,
+		Expiry:       s.accessExpires,
+	}, nil
+}
+
+// Invalidate drops accessToken, if it is still the current one, so that the
+// next call to Token renews it. The client calls it when the API answers 401;
+// concurrent requests rejected with the same token cause a single renewal.
+func (s *LoginTokenSource) Invalidate(accessToken string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && s.token.Access.Token == accessToken {
+		s.token.Access.Token = ""
+		s.accessExpires = time.Unix(0, 0)
+	}
+}
+
+func (s *LoginTokenSource) renew(ctx context.Context, now time.Time) error {
+	if s.token != nil && s.token.Refresh.Token != "" && valid(s.refreshExpires, now) {
+		token, err := s.account.RefreshToken(ctx, s.token.Refresh.Token)
+		if err == nil && token.Access.Token != "" {
+			s.setToken(token)
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+
+	token, err := s.account.Login(ctx, &s.credentials)
+	if err != nil {
+		return err
+	}
+	if token.Access.Token == "" {
+		return errors.New("login returned no access token")
+	}
+
+	s.setToken(token)
+	return nil
+}
+
+func (s *LoginTokenSource) setToken(token *Token) {
+	s.token = token
+	s.accessExpires = token.Access.Expires.Time
+	s.refreshExpires = token.Refresh.Expires.Time
+}
+
+// valid reports whether a token expiring at expires can still be used. A zero
+// expiry means the API did not report one; such a token is used until it is
+// rejected.
+func valid(expires, now time.Time) bool {
+	return expires.IsZero() || now.Add(tokenExpiryLeeway).Before(expires)
+}
+
+// SetTokenSource authenticates every request with a token from src, sent in
+// the Vpsie-Auth header. When the API answers 401 the token is invalidated,
+// if src supports it, and the request is retried once with a fresh token.
+func (c *Client) SetTokenSource(src oauth2.TokenSource) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.tokenSource = src
+}
+
+type authContextKey struct{}
+
+// withoutAuth marks ctx so that requests made with it are sent without a
+// token; login and refresh requests must not wait on the token they produce.
+func withoutAuth(ctx context.Context) context.Context {
+	return context.WithValue(ctx, authContextKey{}, false)
+}
+
+func authEnabled(ctx context.Context) bool {
+	enabled, ok := ctx.Value(authContextKey{}).(bool)
+	return !ok || enabled
+}
+
+// authorize returns req carrying a token from the client's token source.
+func (c *Client) authorize(req *http.Request) (*http.Request, error) {
+	c.mu.RLock()
+	src := c.tokenSource
+	c.mu.RUnlock()
+
+	if src == nil || !authEnabled(req.Context()) {
+		return req, nil
+	}
+
+	var (
+		token *oauth2.Token
+		err   error
+	)
+	if ctxSrc, ok := src.(interface {
+		TokenContext(context.Context) (*oauth2.Token, error)
+	}); ok {
+		token, err = ctxSrc.TokenContext(req.Context())
+	} else {
+		token, err = src.Token()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Set(authHeader, token.AccessToken)
+	return req, nil
+}
+
+// invalidateToken reports whether the token source could drop the token req
+// was sent with, in which case a request rejected with 401 is worth retrying.
+func (c *Client) invalidateToken(req *http.Request) bool {
+	if !authEnabled(req.Context()) {
+		return false
+	}
+
+	c.mu.RLock()
+	src, ok := c.tokenSource.(interface{ Invalidate(string) })
+	c.mu.RUnlock()
+
+	if ok {
+		src.Invalidate(req.Header.Get(authHeader))
+	}
+	return ok
+}
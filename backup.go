@@ -2,18 +2,24 @@ package goVPSie
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
+	"strconv"
 )
 
 var backupsPath = "/apps/v2"
 
 type BackupsService interface {
 	List(ctx context.Context, options *ListOptions) ([]Backup, error)
+	All(ctx context.Context, opts ...IterOption) iter.Seq2[Backup, error]
 	DeleteBackup(ctx context.Context, backupIdentifier, deleteReason, deleteNote string) error
 	CreateBackups(ctx context.Context, vmIdentifier, name, notes string) error
 	ListByServer(ctx context.Context, options *ListOptions, vmIdentifier string) ([]Backup, error)
+	AllByServer(ctx context.Context, vmIdentifier string, opts ...IterOption) iter.Seq2[Backup, error]
 	CreateServerByBackup(ctx context.Context, backupIdentifier string) error
+	CreateServerByBackupWithResult(ctx context.Context, backupIdentifier string) (*CreateResult, error)
 	Get(ctx context.Context, identifer string) (*Backup, error)
 	EnableAutoBackup(ctx context.Context, enableAutoReq *EnableAutoBackupReq) error
 	Rename(ctx context.Context, backupIdentifier string, newName string) error
@@ -24,6 +30,7 @@ type BackupsService interface {
 	AttachBackupPolicy(ctx context.Context, policyId string, vms []string) error
 	DetachBackupPolicy(ctx context.Context, policyId string, vms []string) error
 	ListBackupPolicies(ctx context.Context, options *ListOptions) ([]BackupPolicyListDetail, error)
+	BackupPoliciesAll(ctx context.Context, opts ...IterOption) iter.Seq2[BackupPolicyListDetail, error]
 }
 
 type EnableAutoBackupReq struct {
@@ -36,6 +43,16 @@ type EnableAutoBackupReq struct {
 	Tags          []string `json:"tags"`
 }
 
+// Validate reports the invalid fields of r.
+func (r EnableAutoBackupReq) Validate() error {
+	var errs fieldErrors
+	errs.required("vmIdentifier", r.VmIdentifier)
+	errs.flag("autoBackup", int64(r.AutoBackup))
+	errs.flag("weeklyBackup", int64(r.WeeklyBackup))
+	errs.flag("monthlyBackup", int64(r.MonthlyBackup))
+	return errs.err()
+}
+
 type backupsServiceHandler struct {
 	client *Client
 }
@@ -45,7 +62,7 @@ var _ BackupsService = &backupsServiceHandler{}
 type ListBackupsRoot struct {
 	Error bool     `json:"error"`
 	Data  []Backup `json:"data"`
-	Total int      `json:"total "`
+	Total int      `json:"total"`
 }
 
 type GetBackupsRoot struct {
@@ -56,24 +73,27 @@ type GetBackupsRoot struct {
 }
 
 type Backup struct {
-	HostName     string `json:"hostname"`
-	Name         string `json:"name"`
-	Identifier   string `json:"identifier"`
-	Note         string `json:"note"`
-	BackupKey    string `json:"backupKey"`
-	State        string `json:"state"`
-	DcIdentifier string `json:"dcIdentifier"`
-	VMIdentifier string `json:"vmIdentifier"`
-	BoxID        int    `json:"boxId"`
-	BackupSHA1   string `json:"backupsha1"`
-	OSFullName   string `json:"osFullName"`
-	VMCategory   string `json:"vmCategory"`
-	CreatedBy    string `json:"created_by"`
-	CreatedOn    string `json:"created_on"`
+	HostName     string    `json:"hostname"`
+	Name         string    `json:"name"`
+	Identifier   string    `json:"identifier"`
+	Note         string    `json:"note"`
+	BackupKey    string    `json:"backupKey"`
+	State        string    `json:"state"`
+	DcIdentifier string    `json:"dcIdentifier"`
+	VMIdentifier string    `json:"vmIdentifier"`
+	BoxID        int       `json:"boxId"`
+	BackupSHA1   string    `json:"backupsha1"`
+	OSFullName   string    `json:"osFullName"`
+	VMCategory   string    `json:"vmCategory"`
+	CreatedBy    string    `json:"created_by"`
+	CreatedOn    Timestamp `json:"created_on"`
 }
 
 func (b *backupsServiceHandler) List(ctx context.Context, options *ListOptions) ([]Backup, error) {
-	path := fmt.Sprintf("%s/backups", backupsPath)
+	path, err := addOptions(fmt.Sprintf("%s/backups", backupsPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := b.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -85,9 +105,15 @@ func (b *backupsServiceHandler) List(ctx context.Context, options *ListOptions)
 		return nil, err
 	}
 
+	options.setMeta(backups.Total, len(backups.Data))
 	return backups.Data, nil
 }
 
+// All iterates over every backup, fetching pages lazily.
+func (b *backupsServiceHandler) All(ctx context.Context, opts ...IterOption) iter.Seq2[Backup, error] {
+	return paginate(ctx, b.List, opts)
+}
+
 func (b *backupsServiceHandler) DeleteBackup(ctx context.Context, backupIdentifier, deleteReason, deleteNote string) error {
 	path := fmt.Sprintf("%s/backup", backupsPath)
 
@@ -138,7 +164,10 @@ func (b *backupsServiceHandler) CreateBackups(ctx context.Context, vmIdentifier,
 }
 
 func (b *backupsServiceHandler) ListByServer(ctx context.Context, options *ListOptions, serverId string) ([]Backup, error) {
-	path := fmt.Sprintf("%s/vm/backups/%s", backupsPath, serverId)
+	path, err := addOptions(fmt.Sprintf("%s/vm/backups/%s", backupsPath, serverId), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := b.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -150,10 +179,25 @@ func (b *backupsServiceHandler) ListByServer(ctx context.Context, options *ListO
 		return nil, err
 	}
 
+	options.setMeta(backups.Total, len(backups.Data))
 	return backups.Data, nil
 }
 
+// AllByServer iterates over every backup of the server, fetching pages lazily.
+func (b *backupsServiceHandler) AllByServer(ctx context.Context, vmIdentifier string, opts ...IterOption) iter.Seq2[Backup, error] {
+	return paginate(ctx, func(ctx context.Context, options *ListOptions) ([]Backup, error) {
+		return b.ListByServer(ctx, options, vmIdentifier)
+	}, opts)
+}
+
 func (b *backupsServiceHandler) CreateServerByBackup(ctx context.Context, backupIdentifier string) error {
+	_, err := b.CreateServerByBackupWithResult(ctx, backupIdentifier)
+	return err
+}
+
+// CreateServerByBackupWithResult is like CreateServerByBackup but also
+// returns the identifier or process ID of the new server.
+func (b *backupsServiceHandler) CreateServerByBackupWithResult(ctx context.Context, backupIdentifier string) (*CreateResult, error) {
 	path := fmt.Sprintf("%s/backups/create", backupsPath)
 
 	createServerReq := struct {
@@ -164,10 +208,15 @@ func (b *backupsServiceHandler) CreateServerByBackup(ctx context.Context, backup
 
 	req, err := b.client.NewRequest(ctx, http.MethodPost, path, &createServerReq)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return b.client.Do(ctx, req, nil)
+	var raw json.RawMessage
+	if err = b.client.Do(ctx, req, &raw); err != nil {
+		return nil, err
+	}
+
+	return newCreateResult(raw), nil
 }
 
 func (b *backupsServiceHandler) Get(ctx context.Context, identifer string) (*Backup, error) {
@@ -220,29 +269,29 @@ func (b *backupsServiceHandler) Rename(ctx context.Context, backupIdentifier str
 // backup policies apis
 
 type BackupPolicy struct {
-	Name       string   `json:"name"`
-	Identifier string   `json:"identifier"`
-	CreatedOn  string   `json:"created_on"`
-	CreatedBy  string   `json:"created_by"`
-	BackupPlan string   `json:"backupPlan"`
-	PlanEvery  int      `json:"planEvery"`
-	Keep       int      `json:"keep"`
-	Disabled   int      `json:"disabled"`
-	UserId     int      `json:"userId"`
-	Vms        []string `json:"vms"`
+	Name       string    `json:"name"`
+	Identifier string    `json:"identifier"`
+	CreatedOn  Timestamp `json:"created_on"`
+	CreatedBy  string    `json:"created_by"`
+	BackupPlan string    `json:"backupPlan"`
+	PlanEvery  int       `json:"planEvery"`
+	Keep       int       `json:"keep"`
+	Disabled   int       `json:"disabled"`
+	UserId     int       `json:"userId"`
+	Vms        []string  `json:"vms"`
 }
 
 type BackupPolicyListDetail struct {
-	Name       string `json:"name"`
-	Identifier string `json:"identifier"`
-	CreatedOn  string `json:"created_on"`
-	CreatedBy  string `json:"created_by"`
-	BackupPlan string `json:"backupPlan"`
-	PlanEvery  int    `json:"planEvery"`
-	Keep       int    `json:"keep"`
-	Disabled   int    `json:"disabled"`
-	VmsCount   int    `json:"vmsCount"`
-	UserId     int    `json:"userId"`
+	Name       string    `json:"name"`
+	Identifier string    `json:"identifier"`
+	CreatedOn  Timestamp `json:"created_on"`
+	CreatedBy  string    `json:"created_by"`
+	BackupPlan string    `json:"backupPlan"`
+	PlanEvery  int       `json:"planEvery"`
+	Keep       int       `json:"keep"`
+	Disabled   int       `json:"disabled"`
+	VmsCount   int       `json:"vmsCount"`
+	UserId     int       `json:"userId"`
 }
 type ListBackupPoliciesRoot struct {
 	Error bool `json:"error"`
@@ -265,8 +314,30 @@ type CreateBackupPolicyReq struct {
 	Tags       []string `json:"tags"`
 }
 
+// Validate reports the invalid fields of r.
+func (r CreateBackupPolicyReq) Validate() error {
+	var errs fieldErrors
+	validatePolicy(&errs, r.Name, r.BackupPlan, r.PlanEvery, r.Keep)
+	return errs.err()
+}
+
+// validatePolicy checks the fields shared by backup and snapshot policies.
+func validatePolicy(errs *fieldErrors, name, plan, every, keep string) {
+	errs.required("name", name)
+	errs.required("backupPlan", plan)
+	if every != "" {
+		n, err := strconv.Atoi(every)
+		errs.check(err == nil && n > 0, "planEvery", "must be a positive integer, got %q", every)
+	}
+	n, err := strconv.Atoi(keep)
+	errs.check(err == nil && n > 0, "keep", "must be a positive integer, got %q", keep)
+}
+
 func (b *backupsServiceHandler) ListBackupPolicies(ctx context.Context, options *ListOptions) ([]BackupPolicyListDetail, error) {
-	path := fmt.Sprintf("%s/backups/policy/all", backupsPath)
+	path, err := addOptions(fmt.Sprintf("%s/backups/policy/all", backupsPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := b.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -278,9 +349,15 @@ func (b *backupsServiceHandler) ListBackupPolicies(ctx context.Context, options
 		return nil, err
 	}
 
+	options.setMeta(0, len(policies.Data.Rows))
 	return policies.Data.Rows, nil
 }
 
+// BackupPoliciesAll iterates over every backup policy, fetching pages lazily.
+func (b *backupsServiceHandler) BackupPoliciesAll(ctx context.Context, opts ...IterOption) iter.Seq2[BackupPolicyListDetail, error] {
+	return paginate(ctx, b.ListBackupPolicies, opts)
+}
+
 func (b *backupsServiceHandler) GetBackupPolicy(ctx context.Context, identifier string) (*BackupPolicy, error) {
 	path := fmt.Sprintf("%s/backups/policy/%s", backupsPath, identifier)
 
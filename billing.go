@@ -3,18 +3,22 @@ package goVPSie
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
-	"time"
 )
 
 var billingPath = "/apps/v2/billing"
 
 type BillingService interface {
 	ListInvoices(ctx context.Context, options *ListOptions) ([]Invoice, error)
+	InvoicesAll(ctx context.Context, opts ...IterOption) iter.Seq2[Invoice, error]
 	ListPurchaseLog(ctx context.Context, options *ListOptions) ([]PurchaseLog, error)
+	PurchaseLogAll(ctx context.Context, opts ...IterOption) iter.Seq2[PurchaseLog, error]
 	ApplyVoucher(ctx context.Context, couponIdentifier string) error
 	ListAppliedVouchers(ctx context.Context, options *ListOptions) ([]AppliedVouchers, error)
+	AppliedVouchersAll(ctx context.Context, opts ...IterOption) iter.Seq2[AppliedVouchers, error]
 	ListEstimatedUsages(ctx context.Context, options *ListOptions) ([]EstimatedUsages, error)
+	EstimatedUsagesAll(ctx context.Context, opts ...IterOption) iter.Seq2[EstimatedUsages, error]
 }
 
 type billingServiceHandler struct {
@@ -26,43 +30,43 @@ var _ BillingService = &billingServiceHandler{}
 type ListInvoicesRoot struct {
 	Error bool      `json:"error"`
 	Data  []Invoice `json:"data"`
-	Total int       `json:"total "`
+	Total int       `json:"total"`
 }
 
 type Invoice struct {
 	ID                         int       `json:"id"`
 	UserID                     int       `json:"user_id"`
-	Date                       time.Time `json:"date"`
+	Date                       Timestamp `json:"date"`
 	SerialNumber               string    `json:"serial_number"`
-	Total                      string    `json:"total"`
+	Total                      Decimal   `json:"total"`
 	DiscountID                 *int      `json:"discount_id"`
 	TaxID                      *int      `json:"tax_id"`
-	DiscountValue              *float32  `json:"discount_value"`
-	TaxValue                   *float32  `json:"tax_value"`
-	TotalAfterDiscountAndTaxes *float32  `json:"total_after_discount_and_taxes"`
-	IsPaid                     int       `json:"is_paid"`
-	CreatedOn                  time.Time `json:"created_on"`
-	UpdatedAt                  time.Time `json:"updated_at"`
+	DiscountValue              Decimal   `json:"discount_value"`
+	TaxValue                   Decimal   `json:"tax_value"`
+	TotalAfterDiscountAndTaxes Decimal   `json:"total_after_discount_and_taxes"`
+	IsPaid                     Flag      `json:"is_paid"`
+	CreatedOn                  Timestamp `json:"created_on"`
+	UpdatedAt                  Timestamp `json:"updated_at"`
 	Month                      int       `json:"month"`
 	Year                       int       `json:"year"`
 	Identifier                 string    `json:"identifier"`
 	OldInvoice                 int       `json:"old_invoice"`
-	IsCustom                   int       `json:"is_custom"`
+	IsCustom                   Flag      `json:"is_custom"`
 	EndPeriod                  *string   `json:"end_period"`
 	CustomUserName             *string   `json:"custom_user_name"`
 	CustomBillAddress          *string   `json:"custom_bill_address"`
-	IsHidden                   int       `json:"is_hidden"`
-	TaxPercentage              *string   `json:"tax_percentage"`
-	DiscountPercentage         *string   `json:"discount_percentage"`
+	IsHidden                   Flag      `json:"is_hidden"`
+	TaxPercentage              Decimal   `json:"tax_percentage"`
+	DiscountPercentage         Decimal   `json:"discount_percentage"`
 	InvoiceStatus              int       `json:"invoice_status"`
 	Notes                      *string   `json:"notes"`
-	DueDate                    *string   `json:"due_date"`
+	DueDate                    Timestamp `json:"due_date"`
 	TransactionID              *int      `json:"transaction_id"`
-	PaidValue                  *string   `json:"paid_value"`
+	PaidValue                  Decimal   `json:"paid_value"`
 	PaymentMethod              *string   `json:"payment_method"`
 	CustomFooter               *string   `json:"custom_footer"`
 	BankDetails                int       `json:"bank_details"`
-	VatPercentage              string    `json:"vat_percentage"`
+	VatPercentage              Decimal   `json:"vat_percentage"`
 	Username                   string    `json:"username"`
 	TaxName                    *string   `json:"tax_name"`
 	TaxType                    *string   `json:"tax_type"`
@@ -70,35 +74,35 @@ type Invoice struct {
 	DiscountType               *string   `json:"discount_type"`
 	ResellerName               *string   `json:"reseller_name"`
 	ResellerID                 *string   `json:"reseller_id"`
-	IsUserHaveReseller         *int      `json:"is_user_have_reseller"`
-	StartingDate               string    `json:"startingDate"`
-	ClosingDate                string    `json:"closingDate"`
-	StartingBalance            float64   `json:"startingBalance"`
-	ClosingBalance             float64   `json:"closingBalance"`
+	IsUserHaveReseller         Flag      `json:"is_user_have_reseller"`
+	StartingDate               Timestamp `json:"startingDate"`
+	ClosingDate                Timestamp `json:"closingDate"`
+	StartingBalance            Decimal   `json:"startingBalance"`
+	ClosingBalance             Decimal   `json:"closingBalance"`
 }
 
 type PurchaseLog struct {
-	UserID        int         `json:"user_id"`
-	ID            int         `json:"id"`
-	Authority     string      `json:"authority"`
-	TransactionID string      `json:"transaction_id"`
-	VatPercentage interface{} `json:"vat_percentage"`
-	NetAmount     string      `json:"net_amount"`
-	VatValue      interface{} `json:"vat_value"`
-	TotalAmount   int         `json:"total_amount"`
-	Message       string      `json:"message"`
-	Amount        int         `json:"amount"`
-	CreatedOn     time.Time   `json:"created_on"`
-	LastFour      *string     `json:"last_four"`
-	CardType      *string     `json:"card_type"`
-	Firstname     string      `json:"firstname"`
-	Lastname      string      `json:"lastname"`
-	BillAddress   string      `json:"bill_address"`
-	BillCountry   string      `json:"bill_country"`
-	BillCity      string      `json:"bill_city"`
-	BillState     string      `json:"bill_state"`
-	BillZip       string      `json:"bill_zip"`
-	EntityName    *string     `json:"entity_name"`
+	UserID        int       `json:"user_id"`
+	ID            int       `json:"id"`
+	Authority     string    `json:"authority"`
+	TransactionID string    `json:"transaction_id"`
+	VatPercentage Decimal   `json:"vat_percentage"`
+	NetAmount     Decimal   `json:"net_amount"`
+	VatValue      Decimal   `json:"vat_value"`
+	TotalAmount   Decimal   `json:"total_amount"`
+	Message       string    `json:"message"`
+	Amount        Decimal   `json:"amount"`
+	CreatedOn     Timestamp `json:"created_on"`
+	LastFour      *string   `json:"last_four"`
+	CardType      *string   `json:"card_type"`
+	Firstname     string    `json:"firstname"`
+	Lastname      string    `json:"lastname"`
+	BillAddress   string    `json:"bill_address"`
+	BillCountry   string    `json:"bill_country"`
+	BillCity      string    `json:"bill_city"`
+	BillState     string    `json:"bill_state"`
+	BillZip       string    `json:"bill_zip"`
+	EntityName    *string   `json:"entity_name"`
 }
 
 type ListPurchaseLogRoot struct {
@@ -113,17 +117,17 @@ type EstimatedUsages struct {
 	EntityType     string    `json:"entity_type"`
 	EntityID       int       `json:"entity_id"`
 	UserID         int       `json:"user_id"`
-	StartDate      time.Time `json:"start_date"`
-	EndDate        *string   `json:"end_date"`
+	StartDate      Timestamp `json:"start_date"`
+	EndDate        Timestamp `json:"end_date"`
 	TypeOfTrigger  string    `json:"type_of_trigger"`
-	CreatedOn      time.Time `json:"created_on"`
-	UpdatedAt      time.Time `json:"updated_at"`
+	CreatedOn      Timestamp `json:"created_on"`
+	UpdatedAt      Timestamp `json:"updated_at"`
 	Identifier     string    `json:"identifier"`
 	Quantity       int       `json:"quantity"`
 	Unit           string    `json:"unit"`
-	Price          string    `json:"price"`
-	CostValue      string    `json:"cost_value"`
-	CostValueMonth string    `json:"cost_value_month"`
+	Price          Decimal   `json:"price"`
+	CostValue      Decimal   `json:"cost_value"`
+	CostValueMonth Decimal   `json:"cost_value_month"`
 	EntityName     string    `json:"entity_name"`
 	Description    string    `json:"description"`
 }
@@ -132,25 +136,25 @@ type ListEstimatedUsagesRoot struct {
 	Total       int               `json:"total"`
 	Data        []EstimatedUsages `json:"data"`
 	BalanceData struct {
-		CurrentBalance      float64     `json:"current_balance"`
-		BalanceCharged      int         `json:"balance_charged"`
+		CurrentBalance      Decimal     `json:"current_balance"`
+		BalanceCharged      Decimal     `json:"balance_charged"`
 		MonthlyCharge       string      `json:"monthly_charge"`
 		ActualMonthlyCharge string      `json:"actual_monthly_charge"`
 		AddedWithCc         int         `json:"added_with_cc"`
 		AddedWithCcOrPp     int         `json:"added_with_cc_or_pp"`
 		BillCity            interface{} `json:"bill_city"`
 		BillCountry         interface{} `json:"bill_country"`
-		IsPostPaid          int         `json:"is_post_paid"`
+		IsPostPaid          Flag        `json:"is_post_paid"`
 	} `json:"balanceData"`
 }
 
 type AppliedVouchers struct {
-	ID               int     `json:"id"`
-	UserID           int     `json:"user_id"`
-	CouponId         int     `json:"coupon_id"`
-	CouponIdentifier string  `json:"coupon_identifier"`
-	Value            float32 `json:"value"`
-	Expires          string  `json:"expires"`
+	ID               int       `json:"id"`
+	UserID           int       `json:"user_id"`
+	CouponId         int       `json:"coupon_id"`
+	CouponIdentifier string    `json:"coupon_identifier"`
+	Value            Decimal   `json:"value"`
+	Expires          Timestamp `json:"expires"`
 }
 
 type ListAppliedVouchersRoot struct {
@@ -160,7 +164,10 @@ type ListAppliedVouchersRoot struct {
 }
 
 func (s *billingServiceHandler) ListInvoices(ctx context.Context, options *ListOptions) ([]Invoice, error) {
-	path := fmt.Sprintf("%s/invoices", billingPath)
+	path, err := addOptions(fmt.Sprintf("%s/invoices", billingPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -172,11 +179,20 @@ func (s *billingServiceHandler) ListInvoices(ctx context.Context, options *ListO
 		return nil, err
 	}
 
+	options.setMeta(root.Total, len(root.Data))
 	return root.Data, nil
 }
 
+// InvoicesAll iterates over every invoice, fetching pages lazily.
+func (s *billingServiceHandler) InvoicesAll(ctx context.Context, opts ...IterOption) iter.Seq2[Invoice, error] {
+	return paginate(ctx, s.ListInvoices, opts)
+}
+
 func (s *billingServiceHandler) ListPurchaseLog(ctx context.Context, options *ListOptions) ([]PurchaseLog, error) {
-	path := fmt.Sprintf("%s/purchase/logs", billingPath)
+	path, err := addOptions(fmt.Sprintf("%s/purchase/logs", billingPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -188,11 +204,20 @@ func (s *billingServiceHandler) ListPurchaseLog(ctx context.Context, options *Li
 		return nil, err
 	}
 
+	options.setMeta(root.Total, len(root.Data))
 	return root.Data, nil
 }
 
+// PurchaseLogAll iterates over every purchase log entry, fetching pages lazily.
+func (s *billingServiceHandler) PurchaseLogAll(ctx context.Context, opts ...IterOption) iter.Seq2[PurchaseLog, error] {
+	return paginate(ctx, s.ListPurchaseLog, opts)
+}
+
 func (s *billingServiceHandler) ListEstimatedUsages(ctx context.Context, options *ListOptions) ([]EstimatedUsages, error) {
-	path := fmt.Sprintf("%s/estimated/usages", billingPath)
+	path, err := addOptions(fmt.Sprintf("%s/estimated/usages", billingPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -204,11 +229,20 @@ func (s *billingServiceHandler) ListEstimatedUsages(ctx context.Context, options
 		return nil, err
 	}
 
+	options.setMeta(root.Total, len(root.Data))
 	return root.Data, nil
 }
 
+// EstimatedUsagesAll iterates over every estimated usage, fetching pages lazily.
+func (s *billingServiceHandler) EstimatedUsagesAll(ctx context.Context, opts ...IterOption) iter.Seq2[EstimatedUsages, error] {
+	return paginate(ctx, s.ListEstimatedUsages, opts)
+}
+
 func (s *billingServiceHandler) ListAppliedVouchers(ctx context.Context, options *ListOptions) ([]AppliedVouchers, error) {
-	path := fmt.Sprintf("%s/coupons", billingPath)
+	path, err := addOptions(fmt.Sprintf("%s/coupons", billingPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -220,9 +254,15 @@ func (s *billingServiceHandler) ListAppliedVouchers(ctx context.Context, options
 		return nil, err
 	}
 
+	options.setMeta(root.Total, len(root.Data))
 	return root.Data, nil
 }
 
+// AppliedVouchersAll iterates over every applied voucher, fetching pages lazily.
+func (s *billingServiceHandler) AppliedVouchersAll(ctx context.Context, opts ...IterOption) iter.Seq2[AppliedVouchers, error] {
+	return paginate(ctx, s.ListAppliedVouchers, opts)
+}
+
 func (s *billingServiceHandler) ApplyVoucher(ctx context.Context, couponIdentifier string) error {
 	path := fmt.Sprintf("%s/coupon/add", billingPath)
 
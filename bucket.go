@@ -3,7 +3,11 @@ package goVPSie
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
+	"net/netip"
+	"strings"
+	"time"
 )
 
 var bucketsPath = "/apps/v2/buckets"
@@ -11,6 +15,7 @@ var bucketPath = "/apps/v2/bucket"
 
 type BucketService interface {
 	List(ctx context.Context, options *ListOptions) ([]Bucket, error)
+	All(ctx context.Context, opts ...IterOption) iter.Seq2[Bucket, error]
 	Get(ctx context.Context, id string) (*Bucket, error)
 	Create(ctx context.Context, createReq *CreateBucketReq) error
 	Delete(ctx context.Context, bucketId, reason, note string) error
@@ -29,7 +34,7 @@ var _ BucketService = &bucketServiceHandler{}
 type ListBucketRoot struct {
 	Error bool     `json:"error"`
 	Data  []Bucket `json:"data"`
-	Total int      `json:"total "`
+	Total int      `json:"total"`
 }
 
 type GetBucketRoot struct {
@@ -45,6 +50,22 @@ type CreateBucketReq struct {
 	Tags         []string `json:"tags"`
 }
 
+// Validate reports the invalid fields of r. Bucket names follow the S3 rules:
+// 3 to 63 lowercase letters, digits, dots and hyphens, not formatted as an
+// IP address.
+func (r CreateBucketReq) Validate() error {
+	var errs fieldErrors
+	errs.check(isBucketName(r.BucketName), "bucketName", "must be 3 to 63 lowercase letters, digits, dots and hyphens, got %q", r.BucketName)
+	errs.required("projectId", r.ProjectId)
+	errs.required("datacenterId", r.DataCenterId)
+	return errs.err()
+}
+
+func isBucketName(s string) bool {
+	_, err := netip.ParseAddr(s)
+	return len(s) >= 3 && len(s) <= 63 && strings.ToLower(s) == s && isHostname(s) && err != nil
+}
+
 type FileListingStatusRoot struct {
 	Error bool `json:"error"`
 	Data  struct {
@@ -53,26 +74,26 @@ type FileListingStatusRoot struct {
 }
 
 type Bucket struct {
-	ID          int    `json:"id"`
-	UserId      int    `json:"user_id"`
-	AccessKey   string `json:"accessKey"`
-	SecretKey   string `json:"secretKey"`
-	BucketName  string `json:"bucketName"`
-	ProjectName string `json:"projectName"`
-	CreatedBy   string `json:"created_by"`
-	EndPoint    string `json:"endPoint"`
-	CreatedOn   string `json:"created_on"`
-	Identifier  string `json:"identifier"`
-	State       string `json:"state"`
-	Country     string `json:"country"`
+	ID          int       `json:"id"`
+	UserId      int       `json:"user_id"`
+	AccessKey   string    `json:"accessKey"`
+	SecretKey   string    `json:"secretKey"`
+	BucketName  string    `json:"bucketName"`
+	ProjectName string    `json:"projectName"`
+	CreatedBy   string    `json:"created_by"`
+	EndPoint    string    `json:"endPoint"`
+	CreatedOn   Timestamp `json:"created_on"`
+	Identifier  string    `json:"identifier"`
+	State       string    `json:"state"`
+	Country     string    `json:"country"`
 }
 
 type BucketKey struct {
-	AccessKey  string `json:"accessKey"`
-	SecretKey  string `json:"secretKey"`
-	KeyName    string `json:"key_name"`
-	CreatedON  string `json:"created_on"`
-	Identifier string `json:"identifier"`
+	AccessKey  string    `json:"accessKey"`
+	SecretKey  string    `json:"secretKey"`
+	KeyName    string    `json:"key_name"`
+	CreatedON  Timestamp `json:"created_on"`
+	Identifier string    `json:"identifier"`
 }
 
 type ListBucketKeysRoot struct {
@@ -81,7 +102,10 @@ type ListBucketKeysRoot struct {
 }
 
 func (s *bucketServiceHandler) List(ctx context.Context, options *ListOptions) ([]Bucket, error) {
-	path := fmt.Sprintf("%s", bucketsPath)
+	path, err := addOptions(bucketsPath, options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -93,9 +117,15 @@ func (s *bucketServiceHandler) List(ctx context.Context, options *ListOptions) (
 		return nil, err
 	}
 
+	options.setMeta(root.Total, len(root.Data))
 	return root.Data, nil
 }
 
+// All iterates over every bucket, fetching pages lazily.
+func (s *bucketServiceHandler) All(ctx context.Context, opts ...IterOption) iter.Seq2[Bucket, error] {
+	return paginate(ctx, s.List, opts)
+}
+
 func (s *bucketServiceHandler) Get(ctx context.Context, id string) (*Bucket, error) {
 	path := fmt.Sprintf("%s", bucketPath)
 
@@ -122,14 +152,31 @@ func (s *bucketServiceHandler) Get(ctx context.Context, id string) (*Bucket, err
 func (s *bucketServiceHandler) Create(ctx context.Context, createReq *CreateBucketReq) error {
 	path := fmt.Sprintf("%s/create", bucketPath)
 
+	createReq = withDefaultProject(s.client, createReq, func(r *CreateBucketReq) *string { return &r.ProjectId })
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, createReq)
 	if err != nil {
 		return err
 	}
+	ctx = s.client.idempotent(ctx, req, s.existingBucket(createReq))
 
 	return s.client.Do(ctx, req, nil)
 }
 
+// existingBucket finds a bucket named like createReq.
+func (s *bucketServiceHandler) existingBucket(createReq *CreateBucketReq) existingFunc {
+	return func(ctx context.Context, since time.Time) (string, error) {
+		for bucket, err := range s.All(ctx) {
+			if err != nil {
+				return "", err
+			}
+			if bucket.BucketName == createReq.BucketName && createdSince(bucket.CreatedOn, since) {
+				return bucket.Identifier, nil
+			}
+		}
+		return "", nil
+	}
+}
+
 func (s *bucketServiceHandler) Delete(ctx context.Context, buckId, reason, note string) error {
 	path := fmt.Sprintf("%s/delete", bucketPath)
 
@@ -0,0 +1,175 @@
+package goVPSie
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const defaultBulkConcurrency = 8
+
+// ErrSkipped is the error of the items Bulk did not start because an earlier
+// one failed with StopOnError set.
+var ErrSkipped = errors.New("skipped after an earlier failure")
+
+// BulkOptions configures Client.Bulk and the Many methods of the services.
+// The zero value runs eight operations at a time and continues past errors.
+type BulkOptions struct {
+	// Concurrency bounds the operations running at once. Defaults to 8.
+	Concurrency int
+
+	// StopOnError stops starting new operations after the first failure. The
+	// operations already running finish; the rest fail with ErrSkipped.
+	StopOnError bool
+
+	// OnResult, if set, is called as each operation finishes. Calls are not
+	// concurrent.
+	OnResult func(BulkResult)
+}
+
+// BulkResult is the outcome of the operation on one identifier.
+type BulkResult struct {
+	Identifier string
+	Err        error
+	Duration   time.Duration
+}
+
+// BulkError is returned by Bulk when any operation failed or was skipped.
+type BulkError struct {
+	// Failed holds the results with an error, in the order of the
+	// identifiers.
+	Failed []BulkResult
+
+	// Total is the number of identifiers.
+	Total int
+}
+
+// maxBulkErrorItems bounds how many failures BulkError.Error lists.
+const maxBulkErrorItems = 3
+
+func (e *BulkError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d of %d operations failed", len(e.Failed), e.Total)
+	for i, r := range e.Failed {
+		if i == maxBulkErrorItems {
+			fmt.Fprintf(&b, "; and %d more", len(e.Failed)-i)
+			break
+		}
+		fmt.Fprintf(&b, "; %s: %v", r.Identifier, r.Err)
+	}
+	return b.String()
+}
+
+// Unwrap returns the errors of the failed operations, so that errors.Is and
+// errors.As look through them.
+func (e *BulkError) Unwrap() []error {
+	errs := make([]error, len(e.Failed))
+	for i, r := range e.Failed {
+		errs[i] = r.Err
+	}
+	return errs
+}
+
+// Bulk runs op for every identifier, opts.Concurrency at a time, and returns
+// one result per identifier in the same order. If any operation failed, the
+// error is a *BulkError listing them. Once ctx is done, no operation starts
+// and the identifiers left fail with ctx.Err().
+//
+// Every request still goes through the client's rate limiter and retry
+// policy. In addition, Bulk holds off starting operations while the API
+// reports that no requests remain in the current rate-limit window.
+func (c *Client) Bulk(ctx context.Context, identifiers []string, op func(ctx context.Context, identifier string) error, opts *BulkOptions) ([]BulkResult, error) {
+	var o BulkOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = defaultBulkConcurrency
+	}
+
+	results := make([]BulkResult, len(identifiers))
+	for i, identifier := range identifiers {
+		results[i] = BulkResult{Identifier: identifier, Err: ErrSkipped}
+	}
+
+	var (
+		wg       sync.WaitGroup
+		resultMu sync.Mutex
+		failed   atomic.Bool
+		slots    = make(chan struct{}, o.Concurrency)
+	)
+
+	finish := func(i int, err error, start time.Time) {
+		results[i].Err = err
+		results[i].Duration = time.Since(start)
+		if err != nil {
+			failed.Store(true)
+		}
+		if o.OnResult != nil {
+			resultMu.Lock()
+			o.OnResult(results[i])
+			resultMu.Unlock()
+		}
+	}
+
+	// skip fails the identifiers from i on, which were not started, with err.
+	skip := func(i int, err error) {
+		for j := i; j < len(identifiers); j++ {
+			results[j].Err = err
+		}
+	}
+
+loop:
+	for i, identifier := range identifiers {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			skip(i, ctx.Err())
+			break loop
+		}
+		if o.StopOnError && failed.Load() {
+			<-slots
+			break
+		}
+		if err := c.waitForRateWindow(ctx); err != nil {
+			<-slots
+			skip(i, err)
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			start := time.Now()
+			finish(i, op(ctx, identifier), start)
+		}()
+	}
+	wg.Wait()
+
+	bulkErr := &BulkError{Total: len(identifiers)}
+	for _, r := range results {
+		if r.Err != nil {
+			bulkErr.Failed = append(bulkErr.Failed, r)
+		}
+	}
+	if len(bulkErr.Failed) > 0 {
+		return results, bulkErr
+	}
+	return results, nil
+}
+
+// waitForRateWindow waits for the rate-limit window to reset if the API
+// reported that no requests remain in it.
+func (c *Client) waitForRateWindow(ctx context.Context) error {
+	rate := c.Rate()
+	if rate.Observed.IsZero() || rate.Remaining > 0 {
+		return ctx.Err()
+	}
+	return sleepContext(ctx, time.Until(rate.Reset))
+}
@@ -0,0 +1,305 @@
+package goVPSie
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultCacheEntries = 1024
+
+// CacheOptions configures the response cache. See Client.SetCache.
+type CacheOptions struct {
+	// TTLs maps URL path prefixes, such as "/apps/v2/datacenter", to how long
+	// responses to reads under them stay fresh. The longest matching prefix
+	// wins. Endpoints without a TTL are not cached.
+	TTLs map[string]time.Duration
+
+	// MaxEntries bounds the number of cached responses. The least recently
+	// stored ones are evicted first. Defaults to 1024.
+	MaxEntries int
+}
+
+// DefaultCacheTTLs returns TTLs for the catalog endpoints that rarely change:
+// data centers, load balancer offers and data centers, storage data centers
+// and firewall macros.
+func DefaultCacheTTLs() map[string]time.Duration {
+	return map[string]time.Duration{
+		dataCenterBasePath:                      10 * time.Minute,
+		lbPath + "/offers":                      10 * time.Minute,
+		lbPath + "/datacenter":                  10 * time.Minute,
+		storageBasePath + "/storage/datacenter": 10 * time.Minute,
+		firewallBasePath + "/macros":            10 * time.Minute,
+	}
+}
+
+// SetCache enables the response cache, or disables and empties it if opts is
+// nil. Cached responses to reads are served without a request while fresh.
+// Reads are GET requests and the few POST requests the API answers without
+// changing anything, such as LB.ListOffers and Bucket.Get, which are cached
+// per request body. Once stale, a response that came with an ETag is
+// revalidated with If-None-Match and kept if the API answers 304 Not
+// Modified.
+//
+// Any other successful request drops every cached response of the same
+// resource family, the first path segment after the API version with any
+// plural "s" removed, so that creating a storage under /apps/v2/storages
+// invalidates /apps/v2/storage/datacenter.
+func (c *Client) SetCache(opts *CacheOptions) {
+	var cache *responseCache
+	if opts != nil {
+		cache = newResponseCache(*opts)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cache = cache
+}
+
+// InvalidateCache drops the cached responses whose URL path starts with
+// prefix, or every cached response if prefix is empty.
+func (c *Client) InvalidateCache(prefix string) {
+	c.mu.RLock()
+	cache := c.cache
+	c.mu.RUnlock()
+
+	if cache != nil {
+		cache.invalidate(func(e *cacheEntry) bool { return prefix == "" || hasPathPrefix(e.path, prefix) })
+	}
+}
+
+// readOnlyPosts lists the endpoints read with a POST request, because the
+// API takes their parameters in the body.
+var readOnlyPosts = map[string]bool{
+	lbPath + "/offers": true,
+	bucketPath:         true,
+}
+
+// isRead reports whether req only reads, so that it neither invalidates the
+// cache nor is captured by a dry run.
+func isRead(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+		return true
+	case http.MethodPost:
+		return readOnlyPosts[req.URL.Path]
+	}
+	return false
+}
+
+// cacheKey returns the key of the response to req, and false if req is not a
+// read that can be cached. Reads made with a POST include their body.
+func cacheKey(req *http.Request) (string, bool) {
+	switch {
+	case req.Method == http.MethodGet:
+		return req.URL.String(), true
+	case req.Method != http.MethodPost || !readOnlyPosts[req.URL.Path] || req.GetBody == nil:
+		return "", false
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return "", false
+	}
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return "", false
+	}
+	return http.MethodPost + " " + req.URL.String() + " " + string(data), true
+}
+
+type cacheEntry struct {
+	path    string
+	family  string
+	body    []byte
+	etag    string
+	stored  time.Time
+	expires time.Time
+}
+
+type responseCache struct {
+	ttls       map[string]time.Duration
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+func newResponseCache(opts CacheOptions) *responseCache {
+	ttls := make(map[string]time.Duration, len(opts.TTLs))
+	for prefix, ttl := range opts.TTLs {
+		if ttl > 0 {
+			ttls["/"+strings.Trim(prefix, "/")] = ttl
+		}
+	}
+
+	maxEntries := opts.MaxEntries
+	if maxEntries <= 0 {
+		maxEntries = defaultCacheEntries
+	}
+
+	return &responseCache{ttls: ttls, maxEntries: maxEntries, entries: make(map[string]*cacheEntry)}
+}
+
+// ttl returns how long responses for path stay fresh, or zero if they are not
+// cached.
+func (rc *responseCache) ttl(path string) time.Duration {
+	var ttl time.Duration
+	longest := -1
+	for prefix, d := range rc.ttls {
+		if len(prefix) > longest && hasPathPrefix(path, prefix) {
+			ttl, longest = d, len(prefix)
+		}
+	}
+	return ttl
+}
+
+// lookup returns the fresh cached body for req. For a stale entry with an
+// ETag it returns a copy of req asking the API to revalidate it.
+func (rc *responseCache) lookup(req *http.Request) ([]byte, *http.Request, bool) {
+	key, ok := cacheKey(req)
+	if !ok || rc.ttl(req.URL.Path) == 0 {
+		return nil, req, false
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	entry, ok := rc.entries[key]
+	switch {
+	case !ok:
+		return nil, req, false
+	case time.Now().Before(entry.expires):
+		return entry.body, req, true
+	case entry.etag == "":
+		delete(rc.entries, key)
+		return nil, req, false
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Set("If-None-Match", entry.etag)
+	return nil, req, false
+}
+
+// cacheable reports whether the response to req should be stored.
+func (rc *responseCache) cacheable(req *http.Request) bool {
+	_, ok := cacheKey(req)
+	return ok && rc.ttl(req.URL.Path) > 0
+}
+
+// store caches the successful response to a cacheable request.
+func (rc *responseCache) store(req *http.Request, body []byte, header http.Header) {
+	ttl := rc.ttl(req.URL.Path)
+	key, ok := cacheKey(req)
+	if ttl == 0 || !ok || !json.Valid(body) {
+		return
+	}
+
+	now := time.Now()
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if _, ok := rc.entries[key]; !ok && len(rc.entries) >= rc.maxEntries {
+		rc.evictOldest()
+	}
+	rc.entries[key] = &cacheEntry{
+		path:    req.URL.Path,
+		family:  resourceFamily(req.URL.Path),
+		body:    body,
+		etag:    header.Get("ETag"),
+		stored:  now,
+		expires: now.Add(ttl),
+	}
+}
+
+// revalidated returns the cached body if err is the 304 answer to a
+// revalidation of req, and marks it fresh again.
+func (rc *responseCache) revalidated(req *http.Request, err error) ([]byte, bool) {
+	apiErr, ok := asAPIError(err)
+	if !ok || apiErr.StatusCode != http.StatusNotModified {
+		return nil, false
+	}
+	key, ok := cacheKey(req)
+	if !ok {
+		return nil, false
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	entry, ok := rc.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry.expires = time.Now().Add(rc.ttl(req.URL.Path))
+	return entry.body, true
+}
+
+// mutated invalidates the resource family of a successful request that is
+// not a read.
+func (rc *responseCache) mutated(req *http.Request) {
+	if isRead(req) {
+		return
+	}
+
+	family := resourceFamily(req.URL.Path)
+	rc.invalidate(func(e *cacheEntry) bool { return e.family == family })
+}
+
+func (rc *responseCache) invalidate(match func(*cacheEntry) bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	for key, entry := range rc.entries {
+		if match(entry) {
+			delete(rc.entries, key)
+		}
+	}
+}
+
+// evictOldest drops the least recently stored entry. Callers must hold rc.mu.
+func (rc *responseCache) evictOldest() {
+	var oldest string
+	for key, entry := range rc.entries {
+		if oldest == "" || entry.stored.Before(rc.entries[oldest].stored) {
+			oldest = key
+		}
+	}
+	delete(rc.entries, oldest)
+}
+
+// resourceFamily returns the first segment of path after the API prefix and
+// version, in singular form: "/apps/v2/storages/vm/attach" gives "storage".
+func resourceFamily(path string) string {
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" || segment == "api" || segment == "apps" || isVersion(segment) {
+			continue
+		}
+		return strings.TrimSuffix(strings.ToLower(segment), "s")
+	}
+	return ""
+}
+
+func isVersion(segment string) bool {
+	if len(segment) < 2 || segment[0] != 'v' {
+		return false
+	}
+	for _, r := range segment[1:] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// hasPathPrefix reports whether path is prefix or lies below it.
+func hasPathPrefix(path, prefix string) bool {
+	prefix = "/" + strings.Trim(prefix, "/")
+	return prefix == "/" || path == prefix || strings.HasPrefix(path, prefix+"/")
+}
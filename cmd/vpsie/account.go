@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"strconv"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+)
+
+var invoiceColumns = []column[goVPSie.Invoice]{
+	{"IDENTIFIER", func(i goVPSie.Invoice) string { return i.Identifier }},
+	{"NUMBER", func(i goVPSie.Invoice) string { return i.SerialNumber }},
+	{"DATE", func(i goVPSie.Invoice) string { return timestamp(i.Date) }},
+	{"TOTAL", func(i goVPSie.Invoice) string { return i.TotalAfterDiscountAndTaxes.String() }},
+	{"PAID", func(i goVPSie.Invoice) string { return strconv.FormatBool(i.IsPaid.Bool()) }},
+	{"DUE", func(i goVPSie.Invoice) string { return timestamp(i.DueDate) }},
+}
+
+var usageColumns = []column[goVPSie.EstimatedUsages]{
+	{"ENTITY", func(u goVPSie.EstimatedUsages) string { return u.EntityName }},
+	{"TYPE", func(u goVPSie.EstimatedUsages) string { return u.EntityType }},
+	{"QUANTITY", func(u goVPSie.EstimatedUsages) string { return strconv.Itoa(u.Quantity) + " " + u.Unit }},
+	{"PRICE", func(u goVPSie.EstimatedUsages) string { return u.Price.String() }},
+	{"COST", func(u goVPSie.EstimatedUsages) string { return u.CostValue.String() }},
+	{"MONTHLY", func(u goVPSie.EstimatedUsages) string { return u.CostValueMonth.String() }},
+	{"SINCE", func(u goVPSie.EstimatedUsages) string { return timestamp(u.StartDate) }},
+}
+
+var activityColumns = []column[goVPSie.ActivityLog]{
+	{"TIME", func(l goVPSie.ActivityLog) string { return timestamp(l.CreatedOn) }},
+	{"BY", func(l goVPSie.ActivityLog) string { return l.CreatedBy }},
+	{"DESCRIPTION", func(l goVPSie.ActivityLog) string { return l.Description }},
+}
+
+var auditColumns = []column[goVPSie.AuditLog]{
+	{"TIME", func(l goVPSie.AuditLog) string { return timestamp(l.CreatedOn) }},
+	{"ACTION", func(l goVPSie.AuditLog) string { return l.Action }},
+	{"IP", func(l goVPSie.AuditLog) string { return l.IPAddress }},
+	{"COUNTRY", func(l goVPSie.AuditLog) string { return l.Country }},
+	{"STATE", func(l goVPSie.AuditLog) string { return l.State }},
+	{"DESCRIPTION", func(l goVPSie.AuditLog) string { return l.Description }},
+}
+
+func billingCommands() *command {
+	return &command{
+		name:    "billing",
+		summary: "Show invoices and usage",
+		sub: []*command{
+			{name: "invoices", summary: "List the invoices", run: listCommand(func(ctx context.Context, client *goVPSie.Client) ([]goVPSie.Invoice, error) {
+				return collect(client.Billing.InvoicesAll(ctx))
+			}, invoiceColumns)},
+			{name: "usage", summary: "List the estimated usage of the current period", run: listCommand(func(ctx context.Context, client *goVPSie.Client) ([]goVPSie.EstimatedUsages, error) {
+				return collect(client.Billing.EstimatedUsagesAll(ctx))
+			}, usageColumns)},
+		},
+	}
+}
+
+func logsCommands() *command {
+	return &command{
+		name:    "logs",
+		summary: "Show account logs",
+		sub: []*command{
+			{name: "activity", summary: "List the activity log", run: listCommand(func(ctx context.Context, client *goVPSie.Client) ([]goVPSie.ActivityLog, error) {
+				return collect(client.Logs.ActivityLogsAll(ctx))
+			}, activityColumns)},
+			{name: "audit", summary: "List the audit log", run: listCommand(func(ctx context.Context, client *goVPSie.Client) ([]goVPSie.AuditLog, error) {
+				return collect(client.Logs.AuditLogsAll(ctx))
+			}, auditColumns)},
+		},
+	}
+}
+
+// listCommand returns a command without arguments that prints what list
+// returns.
+func listCommand[T any](list func(context.Context, *goVPSie.Client) ([]T, error), cols []column[T]) func(*cli, string, []string) error {
+	return func(c *cli, name string, args []string) error {
+		if _, err := parse(c.flags(name, ""), args, 0, 0); err != nil {
+			return err
+		}
+
+		client, err := c.api()
+		if err != nil {
+			return err
+		}
+		items, err := list(c.ctx, client)
+		if err != nil {
+			return err
+		}
+		return printList(c, items, cols)
+	}
+}
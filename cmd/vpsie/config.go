@@ -0,0 +1,176 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+)
+
+// profileKeys maps the keys of "vpsie config set" to the profile fields.
+var profileKeys = map[string]func(*goVPSie.ConfigProfile) *string{
+	"token":         func(p *goVPSie.ConfigProfile) *string { return &p.Token },
+	"client_id":     func(p *goVPSie.ConfigProfile) *string { return &p.ClientID },
+	"client_secret": func(p *goVPSie.ConfigProfile) *string { return &p.ClientSecret },
+	"base_url":      func(p *goVPSie.ConfigProfile) *string { return &p.BaseURL },
+	"project":       func(p *goVPSie.ConfigProfile) *string { return &p.Project },
+}
+
+// loadConfig reads the config file. A missing file is an empty config.
+func (c *cli) loadConfig() error {
+	if c.config != nil {
+		return nil
+	}
+
+	if c.configPath == "" {
+		path, err := goVPSie.DefaultConfigPath()
+		if err != nil {
+			return err
+		}
+		c.configPath = path
+	}
+
+	config, err := goVPSie.ReadConfig(c.configPath)
+	if err != nil {
+		return err
+	}
+	c.config = config
+	return nil
+}
+
+// loadProfile resolves the selected profile, with the environment
+// overriding its settings.
+func (c *cli) loadProfile() error {
+	if c.profile != nil {
+		return nil
+	}
+	if err := c.loadConfig(); err != nil {
+		return err
+	}
+
+	p, err := c.config.Resolve(c.profileName)
+	if err != nil {
+		return fmt.Errorf("%s: %w", c.configPath, err)
+	}
+	c.profile = p
+	return nil
+}
+
+func configCommands() *command {
+	return &command{
+		name:    "config",
+		summary: "Manage profiles in the config file",
+		sub: []*command{
+			{name: "set", args: "<key> <value>", summary: "Set token, client_id, client_secret, base_url or project in the profile", run: configSet},
+			{name: "show", summary: "Show the profile, with secrets masked", run: configShow},
+			{name: "use", args: "<profile>", summary: "Make a profile the current one", run: configUse},
+			{name: "profiles", summary: "List the profiles", run: configProfiles},
+		},
+	}
+}
+
+func configSet(c *cli, name string, args []string) error {
+	args, err := parse(c.flags(name, "<key> <value>"), args, 2, 2)
+	if err != nil {
+		return err
+	}
+
+	field, ok := profileKeys[args[0]]
+	if !ok {
+		return usagef("%s: unknown key %q; use one of %s", name, args[0], strings.Join(sortedProfileKeys(), ", "))
+	}
+	if err := c.loadConfig(); err != nil {
+		return err
+	}
+
+	p, _ := c.config.Profile(c.profileName)
+	*field(p) = args[1]
+	if c.config.Profiles == nil {
+		c.config.Profiles = make(map[string]*goVPSie.ConfigProfile)
+	}
+	c.config.Profiles[p.Name] = p
+	if c.config.CurrentProfile == "" {
+		c.config.CurrentProfile = p.Name
+	}
+
+	return c.config.WriteFile(c.configPath)
+}
+
+func configShow(c *cli, name string, args []string) error {
+	if _, err := parse(c.flags(name, ""), args, 0, 0); err != nil {
+		return err
+	}
+	if err := c.loadProfile(); err != nil {
+		return err
+	}
+
+	p := *c.profile
+	p.Token = mask(p.Token)
+	p.ClientSecret = mask(p.ClientSecret)
+
+	return printItem(c, p, []column[goVPSie.ConfigProfile]{
+		{"PROFILE", func(p goVPSie.ConfigProfile) string { return p.Name }},
+		{"TOKEN", func(p goVPSie.ConfigProfile) string { return p.Token }},
+		{"CLIENT ID", func(p goVPSie.ConfigProfile) string { return p.ClientID }},
+		{"BASE URL", func(p goVPSie.ConfigProfile) string { return p.BaseURL }},
+		{"PROJECT", func(p goVPSie.ConfigProfile) string { return p.Project }},
+	})
+}
+
+func configUse(c *cli, name string, args []string) error {
+	args, err := parse(c.flags(name, "<profile>"), args, 1, 1)
+	if err != nil {
+		return err
+	}
+	if err := c.loadConfig(); err != nil {
+		return err
+	}
+	if _, ok := c.config.Profiles[args[0]]; !ok {
+		return fmt.Errorf("profile %q not found in %s", args[0], c.configPath)
+	}
+	c.config.CurrentProfile = args[0]
+	return c.config.WriteFile(c.configPath)
+}
+
+func configProfiles(c *cli, name string, args []string) error {
+	if _, err := parse(c.flags(name, ""), args, 0, 0); err != nil {
+		return err
+	}
+	if err := c.loadConfig(); err != nil {
+		return err
+	}
+
+	current, _ := c.config.Profile(c.profileName)
+	names := make([]string, 0, len(c.config.Profiles))
+	for n := range c.config.Profiles {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	for _, n := range names {
+		marker := " "
+		if n == current.Name {
+			marker = "*"
+		}
+		fmt.Fprintf(c.stdout, "%s %s\n", marker, n)
+	}
+	return nil
+}
+
+// mask hides all but the last four characters of a secret.
+func mask(secret string) string {
+	if len(secret) <= 4 {
+		return strings.Repeat("*", len(secret))
+	}
+	return strings.Repeat("*", 8) + secret[len(secret)-4:]
+}
+
+func sortedProfileKeys() []string {
+	keys := make([]string, 0, len(profileKeys))
+	for k := range profileKeys {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
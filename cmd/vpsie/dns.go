@@ -0,0 +1,140 @@
+package main
+
+import (
+	"strconv"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+)
+
+var domainColumns = []column[goVPSie.Domain]{
+	{"IDENTIFIER", func(d goVPSie.Domain) string { return d.Identifier }},
+	{"DOMAIN", func(d goVPSie.Domain) string { return d.DomainName }},
+	{"NS VALIDATED", func(d goVPSie.Domain) string { return strconv.FormatBool(d.NsValidated == 1) }},
+	{"CREATED", func(d goVPSie.Domain) string { return timestamp(d.CreatedOn) }},
+}
+
+func dnsCommands() *command {
+	return &command{
+		name:    "dns",
+		summary: "Manage domains and DNS records",
+		sub: []*command{
+			{name: "list", summary: "List the domains of the project, or all domains with --all", run: dnsList},
+			{name: "create", args: "<domain>", summary: "Add a domain", run: dnsCreate},
+			{name: "delete", args: "<domain-identifier>", summary: "Delete a domain", run: dnsDelete},
+			{
+				name:    "record",
+				summary: "Manage the records of a domain",
+				sub: []*command{
+					{name: "add", args: "<domain-identifier>", summary: "Add a record", run: dnsRecord(true)},
+					{name: "delete", args: "<domain-identifier>", summary: "Delete a record", run: dnsRecord(false)},
+				},
+			},
+		},
+	}
+}
+
+func dnsList(c *cli, name string, args []string) error {
+	fs := c.flags(name, "")
+	project := fs.String("project", "", "project identifier (default: the profile's project)")
+	all := fs.Bool("all", false, "list the domains of every project")
+	if _, err := parse(fs, args, 0, 0); err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	projectID, err := c.project(*project)
+	if err != nil {
+		return err
+	}
+
+	var domains []goVPSie.Domain
+	if *all || projectID == "" {
+		domains, err = collect(client.Domain.DomainsAll(c.ctx))
+	} else {
+		domains, err = collect(client.Domain.DomainsByProjectAll(c.ctx, projectID))
+	}
+	if err != nil {
+		return err
+	}
+	return printList(c, domains, domainColumns)
+}
+
+func dnsCreate(c *cli, name string, args []string) error {
+	fs := c.flags(name, "<domain>")
+	project := fs.String("project", "", "project identifier (default: the profile's project)")
+	tags := fs.String("tags", "", "comma separated tags")
+	args, err := parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	projectID, err := c.project(*project)
+	if err != nil {
+		return err
+	}
+	if err := client.Domain.CreateDomain(c.ctx, &goVPSie.CreateDomainRequest{
+		ProjectIdentifier: projectID,
+		Domain:            args[0],
+		Tags:              splitList(*tags),
+	}); err != nil {
+		return err
+	}
+	return c.printDone("Added domain %s", args[0])
+}
+
+func dnsDelete(c *cli, name string, args []string) error {
+	fs := c.flags(name, "<domain-identifier>")
+	reason, note := deleteFlags(fs)
+	args, err := parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if err := client.Domain.DeleteDomain(c.ctx, args[0], *reason, *note); err != nil {
+		return err
+	}
+	return c.printDone("Deleted domain %s", args[0])
+}
+
+// dnsRecord returns the command adding a record to a domain, or deleting it
+// if add is false.
+func dnsRecord(add bool) func(*cli, string, []string) error {
+	return func(c *cli, name string, args []string) error {
+		fs := c.flags(name, "<domain-identifier>")
+		recordName := fs.String("name", "", "name of the record (required)")
+		recordType := fs.String("type", "A", "record type")
+		content := fs.String("content", "", "content of the record (required)")
+		ttl := fs.Int("ttl", 3600, "time to live in seconds")
+		args, err := parse(fs, args, 1, 1)
+		if err != nil {
+			return err
+		}
+
+		client, err := c.api()
+		if err != nil {
+			return err
+		}
+		record := goVPSie.Record{Name: *recordName, Type: *recordType, Content: *content, TTL: *ttl}
+		if !add {
+			if err := client.Domain.DeleteDnsRecord(c.ctx, args[0], &record); err != nil {
+				return err
+			}
+			return c.printDone("Deleted %s record %s", record.Type, record.Name)
+		}
+		if err := client.Domain.CreateDnsRecord(c.ctx, goVPSie.CreateDnsRecordReq{DomainIdentifier: args[0], Record: record}); err != nil {
+			return err
+		}
+		return c.printDone("Added %s record %s", record.Type, record.Name)
+	}
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+)
+
+var firewallColumns = []column[goVPSie.FirewallGroupListData]{
+	{"IDENTIFIER", func(g goVPSie.FirewallGroupListData) string { return g.Identifier }},
+	{"NAME", func(g goVPSie.FirewallGroupListData) string { return g.GroupName }},
+	{"INBOUND", func(g goVPSie.FirewallGroupListData) string { return strconv.FormatInt(g.InboundCount, 10) }},
+	{"OUTBOUND", func(g goVPSie.FirewallGroupListData) string { return strconv.FormatInt(g.OutboundCount, 10) }},
+	{"SERVERS", func(g goVPSie.FirewallGroupListData) string { return strconv.FormatInt(g.Vms, 10) }},
+	{"CREATED", func(g goVPSie.FirewallGroupListData) string { return timestamp(g.CreatedOn) }},
+}
+
+var firewallRuleColumns = []column[goVPSie.FirewallRule]{
+	{"TYPE", func(r goVPSie.FirewallRule) string { return r.Type }},
+	{"ACTION", func(r goVPSie.FirewallRule) string { return r.Action }},
+	{"PROTO", func(r goVPSie.FirewallRule) string { return r.Proto }},
+	{"SOURCE", func(r goVPSie.FirewallRule) string { return strings.Join(r.Source, ",") }},
+	{"SPORT", func(r goVPSie.FirewallRule) string { return r.Sport }},
+	{"DEST", func(r goVPSie.FirewallRule) string { return strings.Join(r.Dest, ",") }},
+	{"DPORT", func(r goVPSie.FirewallRule) string { return r.Dport }},
+	{"MACRO", func(r goVPSie.FirewallRule) string { return r.Macro }},
+	{"ENABLED", func(r goVPSie.FirewallRule) string { return strconv.FormatBool(r.Enable.Bool()) }},
+	{"COMMENT", func(r goVPSie.FirewallRule) string { return r.Comment }},
+}
+
+func firewallCommands() *command {
+	return &command{
+		name:    "firewall",
+		summary: "Manage firewall groups",
+		sub: []*command{
+			{name: "list", summary: "List the firewall groups", run: listCommand(func(ctx context.Context, client *goVPSie.Client) ([]goVPSie.FirewallGroupListData, error) {
+				return collect(client.FirewallGroup.All(ctx))
+			}, firewallColumns)},
+			{name: "get", args: "<group>", summary: "Show a firewall group and its rules", run: firewallGet},
+			{name: "delete", args: "<group>", summary: "Delete a firewall group", run: firewallDelete},
+			{name: "attach", args: "<group> <server>", summary: "Attach a firewall group to a server", run: firewallAttach},
+			{name: "detach", args: "<group> <server>", summary: "Detach a firewall group from a server", run: firewallDetach},
+		},
+	}
+}
+
+func firewallGet(c *cli, name string, args []string) error {
+	args, err := parse(c.flags(name, "<group>"), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	group, err := client.FirewallGroup.Get(c.ctx, args[0])
+	if err != nil {
+		return err
+	}
+	if c.output != "table" {
+		return c.encode(group)
+	}
+
+	if err := printItem(c, *group, []column[goVPSie.FirewallGroupDetailData]{
+		{"IDENTIFIER", func(g goVPSie.FirewallGroupDetailData) string { return g.Group.Identifier }},
+		{"NAME", func(g goVPSie.FirewallGroupDetailData) string { return g.Group.GroupName }},
+		{"SERVERS", func(g goVPSie.FirewallGroupDetailData) string { return strconv.Itoa(len(g.Vms)) }},
+		{"CREATED", func(g goVPSie.FirewallGroupDetailData) string { return timestamp(g.Group.CreatedOn) }},
+	}); err != nil {
+		return err
+	}
+	fmt.Fprintln(c.stdout)
+	return printList(c, group.Rules, firewallRuleColumns)
+}
+
+func firewallDelete(c *cli, name string, args []string) error {
+	args, err := parse(c.flags(name, "<group>"), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if err := client.FirewallGroup.Delete(c.ctx, args[0]); err != nil {
+		return err
+	}
+	return c.printDone("Deleted firewall group %s", args[0])
+}
+
+func firewallAttach(c *cli, name string, args []string) error {
+	args, err := parse(c.flags(name, "<group> <server>"), args, 2, 2)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if err := client.FirewallGroup.AttachToVpsie(c.ctx, args[0], args[1]); err != nil {
+		return err
+	}
+	return c.printDone("Attached firewall group %s to %s", args[0], args[1])
+}
+
+func firewallDetach(c *cli, name string, args []string) error {
+	args, err := parse(c.flags(name, "<group> <server>"), args, 2, 2)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if err := client.FirewallGroup.DetachFromVpsie(c.ctx, args[0], args[1]); err != nil {
+		return err
+	}
+	return c.printDone("Detached firewall group %s from %s", args[0], args[1])
+}
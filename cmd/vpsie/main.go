@@ -0,0 +1,284 @@
+// Command vpsie manages VPSie resources from the command line.
+//
+// Usage:
+//
+//	vpsie [global flags] <group> <command> [flags] [arguments]
+//
+// The groups mirror the services of the goVPSie package: server, firewall,
+// storage, snapshot, dns, lb, k8s, bucket, billing, logs and project. Run
+// "vpsie help" for the full list of commands, and "vpsie <group> <command>
+// -h" for the flags of one.
+//
+// Credentials, the API endpoint and the default project are read from a
+// profile in ~/.config/vpsie/config.yaml, which "vpsie config set" edits.
+// The VPSIE_* environment variables override the profile; see
+// goVPSie.LoadConfig for the precedence.
+// Output is a table by default; -o json and -o yaml print the full objects.
+// Commands that start asynchronous work accept --wait to block until it is
+// done.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+)
+
+// version is reported in the User-Agent and by "vpsie version".
+const version = "0.1.0"
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "vpsie:", err)
+		}
+		os.Exit(exitCode(err))
+	}
+}
+
+// exitCode is 2 for usage errors and 1 for everything else.
+func exitCode(err error) int {
+	var usage *usageError
+	if errors.As(err, &usage) || errors.Is(err, flag.ErrHelp) {
+		return 2
+	}
+	return 1
+}
+
+// usageError reports a command line that could not be understood.
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+func usagef(format string, args ...any) error {
+	return &usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+// cli is the state shared by the commands of one invocation.
+type cli struct {
+	ctx    context.Context
+	stdout io.Writer
+	stderr io.Writer
+
+	configPath  string
+	profileName string
+	output      string
+
+	config  *goVPSie.Config
+	profile *goVPSie.ConfigProfile
+	client  *goVPSie.Client
+}
+
+// command is a node of the command tree: either a group with subcommands or a
+// leaf with a run function.
+type command struct {
+	name    string
+	args    string
+	summary string
+	run     func(c *cli, name string, args []string) error
+	sub     []*command
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	c := &cli{
+		ctx:    ctx,
+		stdout: stdout,
+		stderr: stderr,
+	}
+
+	fs := flag.NewFlagSet("vpsie", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&c.configPath, "config", "", "path of the config file (default $VPSIE_CONFIG or ~/.config/vpsie/config.yaml)")
+	fs.StringVar(&c.profileName, "profile", "", "profile to use (default $VPSIE_PROFILE or the config's current profile)")
+	fs.StringVar(&c.output, "o", "table", "output format: table, json or yaml")
+	fs.StringVar(&c.output, "output", "table", "output format: table, json or yaml")
+	fs.Usage = func() { c.usage(commands(), "vpsie") }
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	return c.dispatch(commands(), "vpsie", fs.Args())
+}
+
+// dispatch finds the command named by args in cmds and runs it.
+func (c *cli) dispatch(cmds []*command, path string, args []string) error {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		c.usage(cmds, path)
+		if len(args) == 0 {
+			return usagef("%s: missing command", path)
+		}
+		return nil
+	}
+
+	for _, cmd := range cmds {
+		if cmd.name != args[0] {
+			continue
+		}
+		name := path + " " + cmd.name
+		if cmd.sub != nil {
+			return c.dispatch(cmd.sub, name, args[1:])
+		}
+		return cmd.run(c, name, args[1:])
+	}
+
+	return usagef("%s: unknown command %q; run \"%s help\"", path, args[0], path)
+}
+
+func (c *cli) usage(cmds []*command, path string) {
+	fmt.Fprintf(c.stderr, "Usage: %s <command> [flags] [arguments]\n\nCommands:\n", path)
+	tw := tabwriter.NewWriter(c.stderr, 0, 4, 2, ' ', 0)
+	for _, cmd := range cmds {
+		usage := cmd.name
+		if cmd.sub != nil {
+			usage += " <command>"
+		} else if cmd.args != "" {
+			usage += " " + cmd.args
+		}
+		fmt.Fprintf(tw, "  %s\t%s\n", usage, cmd.summary)
+	}
+	tw.Flush()
+
+	if path == "vpsie" {
+		fmt.Fprint(c.stderr, "\nGlobal flags:\n  --config path\n  --profile name\n  -o, --output table|json|yaml\n")
+	}
+}
+
+// flags returns the flag set of the leaf command name. Every command accepts
+// -o so that the output format can follow the command.
+func (c *cli) flags(name, args string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	fs.StringVar(&c.output, "o", c.output, "output format: table, json or yaml")
+	fs.StringVar(&c.output, "output", c.output, "output format: table, json or yaml")
+	fs.Usage = func() {
+		fmt.Fprintf(c.stderr, "Usage: %s [flags] %s\n\nFlags:\n", name, args)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parse parses args with fs, allowing flags after the positional arguments,
+// and checks that there are between min and max positional arguments; a
+// negative max means no limit.
+func parse(fs *flag.FlagSet, args []string, min, max int) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+
+	switch {
+	case len(positional) < min:
+		return nil, usagef("%s: missing arguments", fs.Name())
+	case max >= 0 && len(positional) > max:
+		return nil, usagef("%s: too many arguments", fs.Name())
+	}
+	return positional, nil
+}
+
+// api returns the client for the selected profile, loading the config on
+// first use.
+func (c *cli) api() (*goVPSie.Client, error) {
+	if c.client != nil {
+		return c.client, nil
+	}
+
+	if err := c.loadProfile(); err != nil {
+		return nil, err
+	}
+	client, err := newClient(c.profile)
+	if err != nil {
+		return nil, err
+	}
+	c.client = client
+	return client, nil
+}
+
+// project returns the project given by flag, or the profile's default.
+func (c *cli) project(flagValue string) (string, error) {
+	if flagValue != "" {
+		return flagValue, nil
+	}
+	if err := c.loadProfile(); err != nil {
+		return "", err
+	}
+	return c.profile.Project, nil
+}
+
+// newClient builds a client authenticated as profile p.
+func newClient(p *goVPSie.ConfigProfile) (*goVPSie.Client, error) {
+	client, err := goVPSie.NewClientFromProfile(p,
+		goVPSie.WithUserAgentSuffix("vpsie-cli/"+version),
+		goVPSie.WithRetryPolicy(goVPSie.DefaultRetryPolicy()),
+		goVPSie.WithValidation(),
+	)
+	if errors.Is(err, goVPSie.ErrNoCredentials) {
+		return nil, fmt.Errorf("%w with \"vpsie config set\" or the VPSIE_TOKEN environment variable", err)
+	}
+	return client, err
+}
+
+// waitFlags registers --wait and --timeout on fs.
+func waitFlags(fs *flag.FlagSet) (wait *bool, timeout *time.Duration) {
+	wait = fs.Bool("wait", false, "wait for the operation to finish")
+	timeout = fs.Duration("timeout", 10*time.Minute, "how long --wait waits")
+	return wait, timeout
+}
+
+// waitOptions reports the progress of a wait on stderr.
+func (c *cli) waitOptions(timeout time.Duration, what string) *goVPSie.WaitOptions {
+	fmt.Fprintf(c.stderr, "Waiting for %s...\n", what)
+	return &goVPSie.WaitOptions{
+		PollInterval: 2 * time.Second,
+		Multiplier:   1.5,
+		MaxInterval:  15 * time.Second,
+		Timeout:      timeout,
+	}
+}
+
+func commands() []*command {
+	cmds := []*command{
+		serverCommands(),
+		firewallCommands(),
+		storageCommands(),
+		snapshotCommands(),
+		dnsCommands(),
+		lbCommands(),
+		k8sCommands(),
+		bucketCommands(),
+		billingCommands(),
+		logsCommands(),
+		projectCommands(),
+		configCommands(),
+		{name: "version", summary: "Print the version", run: func(c *cli, name string, args []string) error {
+			fmt.Fprintf(c.stdout, "vpsie %s (%s)\n", version, strings.TrimSpace(goVPSie.NewClient(nil).GetUserAgent()))
+			return nil
+		}},
+	}
+	sort.SliceStable(cmds, func(i, j int) bool { return cmds[i].name < cmds[j].name })
+	return cmds
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	govpsie "github.com/ahmedabdelkader99/goVPSie"
+	"github.com/ahmedabdelkader99/goVPSie/govpsietest"
+	"gopkg.in/yaml.v3"
+)
+
+// vpsie runs the command line args against config and returns its output.
+func vpsie(t *testing.T, config string, args ...string) (string, error) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	err := run(context.Background(), append([]string{"--config", config}, args...), &stdout, &stderr)
+	return stdout.String(), err
+}
+
+// writeConfig writes a config file whose default profile points at fake.
+func writeConfig(t *testing.T, fake *govpsietest.Server) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	for _, kv := range [][2]string{{"base_url", fake.URL}, {"token", govpsietest.Token}, {"project", "proj-1"}} {
+		if _, err := vpsie(t, path, "config", "set", kv[0], kv[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return path
+}
+
+func TestServerCommands(t *testing.T) {
+	fake := govpsietest.NewServer()
+	defer fake.Close()
+	config := writeConfig(t, fake)
+
+	out, err := vpsie(t, config, "-o", "json", "server", "create",
+		"--hostname", "web-1", "--resource", "plan", "--os", "os", "--dc", "dc", "--wait")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var created govpsie.VmData
+	if err := json.Unmarshal([]byte(out), &created); err != nil {
+		t.Fatalf("%v in %s", err, out)
+	}
+	if created.Hostname != "web-1" || created.ProjectID != "proj-1" || created.Identifier == "" {
+		t.Errorf("unexpected server %+v", created)
+	}
+
+	out, err = vpsie(t, config, "server", "stop", created.Identifier, "--wait")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, created.Identifier) || !strings.Contains(out, "ok") {
+		t.Errorf("unexpected stop output:\n%s", out)
+	}
+
+	out, err = vpsie(t, config, "server", "list", "--all", "-o", "yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var listed []govpsie.VmData
+	if err := yaml.Unmarshal([]byte(out), &listed); err != nil {
+		t.Fatalf("%v in %s", err, out)
+	}
+	if len(listed) != 1 || !strings.Contains(out, "state: stopped") {
+		t.Errorf("unexpected list:\n%s", out)
+	}
+
+	out, err = vpsie(t, config, "server", "get", created.Identifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "HOSTNAME:") || !strings.Contains(out, "web-1") {
+		t.Errorf("unexpected table:\n%s", out)
+	}
+
+	_, err = vpsie(t, config, "server", "create", "--hostname", "bad host", "--resource", "plan", "--os", "os", "--dc", "dc")
+	var invalid *govpsie.ValidationError
+	if !errors.As(err, &invalid) {
+		t.Errorf("expected a validation error, got %v", err)
+	}
+}
+
+func TestStorageCommands(t *testing.T) {
+	fake := govpsietest.NewServer()
+	defer fake.Close()
+	config := writeConfig(t, fake)
+	vm := fake.AddServer(govpsie.VmData{Hostname: "web-1"})
+
+	out, err := vpsie(t, config, "-o", "json", "storage", "create", "--name", "vol", "--dc", "dc", "--size", "10", "--wait")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var volume govpsie.StorageDetail
+	if err := json.Unmarshal([]byte(out), &volume); err != nil {
+		t.Fatalf("%v in %s", err, out)
+	}
+	if volume.Identifier == "" || volume.Name != "vol" {
+		t.Errorf("unexpected volume %+v", volume)
+	}
+
+	// An older snapshot of the same name is not the one created.
+	old := fake.AddSnapshot(govpsie.Snapshot{Name: "snap", VmIdentifier: vm.Identifier, State: "active"})
+	out, err = vpsie(t, config, "-o", "json", "snapshot", "create", vm.Identifier, "--name", "snap", "--wait")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var snapshot govpsie.Snapshot
+	if err := json.Unmarshal([]byte(out), &snapshot); err != nil {
+		t.Fatalf("%v in %s", err, out)
+	}
+	if snapshot.Identifier == "" || snapshot.Identifier == old.Identifier || snapshot.Name != "snap" {
+		t.Errorf("unexpected snapshot %+v", snapshot)
+	}
+}
+
+func TestConfigCommands(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vpsie", "config.yaml")
+
+	if _, err := vpsie(t, path, "--profile", "work", "config", "set", "token", "secret-token-1234"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := vpsie(t, path, "--profile", "work", "config", "set", "colour", "blue"); exitCode(err) != 2 {
+		t.Errorf("unknown key: got %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("config file mode %v", info.Mode().Perm())
+	}
+
+	out, err := vpsie(t, path, "config", "show")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "work") || !strings.Contains(out, "********1234") || strings.Contains(out, "secret-token") {
+		t.Errorf("unexpected profile:\n%s", out)
+	}
+
+	if _, err := vpsie(t, path, "config", "use", "home"); err == nil {
+		t.Error("expected an error switching to a missing profile")
+	}
+	if _, err := vpsie(t, path, "--profile", "home", "server", "list"); err == nil {
+		t.Error("expected an error using a missing profile")
+	}
+}
+
+func TestUsage(t *testing.T) {
+	config := filepath.Join(t.TempDir(), "config.yaml")
+	for _, args := range [][]string{
+		{},
+		{"server"},
+		{"server", "launch"},
+		{"server", "get"},
+		{"server", "get", "a", "b"},
+		{"-o", "xml", "config", "show"},
+	} {
+		if _, err := vpsie(t, config, args...); exitCode(err) != 2 {
+			t.Errorf("%q: expected a usage error, got %v", args, err)
+		}
+	}
+}
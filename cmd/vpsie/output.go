@@ -0,0 +1,126 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
+	"strings"
+	"text/tabwriter"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+	"gopkg.in/yaml.v3"
+)
+
+// column is one column of table output.
+type column[T any] struct {
+	header string
+	value  func(T) string
+}
+
+// printList prints items as a table with cols, or as a JSON or YAML list.
+func printList[T any](c *cli, items []T, cols []column[T]) error {
+	if c.output != "table" {
+		if items == nil {
+			items = []T{}
+		}
+		return c.encode(items)
+	}
+
+	tw := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	headers := make([]string, len(cols))
+	for i, col := range cols {
+		headers[i] = col.header
+	}
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+
+	for _, item := range items {
+		values := make([]string, len(cols))
+		for i, col := range cols {
+			values[i] = col.value(item)
+		}
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+	return tw.Flush()
+}
+
+// printItem prints item as "HEADER: value" lines, or as a JSON or YAML object.
+func printItem[T any](c *cli, item T, cols []column[T]) error {
+	if c.output != "table" {
+		return c.encode(item)
+	}
+
+	tw := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	for _, col := range cols {
+		fmt.Fprintf(tw, "%s:\t%s\n", col.header, col.value(item))
+	}
+	return tw.Flush()
+}
+
+// encode writes v as indented JSON or as YAML. YAML is converted from the
+// JSON encoding so that both use the API's field names.
+func (c *cli) encode(v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	switch c.output {
+	case "json":
+		_, err = fmt.Fprintf(c.stdout, "%s\n", data)
+		return err
+
+	case "yaml":
+		var node yaml.Node
+		if err := yaml.Unmarshal(data, &node); err != nil {
+			return err
+		}
+		blockStyle(&node)
+
+		enc := yaml.NewEncoder(c.stdout)
+		enc.SetIndent(2)
+		if err := enc.Encode(&node); err != nil {
+			return err
+		}
+		return enc.Close()
+	}
+
+	return usagef("unknown output format %q; use table, json or yaml", c.output)
+}
+
+// blockStyle resets the flow style and quoting that JSON decodes into, so
+// that the encoder picks YAML's block style and quotes only where needed.
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+// collect drains an iterator returned by an All method.
+func collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var items []T
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// printDone reports a finished operation without a result.
+func (c *cli) printDone(format string, args ...any) error {
+	if c.output != "table" {
+		return c.encode(map[string]string{"status": "ok", "message": fmt.Sprintf(format, args...)})
+	}
+	_, err := fmt.Fprintf(c.stdout, format+"\n", args...)
+	return err
+}
+
+// timestamp formats t for tables, or "-" if it is unset.
+func timestamp(t goVPSie.Timestamp) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Local().Format("2006-01-02 15:04")
+}
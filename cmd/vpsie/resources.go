@@ -0,0 +1,302 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+)
+
+var lbColumns = []column[goVPSie.LB]{
+	{"IDENTIFIER", func(lb goVPSie.LB) string { return lb.Identifier }},
+	{"NAME", func(lb goVPSie.LB) string { return lb.LBName }},
+	{"IP", func(lb goVPSie.LB) string { return lb.DefaultIP }},
+	{"DATA CENTER", func(lb goVPSie.LB) string { return lb.DCName }},
+	{"PACKAGE", func(lb goVPSie.LB) string { return lb.Package }},
+	{"CREATED", func(lb goVPSie.LB) string { return timestamp(lb.CreatedOn) }},
+}
+
+var lbRuleColumns = []column[goVPSie.LBRuleDetail]{
+	{"RULE", func(r goVPSie.LBRuleDetail) string { return r.RuleID }},
+	{"SCHEME", func(r goVPSie.LBRuleDetail) string { return r.Scheme }},
+	{"FRONT PORT", func(r goVPSie.LBRuleDetail) string { return strconv.Itoa(r.FrontPort) }},
+	{"BACK PORT", func(r goVPSie.LBRuleDetail) string { return strconv.Itoa(r.BackPort) }},
+	{"BACKENDS", func(r goVPSie.LBRuleDetail) string { return strconv.Itoa(len(r.Backends)) }},
+	{"DOMAINS", func(r goVPSie.LBRuleDetail) string { return strconv.Itoa(len(r.Domains)) }},
+}
+
+var k8sColumns = []column[goVPSie.ListK8s]{
+	{"IDENTIFIER", func(k goVPSie.ListK8s) string { return k.Identifier }},
+	{"NAME", func(k goVPSie.ListK8s) string { return k.ClusterName }},
+	{"MANAGERS", func(k goVPSie.ListK8s) string { return strconv.Itoa(k.ManagerCount) }},
+	{"WORKERS", func(k goVPSie.ListK8s) string { return strconv.Itoa(k.SlaveCount) }},
+	{"CPU", func(k goVPSie.ListK8s) string { return strconv.Itoa(k.Cpu) }},
+	{"RAM", func(k goVPSie.ListK8s) string { return strconv.Itoa(k.Ram) }},
+	{"PRICE", func(k goVPSie.ListK8s) string { return k.Price.String() }},
+	{"CREATED", func(k goVPSie.ListK8s) string { return timestamp(k.CreatedOn) }},
+}
+
+var k8sDetailColumns = []column[goVPSie.K8s]{
+	{"IDENTIFIER", func(k goVPSie.K8s) string { return k.Identifier }},
+	{"NAME", func(k goVPSie.K8s) string { return k.ClusterName }},
+	{"NODES", func(k goVPSie.K8s) string { return strconv.Itoa(len(k.Nodes)) }},
+	{"CPU", func(k goVPSie.K8s) string { return strconv.Itoa(k.Cpu) }},
+	{"RAM", func(k goVPSie.K8s) string { return strconv.Itoa(k.Ram) }},
+	{"PRICE", func(k goVPSie.K8s) string { return k.Price.String() }},
+	{"CREATED", func(k goVPSie.K8s) string { return timestamp(k.CreatedOn) }},
+}
+
+var bucketColumns = []column[goVPSie.Bucket]{
+	{"IDENTIFIER", func(b goVPSie.Bucket) string { return b.Identifier }},
+	{"NAME", func(b goVPSie.Bucket) string { return b.BucketName }},
+	{"PROJECT", func(b goVPSie.Bucket) string { return b.ProjectName }},
+	{"ENDPOINT", func(b goVPSie.Bucket) string { return b.EndPoint }},
+	{"STATE", func(b goVPSie.Bucket) string { return b.State }},
+	{"CREATED", func(b goVPSie.Bucket) string { return timestamp(b.CreatedOn) }},
+}
+
+var projectColumns = []column[goVPSie.Project]{
+	{"IDENTIFIER", func(p goVPSie.Project) string { return p.Identifier }},
+	{"NAME", func(p goVPSie.Project) string { return p.Name }},
+	{"DEFAULT", func(p goVPSie.Project) string { return strconv.FormatBool(p.IsDefault.Bool()) }},
+	{"DESCRIPTION", func(p goVPSie.Project) string { return p.Description }},
+	{"CREATED", func(p goVPSie.Project) string { return timestamp(p.CreatedOn) }},
+}
+
+func lbCommands() *command {
+	return &command{
+		name:    "lb",
+		summary: "Manage load balancers",
+		sub: []*command{
+			{name: "list", summary: "List the load balancers", run: listCommand(func(ctx context.Context, client *goVPSie.Client) ([]goVPSie.LB, error) {
+				return collect(client.LB.LBsAll(ctx))
+			}, lbColumns)},
+			{name: "get", args: "<identifier>", summary: "Show a load balancer and its rules", run: lbGet},
+			{name: "delete", args: "<identifier>", summary: "Delete a load balancer", run: lbDelete},
+		},
+	}
+}
+
+func lbGet(c *cli, name string, args []string) error {
+	args, err := parse(c.flags(name, "<identifier>"), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	lb, err := client.LB.GetLB(c.ctx, args[0])
+	if err != nil {
+		return err
+	}
+	if c.output != "table" {
+		return c.encode(lb)
+	}
+
+	if err := printItem(c, *lb, []column[goVPSie.LBDetails]{
+		{"IDENTIFIER", func(lb goVPSie.LBDetails) string { return lb.Identifier }},
+		{"NAME", func(lb goVPSie.LBDetails) string { return lb.LBName }},
+		{"IP", func(lb goVPSie.LBDetails) string { return lb.DefaultIP }},
+		{"DATA CENTER", func(lb goVPSie.LBDetails) string { return lb.DcName }},
+	}); err != nil {
+		return err
+	}
+	fmt.Fprintln(c.stdout)
+	return printList(c, lb.Rules, lbRuleColumns)
+}
+
+func lbDelete(c *cli, name string, args []string) error {
+	fs := c.flags(name, "<identifier>")
+	reason, note := deleteFlags(fs)
+	args, err := parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if err := client.LB.DeleteLB(c.ctx, args[0], *reason, *note); err != nil {
+		return err
+	}
+	return c.printDone("Deleted load balancer %s", args[0])
+}
+
+func k8sCommands() *command {
+	return &command{
+		name:    "k8s",
+		summary: "Manage Kubernetes clusters",
+		sub: []*command{
+			{name: "list", summary: "List the clusters", run: listCommand(func(ctx context.Context, client *goVPSie.Client) ([]goVPSie.ListK8s, error) {
+				return collect(client.K8s.All(ctx))
+			}, k8sColumns)},
+			{name: "get", args: "<identifier>", summary: "Show a cluster", run: k8sGet},
+			{name: "delete", args: "<identifier>", summary: "Delete a cluster", run: k8sDelete},
+		},
+	}
+}
+
+func k8sGet(c *cli, name string, args []string) error {
+	args, err := parse(c.flags(name, "<identifier>"), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	cluster, err := client.K8s.Get(c.ctx, args[0])
+	if err != nil {
+		return err
+	}
+	return printItem(c, *cluster, k8sDetailColumns)
+}
+
+func k8sDelete(c *cli, name string, args []string) error {
+	fs := c.flags(name, "<identifier>")
+	reason, note := deleteFlags(fs)
+	args, err := parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if err := client.K8s.Delete(c.ctx, args[0], *reason, *note); err != nil {
+		return err
+	}
+	return c.printDone("Deleted cluster %s", args[0])
+}
+
+func bucketCommands() *command {
+	return &command{
+		name:    "bucket",
+		summary: "Manage object storage buckets",
+		sub: []*command{
+			{name: "list", summary: "List the buckets", run: bucketList},
+			{name: "get", args: "<identifier>", summary: "Show a bucket", run: bucketGet},
+			{name: "create", args: "<name>", summary: "Create a bucket", run: bucketCreate},
+			{name: "delete", args: "<identifier>", summary: "Delete a bucket", run: bucketDelete},
+		},
+	}
+}
+
+func bucketList(c *cli, name string, args []string) error {
+	if _, err := parse(c.flags(name, ""), args, 0, 0); err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+
+	buckets, err := collect(client.Bucket.All(c.ctx))
+	if err != nil {
+		return err
+	}
+	return printList(c, buckets, bucketColumns)
+}
+
+func bucketGet(c *cli, name string, args []string) error {
+	args, err := parse(c.flags(name, "<identifier>"), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	bucket, err := client.Bucket.Get(c.ctx, args[0])
+	if err != nil {
+		return err
+	}
+	return printItem(c, *bucket, bucketColumns)
+}
+
+func bucketCreate(c *cli, name string, args []string) error {
+	fs := c.flags(name, "<name>")
+	dc := fs.String("dc", "", "identifier of the data center (required)")
+	project := fs.String("project", "", "project identifier (default: the profile's project)")
+	listing := fs.Bool("file-listing", false, "allow listing the files of the bucket")
+	tags := fs.String("tags", "", "comma separated tags")
+	args, err := parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	projectID, err := c.project(*project)
+	if err != nil {
+		return err
+	}
+	if err := client.Bucket.Create(c.ctx, &goVPSie.CreateBucketReq{
+		BucketName:   args[0],
+		FileListing:  *listing,
+		ProjectId:    projectID,
+		DataCenterId: *dc,
+		Tags:         splitList(*tags),
+	}); err != nil {
+		return err
+	}
+	return c.printDone("Created bucket %s", args[0])
+}
+
+func bucketDelete(c *cli, name string, args []string) error {
+	fs := c.flags(name, "<identifier>")
+	reason, note := deleteFlags(fs)
+	args, err := parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if err := client.Bucket.Delete(c.ctx, args[0], *reason, *note); err != nil {
+		return err
+	}
+	return c.printDone("Deleted bucket %s", args[0])
+}
+
+func projectCommands() *command {
+	return &command{
+		name:    "project",
+		summary: "List projects",
+		sub: []*command{
+			{name: "list", summary: "List the projects", run: listCommand(func(ctx context.Context, client *goVPSie.Client) ([]goVPSie.Project, error) {
+				return collect(client.Project.All(ctx))
+			}, projectColumns)},
+			{name: "get", args: "<identifier>", summary: "Show a project", run: projectGet},
+		},
+	}
+}
+
+func projectGet(c *cli, name string, args []string) error {
+	args, err := parse(c.flags(name, "<identifier>"), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	project, err := client.Project.Get(c.ctx, args[0])
+	if err != nil {
+		return err
+	}
+	return printItem(c, *project, projectColumns)
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+)
+
+var serverColumns = []column[goVPSie.VmData]{
+	{"IDENTIFIER", func(vm goVPSie.VmData) string { return vm.Identifier }},
+	{"HOSTNAME", func(vm goVPSie.VmData) string { return vm.Hostname }},
+	{"STATE", func(vm goVPSie.VmData) string { return vm.State }},
+	{"IP", func(vm goVPSie.VmData) string { return vm.DefaultIP }},
+	{"CPU", func(vm goVPSie.VmData) string { return strconv.FormatInt(vm.Cpu, 10) }},
+	{"RAM", func(vm goVPSie.VmData) string { return strconv.FormatInt(vm.Ram, 10) }},
+	{"SSD", func(vm goVPSie.VmData) string { return strconv.FormatInt(vm.Ssd, 10) }},
+	{"CREATED", func(vm goVPSie.VmData) string { return timestamp(vm.CreatedOn) }},
+}
+
+func serverCommands() *command {
+	return &command{
+		name:    "server",
+		summary: "Manage servers",
+		sub: []*command{
+			{name: "list", summary: "List the servers of the project, or all servers with --all", run: serverList},
+			{name: "get", args: "<identifier>", summary: "Show a server", run: serverGet},
+			{name: "create", summary: "Create a server", run: serverCreate},
+			{name: "start", args: "<identifier>...", summary: "Start servers", run: serverPower("start", "running")},
+			{name: "stop", args: "<identifier>...", summary: "Stop servers", run: serverPower("stop", "stopped")},
+			{name: "restart", args: "<identifier>...", summary: "Restart servers", run: serverPower("restart", "running")},
+			{name: "resize", args: "<identifier>", summary: "Change the CPU and RAM of a server", run: serverResize},
+		},
+	}
+}
+
+func serverList(c *cli, name string, args []string) error {
+	fs := c.flags(name, "")
+	project := fs.String("project", "", "project identifier (default: the profile's project)")
+	all := fs.Bool("all", false, "list the servers of every project")
+	if _, err := parse(fs, args, 0, 0); err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	projectID, err := c.project(*project)
+	if err != nil {
+		return err
+	}
+
+	var servers []goVPSie.VmData
+	if *all || projectID == "" {
+		servers, err = collect(client.Server.All(c.ctx))
+	} else {
+		servers, err = collect(client.Server.AllByProject(c.ctx, projectID))
+	}
+	if err != nil {
+		return err
+	}
+	return printList(c, servers, serverColumns)
+}
+
+func serverGet(c *cli, name string, args []string) error {
+	args, err := parse(c.flags(name, "<identifier>"), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	vm, err := client.Server.GetServerByIdentifier(c.ctx, args[0])
+	if err != nil {
+		return err
+	}
+	return printItem(c, *vm, serverColumns)
+}
+
+func serverCreate(c *cli, name string, args []string) error {
+	fs := c.flags(name, "")
+	hostname := fs.String("hostname", "", "host name of the server (required)")
+	resource := fs.String("resource", "", "identifier of the resource plan (required)")
+	osID := fs.String("os", "", "identifier of the operating system image (required)")
+	dc := fs.String("dc", "", "identifier of the data center (required)")
+	project := fs.String("project", "", "project identifier (default: the profile's project)")
+	sshKey := fs.String("ssh-key", "", "identifier of an SSH key to install")
+	script := fs.String("script", "", "identifier of a startup script")
+	notes := fs.String("notes", "", "notes about the server")
+	tags := fs.String("tags", "", "comma separated tags")
+	backup := fs.Bool("backup", false, "enable automatic backups")
+	wait, timeout := waitFlags(fs)
+	if _, err := parse(fs, args, 0, 0); err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	projectID, err := c.project(*project)
+	if err != nil {
+		return err
+	}
+
+	req := &goVPSie.CreateServerRequest{
+		ResourceIdentifier: *resource,
+		OsIdentifier:       *osID,
+		DcIdentifier:       *dc,
+		Hostname:           *hostname,
+		ProjectID:          projectID,
+		SshKeyIdentifier:   optional(*sshKey),
+		ScriptIdentifier:   optional(*script),
+		Notes:              optional(*notes),
+	}
+	if *backup {
+		enabled := int64(1)
+		req.BackupEnabled = &enabled
+	}
+	for _, tag := range splitList(*tags) {
+		req.Tags = append(req.Tags, &tag)
+	}
+
+	result, err := client.Server.CreateServerWithResult(c.ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if *wait && (result.ProcessID != "" || result.Identifier != "") {
+		vm, err := client.Server.WaitForCreation(c.ctx, result, c.waitOptions(*timeout, *hostname))
+		if err != nil {
+			return err
+		}
+		return printItem(c, *vm, serverColumns)
+	}
+
+	return printItem(c, *result, []column[goVPSie.CreateResult]{
+		{"IDENTIFIER", func(r goVPSie.CreateResult) string { return r.Identifier }},
+		{"PROCESS", func(r goVPSie.CreateResult) string { return r.ProcessID }},
+	})
+}
+
+// serverPower returns the command running action on each server, waiting for
+// them to reach state with --wait.
+func serverPower(action, state string) func(*cli, string, []string) error {
+	return func(c *cli, name string, args []string) error {
+		fs := c.flags(name, "<identifier>...")
+		concurrency := fs.Int("concurrency", 8, "how many servers to act on at once")
+		wait, timeout := waitFlags(fs)
+		ids, err := parse(fs, args, 1, -1)
+		if err != nil {
+			return err
+		}
+
+		client, err := c.api()
+		if err != nil {
+			return err
+		}
+
+		opts := &goVPSie.BulkOptions{Concurrency: *concurrency}
+		var results []goVPSie.BulkResult
+		switch action {
+		case "start":
+			results, err = client.Server.StartMany(c.ctx, ids, opts)
+		case "stop":
+			results, err = client.Server.StopMany(c.ctx, ids, opts)
+		case "restart":
+			results, err = client.Server.RestartMany(c.ctx, ids, opts)
+		}
+
+		if err == nil && *wait {
+			results, err = client.Bulk(c.ctx, ids, func(ctx context.Context, id string) error {
+				_, err := client.Server.WaitForState(ctx, id, state, c.waitOptions(*timeout, id+" to be "+state))
+				return err
+			}, opts)
+		}
+
+		if printErr := printBulk(c, results); printErr != nil {
+			return printErr
+		}
+		return err
+	}
+}
+
+func serverResize(c *cli, name string, args []string) error {
+	fs := c.flags(name, "<identifier>")
+	cpu := fs.Int("cpu", 0, "number of CPUs (required)")
+	ram := fs.Int("ram", 0, "RAM in MB (required)")
+	wait, timeout := waitFlags(fs)
+	args, err := parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	if *cpu <= 0 || *ram <= 0 {
+		return usagef("%s: --cpu and --ram are required", name)
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if err := client.Server.ResizeServer(c.ctx, args[0], strconv.Itoa(*cpu), strconv.Itoa(*ram)); err != nil {
+		return err
+	}
+
+	if *wait {
+		if _, err := client.Server.WaitForState(c.ctx, args[0], "running", c.waitOptions(*timeout, args[0]+" to be running")); err != nil {
+			return err
+		}
+	}
+	return c.printDone("Resized %s to %d CPUs and %d MB of RAM", args[0], *cpu, *ram)
+}
+
+// printBulk prints the outcome of a bulk operation per identifier.
+func printBulk(c *cli, results []goVPSie.BulkResult) error {
+	type outcome struct {
+		Identifier string `json:"identifier"`
+		Status     string `json:"status"`
+		Error      string `json:"error,omitempty"`
+	}
+
+	outcomes := make([]outcome, len(results))
+	for i, r := range results {
+		outcomes[i] = outcome{Identifier: r.Identifier, Status: "ok"}
+		if r.Err != nil {
+			outcomes[i].Status = "failed"
+			outcomes[i].Error = r.Err.Error()
+		}
+	}
+
+	return printList(c, outcomes, []column[outcome]{
+		{"IDENTIFIER", func(o outcome) string { return o.Identifier }},
+		{"STATUS", func(o outcome) string { return o.Status }},
+		{"ERROR", func(o outcome) string { return o.Error }},
+	})
+}
+
+// optional returns nil for an empty flag value.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// splitList splits a comma separated flag value, dropping empty items.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,345 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+)
+
+var storageColumns = []column[goVPSie.Storage]{
+	{"IDENTIFIER", func(s goVPSie.Storage) string { return s.Identifier }},
+	{"NAME", func(s goVPSie.Storage) string { return s.Name }},
+	{"SIZE", func(s goVPSie.Storage) string { return strconv.Itoa(s.Size) }},
+	{"TYPE", func(s goVPSie.Storage) string { return s.StorageType }},
+	{"FORMAT", func(s goVPSie.Storage) string { return s.DiskFormat }},
+	{"SERVER", func(s goVPSie.Storage) string { return s.Hostname }},
+	{"STATE", func(s goVPSie.Storage) string { return s.State }},
+	{"CREATED", func(s goVPSie.Storage) string { return timestamp(s.CreatedOn) }},
+}
+
+var storageDetailColumns = []column[goVPSie.StorageDetail]{
+	{"IDENTIFIER", func(s goVPSie.StorageDetail) string { return s.Identifier }},
+	{"NAME", func(s goVPSie.StorageDetail) string { return s.Name }},
+	{"SIZE", func(s goVPSie.StorageDetail) string { return strconv.Itoa(s.Size) }},
+	{"TYPE", func(s goVPSie.StorageDetail) string { return s.StorageType }},
+	{"FORMAT", func(s goVPSie.StorageDetail) string { return s.DiskFormat }},
+	{"DATA CENTER", func(s goVPSie.StorageDetail) string { return s.DcName }},
+	{"SERVER", func(s goVPSie.StorageDetail) string { return s.Hostname }},
+	{"STATE", func(s goVPSie.StorageDetail) string { return s.State }},
+	{"CREATED", func(s goVPSie.StorageDetail) string { return timestamp(s.CreatedOn) }},
+}
+
+var snapshotColumns = []column[goVPSie.Snapshot]{
+	{"IDENTIFIER", func(s goVPSie.Snapshot) string { return s.Identifier }},
+	{"NAME", func(s goVPSie.Snapshot) string { return s.Name }},
+	{"SERVER", func(s goVPSie.Snapshot) string { return s.Hostname }},
+	{"SIZE", func(s goVPSie.Snapshot) string { return strconv.FormatInt(s.BackupSize, 10) }},
+	{"STATE", func(s goVPSie.Snapshot) string { return s.State }},
+	{"NOTE", func(s goVPSie.Snapshot) string { return s.Note }},
+	{"CREATED", func(s goVPSie.Snapshot) string { return timestamp(s.CreatedOn) }},
+}
+
+func storageCommands() *command {
+	return &command{
+		name:    "storage",
+		summary: "Manage volumes",
+		sub: []*command{
+			{name: "list", summary: "List the volumes", run: listCommand(func(ctx context.Context, client *goVPSie.Client) ([]goVPSie.Storage, error) {
+				return collect(client.Storage.All(ctx))
+			}, storageColumns)},
+			{name: "get", args: "<identifier>", summary: "Show a volume", run: storageGet},
+			{name: "create", summary: "Create a volume", run: storageCreate},
+			{name: "delete", args: "<identifier>", summary: "Delete a volume", run: storageDelete},
+			{name: "attach", args: "<identifier> <server>", summary: "Attach a volume to a server", run: storageAttach(true)},
+			{name: "detach", args: "<identifier> <server>", summary: "Detach a volume from a server", run: storageAttach(false)},
+		},
+	}
+}
+
+func storageGet(c *cli, name string, args []string) error {
+	args, err := parse(c.flags(name, "<identifier>"), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	volume, err := client.Storage.Get(c.ctx, args[0])
+	if err != nil {
+		return err
+	}
+	return printItem(c, *volume, storageDetailColumns)
+}
+
+func storageCreate(c *cli, name string, args []string) error {
+	fs := c.flags(name, "")
+	volumeName := fs.String("name", "", "name of the volume (required)")
+	dc := fs.String("dc", "", "identifier of the data center (required)")
+	size := fs.Int("size", 0, "size in GB (required)")
+	storageType := fs.String("type", "ssd", "storage type")
+	format := fs.String("format", "EXT4", "disk format")
+	description := fs.String("description", "", "description of the volume")
+	wait, timeout := waitFlags(fs)
+	if _, err := parse(fs, args, 0, 0); err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	result, err := client.Storage.CreateVolumeWithResult(c.ctx, &goVPSie.StorageCreateRequest{
+		Name:         *volumeName,
+		DcIdentifier: *dc,
+		Description:  *description,
+		Size:         *size,
+		StorageType:  *storageType,
+		DiskFormat:   *format,
+	})
+	if err != nil {
+		return err
+	}
+
+	if *wait && result.Identifier != "" {
+		var volume *goVPSie.StorageDetail
+		err := goVPSie.WaitFor(c.ctx, result.Identifier, "created", c.waitOptions(*timeout, "volume "+*volumeName), func(ctx context.Context) (bool, error) {
+			var err error
+			volume, err = client.Storage.Get(ctx, result.Identifier)
+			if goVPSie.IsNotFound(err) {
+				return false, nil
+			} else if err != nil {
+				return false, err
+			}
+			return settled("volume "+*volumeName, volume.State)
+		})
+		if err != nil {
+			return err
+		}
+		return printItem(c, *volume, storageDetailColumns)
+	}
+
+	return printItem(c, *result, []column[goVPSie.CreateResult]{
+		{"IDENTIFIER", func(r goVPSie.CreateResult) string { return r.Identifier }},
+	})
+}
+
+// settled reports whether a volume or snapshot in state is done being
+// created. Any state but the ones of work in progress counts; a failed state
+// ends the wait with an error.
+func settled(what, state string) (bool, error) {
+	switch s := strings.ToLower(state); {
+	case strings.Contains(s, "fail") || strings.Contains(s, "error"):
+		return false, fmt.Errorf("%s is %s", what, state)
+	case s == "queued" || strings.Contains(s, "progress") || strings.HasSuffix(s, "ing"):
+		return false, nil
+	}
+	return true, nil
+}
+
+func storageDelete(c *cli, name string, args []string) error {
+	args, err := parse(c.flags(name, "<identifier>"), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if err := client.Storage.Delete(c.ctx, args[0]); err != nil {
+		return err
+	}
+	return c.printDone("Deleted volume %s", args[0])
+}
+
+// storageAttach returns the command attaching a volume to a server, or
+// detaching it if attach is false.
+func storageAttach(attach bool) func(*cli, string, []string) error {
+	return func(c *cli, name string, args []string) error {
+		fs := c.flags(name, "<identifier> <server>")
+		vmType := fs.String("vm-type", "vm", "type of the server")
+		args, err := parse(fs, args, 2, 2)
+		if err != nil {
+			return err
+		}
+
+		client, err := c.api()
+		if err != nil {
+			return err
+		}
+		if !attach {
+			if err := client.Storage.DetachToServer(c.ctx, args[0], args[1], *vmType); err != nil {
+				return err
+			}
+			return c.printDone("Detached volume %s from %s", args[0], args[1])
+		}
+		if err := client.Storage.AttachToServer(c.ctx, args[0], args[1], *vmType); err != nil {
+			return err
+		}
+		return c.printDone("Attached volume %s to %s", args[0], args[1])
+	}
+}
+
+func snapshotCommands() *command {
+	return &command{
+		name:    "snapshot",
+		summary: "Manage server snapshots",
+		sub: []*command{
+			{name: "list", summary: "List the snapshots, or those of one server with --server", run: snapshotList},
+			{name: "get", args: "<identifier>", summary: "Show a snapshot", run: snapshotGet},
+			{name: "create", args: "<server>", summary: "Snapshot a server", run: snapshotCreate},
+			{name: "delete", args: "<identifier>...", summary: "Delete snapshots", run: snapshotDelete},
+			{name: "rollback", args: "<identifier>", summary: "Restore a server from a snapshot", run: snapshotRollback},
+		},
+	}
+}
+
+func snapshotList(c *cli, name string, args []string) error {
+	fs := c.flags(name, "")
+	server := fs.String("server", "", "list only the snapshots of this server")
+	if _, err := parse(fs, args, 0, 0); err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	var snapshots []goVPSie.Snapshot
+	if *server != "" {
+		snapshots, err = collect(client.Snapshot.AllByVm(c.ctx, *server))
+	} else {
+		snapshots, err = collect(client.Snapshot.All(c.ctx))
+	}
+	if err != nil {
+		return err
+	}
+	return printList(c, snapshots, snapshotColumns)
+}
+
+func snapshotGet(c *cli, name string, args []string) error {
+	args, err := parse(c.flags(name, "<identifier>"), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	snapshot, err := client.Snapshot.Get(c.ctx, args[0])
+	if err != nil {
+		return err
+	}
+	return printItem(c, *snapshot, snapshotColumns)
+}
+
+func snapshotCreate(c *cli, name string, args []string) error {
+	fs := c.flags(name, "<server>")
+	snapshotName := fs.String("name", "", "name of the snapshot (required)")
+	note := fs.String("note", "", "note about the snapshot")
+	wait, timeout := waitFlags(fs)
+	args, err := parse(fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	if *snapshotName == "" {
+		return usagef("%s: --name is required", name)
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	// Create does not return the snapshot, so note the snapshots of the
+	// server beforehand and wait for a new one with the name.
+	before := make(map[string]bool)
+	if *wait {
+		snapshots, err := client.Snapshot.ListByVm(c.ctx, nil, args[0])
+		if err != nil {
+			return err
+		}
+		for _, s := range snapshots {
+			before[s.Identifier] = true
+		}
+	}
+	if err := client.Snapshot.Create(c.ctx, *snapshotName, args[0], *note); err != nil {
+		return err
+	}
+	if !*wait {
+		return c.printDone("Started snapshot %s of %s", *snapshotName, args[0])
+	}
+
+	var snapshot *goVPSie.Snapshot
+	what := "snapshot " + *snapshotName
+	err = goVPSie.WaitFor(c.ctx, *snapshotName, "created", c.waitOptions(*timeout, what), func(ctx context.Context) (bool, error) {
+		snapshots, err := client.Snapshot.ListByVm(ctx, nil, args[0])
+		if err != nil {
+			return false, err
+		}
+		snapshot = nil
+		for i, s := range snapshots {
+			if s.Name == *snapshotName && !before[s.Identifier] {
+				snapshot = &snapshots[i]
+				break
+			}
+		}
+		if snapshot == nil {
+			return false, nil
+		}
+		return settled(what, snapshot.State)
+	})
+	if err != nil {
+		return err
+	}
+	return printItem(c, *snapshot, snapshotColumns)
+}
+
+func snapshotDelete(c *cli, name string, args []string) error {
+	fs := c.flags(name, "<identifier>...")
+	reason, note := deleteFlags(fs)
+	ids, err := parse(fs, args, 1, -1)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	results, err := client.Snapshot.DeleteMany(c.ctx, ids, *reason, *note, nil)
+	if printErr := printBulk(c, results); printErr != nil {
+		return printErr
+	}
+	return err
+}
+
+func snapshotRollback(c *cli, name string, args []string) error {
+	args, err := parse(c.flags(name, "<identifier>"), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.api()
+	if err != nil {
+		return err
+	}
+	if err := client.Snapshot.Rollback(c.ctx, args[0]); err != nil {
+		return err
+	}
+	return c.printDone("Rolling back to snapshot %s", args[0])
+}
+
+// deleteFlags registers the --reason and --note flags that some delete
+// endpoints record.
+func deleteFlags(fs *flag.FlagSet) (reason, note *string) {
+	reason = fs.String("reason", "", "reason for the deletion")
+	note = fs.String("note", "", "note about the deletion")
+	return reason, note
+}
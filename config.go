@@ -0,0 +1,240 @@
+package goVPSie
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables read by LoadConfig and NewClientFromEnv.
+const (
+	EnvToken        = "VPSIE_TOKEN"
+	EnvClientID     = "VPSIE_CLIENT_ID"
+	EnvClientSecret = "VPSIE_CLIENT_SECRET"
+	EnvBaseURL      = "VPSIE_BASE_URL"
+	EnvProject      = "VPSIE_PROJECT"
+	EnvProfile      = "VPSIE_PROFILE"
+	EnvConfig       = "VPSIE_CONFIG"
+)
+
+// DefaultProfile is the profile used when none is named.
+const DefaultProfile = "default"
+
+// ErrNoCredentials is returned by NewClientFromProfile and NewClientFromEnv
+// when neither a token nor client credentials are configured.
+var ErrNoCredentials = errors.New("no credentials: set a token or a client ID and secret")
+
+// Config is the content of the config file: named profiles and the one used
+// when no profile is named.
+type Config struct {
+	CurrentProfile string                    `yaml:"current_profile,omitempty"`
+	Profiles       map[string]*ConfigProfile `yaml:"profiles,omitempty"`
+}
+
+// ConfigProfile holds the credentials and defaults of one account. Either Token or
+// ClientID and ClientSecret authenticate the requests; Token wins if both are
+// set.
+type ConfigProfile struct {
+	// Name is the name of the profile in the config file, if it came from
+	// one.
+	Name string `json:"name,omitempty" yaml:"-"`
+
+	Token        string `json:"token,omitempty" yaml:"token,omitempty"`
+	ClientID     string `json:"client_id,omitempty" yaml:"client_id,omitempty"`
+	ClientSecret string `json:"client_secret,omitempty" yaml:"client_secret,omitempty"`
+	BaseURL      string `json:"base_url,omitempty" yaml:"base_url,omitempty"`
+	Project      string `json:"project,omitempty" yaml:"project,omitempty"`
+}
+
+// DefaultConfigPath returns the path of the config file: $VPSIE_CONFIG if set,
+// else $XDG_CONFIG_HOME/vpsie/config.yaml, else ~/.config/vpsie/config.yaml.
+func DefaultConfigPath() (string, error) {
+	if path := os.Getenv(EnvConfig); path != "" {
+		return path, nil
+	}
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "vpsie", "config.yaml"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "vpsie", "config.yaml"), nil
+}
+
+// ReadConfig reads the config file at path. A missing file is an empty
+// config.
+func ReadConfig(path string) (*Config, error) {
+	cfg := &Config{}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	for name, p := range cfg.Profiles {
+		if p == nil {
+			p = &ConfigProfile{}
+			cfg.Profiles[name] = p
+		}
+		p.Name = name
+	}
+	return cfg, nil
+}
+
+// WriteFile writes cfg to path, creating its directory. The file is readable
+// by its owner only since it holds credentials.
+func (cfg *Config) WriteFile(path string) error {
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// Profile returns the profile called name. An empty name selects the current
+// profile, or DefaultProfile if there is none. The second result reports
+// whether the profile exists; a missing one is returned empty.
+func (cfg *Config) Profile(name string) (*ConfigProfile, bool) {
+	if name == "" {
+		name = cfg.CurrentProfile
+	}
+	if name == "" {
+		name = DefaultProfile
+	}
+	if p, ok := cfg.Profiles[name]; ok {
+		copied := *p
+		copied.Name = name
+		return &copied, true
+	}
+	return &ConfigProfile{Name: name}, false
+}
+
+// LoadConfig returns the settings of profile from the config file at
+// DefaultConfigPath, merged with the environment. See Config.Resolve for the
+// precedence.
+func LoadConfig(profile string) (*ConfigProfile, error) {
+	path, err := DefaultConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := ReadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := cfg.Resolve(profile)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return p, nil
+}
+
+// Resolve returns the settings of profile merged with the environment. The
+// precedence, from highest to lowest, is:
+//
+//  1. VPSIE_TOKEN, VPSIE_CLIENT_ID, VPSIE_CLIENT_SECRET, VPSIE_BASE_URL and
+//     VPSIE_PROJECT. Credentials are taken as a unit: if the environment sets
+//     a token or a client ID, the profile's credentials are ignored.
+//  2. The profile from cfg. It is the one named by the profile argument, else
+//     by VPSIE_PROFILE, else cfg's current profile, else "default".
+//
+// Naming a profile that is not in cfg is an error. With the default profile
+// missing, the settings come from the environment only.
+func (cfg *Config) Resolve(profile string) (*ConfigProfile, error) {
+	if profile == "" {
+		profile = os.Getenv(EnvProfile)
+	}
+	p, ok := cfg.Profile(profile)
+	if !ok && (profile != "" || cfg.CurrentProfile != "") {
+		return nil, fmt.Errorf("profile %q not found", p.Name)
+	}
+
+	if token, id := os.Getenv(EnvToken), os.Getenv(EnvClientID); token != "" || id != "" {
+		p.Token, p.ClientID, p.ClientSecret = token, id, os.Getenv(EnvClientSecret)
+	}
+	if baseURL := os.Getenv(EnvBaseURL); baseURL != "" {
+		p.BaseURL = baseURL
+	}
+	if project := os.Getenv(EnvProject); project != "" {
+		p.Project = project
+	}
+	return p, nil
+}
+
+// NewClientFromEnv builds a client from LoadConfig("") and opts. See
+// NewClientFromProfile.
+func NewClientFromEnv(opts ...ClientOption) (*Client, error) {
+	p, err := LoadConfig("")
+	if err != nil {
+		return nil, err
+	}
+	return NewClientFromProfile(p, opts...)
+}
+
+// NewClientFromProfile builds a client with the base URL, default project and
+// credentials of p, followed by opts, which take precedence. A token is sent
+// as is; client credentials are exchanged through Account.Login on the first
+// request and renewed as needed, see LoginTokenSource.
+func NewClientFromProfile(p *ConfigProfile, opts ...ClientOption) (*Client, error) {
+	var profileOpts []ClientOption
+	if p.BaseURL != "" {
+		profileOpts = append(profileOpts, WithBaseURL(p.BaseURL))
+	}
+	if p.Project != "" {
+		profileOpts = append(profileOpts, WithDefaultProject(p.Project))
+	}
+	if p.Token != "" {
+		profileOpts = append(profileOpts, WithAuthToken(p.Token))
+	}
+
+	c, err := NewClientWithOptions(append(profileOpts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	// An explicit token source in opts wins over the profile's credentials.
+	if c.tokenSource == nil {
+		if p.ClientID == "" || p.ClientSecret == "" {
+			return nil, ErrNoCredentials
+		}
+		c.tokenSource = NewLoginTokenSource(c.Account, &LoginReq{
+			ClientID:     p.ClientID,
+			ClientSecret: p.ClientSecret,
+		})
+	}
+	return c, nil
+}
+
+// DefaultProject returns the project identifier set with WithDefaultProject,
+// usually from a profile, or "" if there is none.
+func (c *Client) DefaultProject() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.defaultProject
+}
+
+// withDefaultProject returns req, or a copy of it whose project, read and set
+// through field, is the default project if req names none. The caller's
+// request is left unchanged.
+func withDefaultProject[T any](c *Client, req *T, field func(*T) *string) *T {
+	project := c.DefaultProject()
+	if req == nil || project == "" || *field(req) != "" {
+		return req
+	}
+	withProject := *req
+	*field(&withProject) = project
+	return &withProject
+}
@@ -0,0 +1,108 @@
+package goVPSie
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// CreateResult identifies the resource started by a create request. The API
+// answers with either the identifier of the new resource or the ID of the
+// process creating it; whichever is present is filled in. A server that is
+// still being provisioned can be awaited with Server.WaitForCreation.
+type CreateResult struct {
+	Identifier string
+	ProcessID  string
+
+	// Raw is the unparsed response body.
+	Raw json.RawMessage
+
+	// started and hostname describe the create call of a server, for
+	// WaitForCreation to recognize the server it made.
+	started  time.Time
+	hostname string
+}
+
+// identifierKeys and processIDKeys list the fields that carry the identifier
+// and process ID across the create endpoints, in order of preference.
+var (
+	identifierKeys = []string{"identifier", "vmIdentifier", "storageIdentifier", "resourceIdentifier"}
+	processIDKeys  = []string{"processId", "process_id", "processID"}
+)
+
+// newCreateResult extracts the identifier and process ID from the body of a
+// create response. They are looked up in the data payload, which may be an
+// object, a list holding one object or a bare identifier, and then in the
+// root object. Only the fields in identifierKeys and processIDKeys are read,
+// and a bare string only counts if it is shaped like an identifier.
+func newCreateResult(raw json.RawMessage) *CreateResult {
+	result := &CreateResult{Raw: raw}
+
+	var root map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &root); err != nil {
+		return result
+	}
+
+	result.fill(root["data"])
+	result.fill(raw)
+	return result
+}
+
+func (r *CreateResult) fill(raw json.RawMessage) {
+	if len(raw) == 0 {
+		return
+	}
+
+	var id string
+	if err := json.Unmarshal(raw, &id); err == nil {
+		if id = strings.TrimSpace(id); r.Identifier == "" && isIdentifier(id) {
+			r.Identifier = id
+		}
+		return
+	}
+
+	var list []json.RawMessage
+	if err := json.Unmarshal(raw, &list); err == nil {
+		if len(list) > 0 {
+			r.fill(list[0])
+		}
+		return
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return
+	}
+	if r.Identifier == "" {
+		r.Identifier = firstString(fields, identifierKeys)
+	}
+	if r.ProcessID == "" {
+		r.ProcessID = firstString(fields, processIDKeys)
+	}
+}
+
+// isIdentifier reports whether a bare string in a create response looks like
+// a generated identifier rather than a message such as "Server created": a
+// single word of letters, digits, hyphens and underscores with a digit in it.
+func isIdentifier(s string) bool {
+	if s == "" || len(s) > 64 || !strings.ContainsAny(s, "0123456789") {
+		return false
+	}
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+func firstString(fields map[string]json.RawMessage, keys []string) string {
+	for _, key := range keys {
+		var value string
+		if err := json.Unmarshal(fields[key], &value); err == nil && value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
@@ -2,7 +2,7 @@ package goVPSie
 
 import (
 	"context"
-	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -10,6 +10,7 @@ var dataCenterBasePath = "/apps/v2/datacenter"
 
 type DataCenterService interface {
 	List(ctx context.Context, options *ListOptions) ([]DataCenter, error)
+	All(ctx context.Context, opts ...IterOption) iter.Seq2[DataCenter, error]
 }
 
 type dataCenterServiceHandler struct {
@@ -23,13 +24,13 @@ type DataCenter struct {
 	DcImage           string `json:"dc_image"`
 	State             string `json:"state"`
 	Country           string `json:"country"`
-	IsActive          int    `json:"is_active"`
+	IsActive          Flag   `json:"is_active"`
 	Identifier        string `json:"identifier"`
-	DefaultSelected   int    `json:"default_selected"`
-	IsDeleted         int    `json:"is_deleted"`
-	IsFipAvailable    int    `json:"is_fip_available"`
-	IsBucketAvailable int    `json:"is_bucket_available"`
-	IsPrivate         int    `json:"is_private"`
+	DefaultSelected   Flag   `json:"default_selected"`
+	IsDeleted         Flag   `json:"is_deleted"`
+	IsFipAvailable    Flag   `json:"is_fip_available"`
+	IsBucketAvailable Flag   `json:"is_bucket_available"`
+	IsPrivate         Flag   `json:"is_private"`
 }
 
 type DataCenterListRoot struct {
@@ -39,7 +40,10 @@ type DataCenterListRoot struct {
 }
 
 func (d *dataCenterServiceHandler) List(ctx context.Context, options *ListOptions) ([]DataCenter, error) {
-	path := fmt.Sprintf("%s?offset=%d&limit%d", dataCenterBasePath, options.Page, options.PerPage)
+	path, err := addOptions(dataCenterBasePath, options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil)
 
@@ -52,5 +56,11 @@ func (d *dataCenterServiceHandler) List(ctx context.Context, options *ListOption
 		return nil, err
 	}
 
+	options.setMeta(root.Total, len(root.Data))
 	return root.Data, nil
 }
+
+// All iterates over every data center, fetching pages lazily.
+func (d *dataCenterServiceHandler) All(ctx context.Context, opts ...IterOption) iter.Seq2[DataCenter, error] {
+	return paginate(ctx, d.List, opts)
+}
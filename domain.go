@@ -3,7 +3,11 @@ package goVPSie
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
 )
 
 var domainsPath = "/apps/v2/domains"
@@ -11,10 +15,13 @@ var domainPath = "/apps/v2/domain"
 
 type DomainService interface {
 	ListDomainByProject(ctx context.Context, options *ListOptions, projectIdentifier string) ([]Domain, error)
+	DomainsByProjectAll(ctx context.Context, projectIdentifier string, opts ...IterOption) iter.Seq2[Domain, error]
 	DnsRecord(ctx context.Context, domainIdentifier string, dnsRecord *DnsRecord) error
 	ListDomains(ctx context.Context, options *ListOptions) ([]Domain, error)
+	DomainsAll(ctx context.Context, opts ...IterOption) iter.Seq2[Domain, error]
 	ListAllDomains(ctx context.Context) ([]Domain, error)
 	ListDomainVpsies(ctx context.Context, options *ListOptions) ([]DomainVpsie, error)
+	DomainVpsiesAll(ctx context.Context, opts ...IterOption) iter.Seq2[DomainVpsie, error]
 	CreateDomain(ctx context.Context, createReq *CreateDomainRequest) error
 	GetDomainByVpsie(ctx context.Context, domainIdentifier string) ([]Domain, error)
 	UpdateReverse(ctx context.Context, reverseReq *ReverseRequest) error
@@ -37,15 +44,15 @@ var _ DomainService = &domainsServiceHandler{}
 type ListDomainRoot struct {
 	Error bool     `json:"error"`
 	Data  []Domain `json:"data"`
-	Total int      `json:"total "`
+	Total int      `json:"total"`
 }
 
 type Domain struct {
-	DomainName  string `json:"domain_name"`
-	Identifier  string `json:"identifier"`
-	NsValidated int    `json:"ns_validated"`
-	CreatedOn   string `json:"created_on"`
-	LastCheck   string `json:"last_check"`
+	DomainName  string    `json:"domain_name"`
+	Identifier  string    `json:"identifier"`
+	NsValidated int       `json:"ns_validated"`
+	CreatedOn   Timestamp `json:"created_on"`
+	LastCheck   string    `json:"last_check"`
 }
 
 type DnsRecord struct {
@@ -60,6 +67,53 @@ type DnsRecord struct {
 	Ttl      int    `json:"ttl"`
 }
 
+// Validate reports the invalid fields of r. SRV records also need a service,
+// protocol, weight and port, and MX and SRV records a priority.
+func (r DnsRecord) Validate() error {
+	var errs fieldErrors
+	validateRecord(&errs, r.Name, r.Type, r.Content, r.Ttl)
+
+	recordType := strings.ToUpper(r.Type)
+	if recordType == "MX" || recordType == "SRV" {
+		n, err := strconv.Atoi(r.Priority)
+		errs.check(err == nil && n >= 0 && n <= 65535, "priority", "must be between 0 and 65535, got %q", r.Priority)
+	}
+	if recordType == "SRV" {
+		errs.required("service", r.Service)
+		errs.check(isOneOf(strings.TrimPrefix(r.Protocol, "_"), "tcp", "udp", "tls"), "protocol", "must be tcp, udp or tls, got %q", r.Protocol)
+		n, err := strconv.Atoi(r.Weight)
+		errs.check(err == nil && n >= 0 && n <= 65535, "weight", "must be between 0 and 65535, got %q", r.Weight)
+		errs.check(isPortString(r.Port), "port", "must be a port between 1 and 65535, got %q", r.Port)
+	}
+	return errs.err()
+}
+
+// dnsRecordTypes lists the record types the API manages.
+var dnsRecordTypes = []string{"A", "AAAA", "CAA", "CNAME", "MX", "NS", "PTR", "SRV", "TXT"}
+
+// maxDnsTTL is the largest TTL RFC 2181 allows.
+const maxDnsTTL = 1<<31 - 1
+
+// validateRecord checks the fields shared by Record and DnsRecord.
+func validateRecord(errs *fieldErrors, name, recordType, content string, ttl int) {
+	errs.check(name == "@" || isDomainName(name), "name", "must be @ or a valid domain name, got %q", name)
+	errs.check(isOneOf(recordType, dnsRecordTypes...), "type", "must be one of %s, got %q", strings.Join(dnsRecordTypes, ", "), recordType)
+	errs.check(ttl >= 1 && ttl <= maxDnsTTL, "ttl", "must be between 1 and %d, got %d", maxDnsTTL, ttl)
+
+	switch strings.ToUpper(recordType) {
+	case "A":
+		addr, err := netip.ParseAddr(content)
+		errs.check(err == nil && addr.Is4(), "content", "must be an IPv4 address, got %q", content)
+	case "AAAA":
+		addr, err := netip.ParseAddr(content)
+		errs.check(err == nil && addr.Is6(), "content", "must be an IPv6 address, got %q", content)
+	case "CNAME", "MX", "NS", "PTR":
+		errs.check(isDomainName(content), "content", "must be a valid domain name, got %q", content)
+	default:
+		errs.required("content", content)
+	}
+}
+
 type ReverseRequest struct {
 	VmIdentifier     string `json:"vmIdentifier"`
 	Ip               string `json:"ip"`
@@ -67,6 +121,17 @@ type ReverseRequest struct {
 	HostName         string `json:"hostName"`
 }
 
+// Validate reports the invalid fields of r.
+func (r ReverseRequest) Validate() error {
+	var errs fieldErrors
+	errs.required("vmIdentifier", r.VmIdentifier)
+	errs.required("domainIdentifier", r.DomainIdentifier)
+	_, err := netip.ParseAddr(r.Ip)
+	errs.check(err == nil, "ip", "must be an IP address, got %q", r.Ip)
+	errs.check(isHostname(r.HostName), "hostName", "must be a valid host name, got %q", r.HostName)
+	return errs.err()
+}
+
 type DomainVpsie struct {
 	HostName     string `json:"hostname"`
 	IP           string `json:"ip"`
@@ -91,23 +156,56 @@ type CreateDomainRequest struct {
 	Domain            string   `json:"domain"`
 }
 
+// Validate reports the invalid fields of r.
+func (r CreateDomainRequest) Validate() error {
+	var errs fieldErrors
+	errs.required("projectIdentifier", r.ProjectIdentifier)
+	errs.check(isHostname(r.Domain) && strings.Contains(r.Domain, "."), "domain", "must be a valid domain name, got %q", r.Domain)
+	return errs.err()
+}
+
 type Record struct {
 	Name    string `json:"name"`
 	Content string `json:"content"`
 	Type    string `json:"type"`
 	TTL     int    `json:"ttl"`
 }
+
+// Validate reports the invalid fields of r.
+func (r Record) Validate() error {
+	var errs fieldErrors
+	validateRecord(&errs, r.Name, r.Type, r.Content, r.TTL)
+	return errs.err()
+}
+
 type CreateDnsRecordReq struct {
 	DomainIdentifier string `json:"domainIdentifier"`
 	Record           Record `json:"record"`
 }
 
+// Validate reports the invalid fields of r and its record.
+func (r CreateDnsRecordReq) Validate() error {
+	var errs fieldErrors
+	errs.required("domainIdentifier", r.DomainIdentifier)
+	errs.nested("record", r.Record.Validate())
+	return errs.err()
+}
+
 type UpdateDnsRecordReq struct {
 	DomainIdentifier string `json:"domainIdentifier"`
 	Current          Record `json:"current"`
 	New              Record `json:"new"`
 }
 
+// Validate reports the invalid fields of r and its records.
+func (r UpdateDnsRecordReq) Validate() error {
+	var errs fieldErrors
+	errs.required("domainIdentifier", r.DomainIdentifier)
+	errs.nested("current", r.Current.Validate())
+	errs.nested("new", r.New.Validate())
+	return errs.err()
+}
+
 type ReversePTR struct {
 	Ip           string `json:"ip"`
 	HostName     string `json:"host_name"`
@@ -120,7 +218,10 @@ type ListReversePTRRoot struct {
 }
 
 func (d *domainsServiceHandler) ListDomainByProject(ctx context.Context, options *ListOptions, projectIdentifier string) ([]Domain, error) {
-	path := fmt.Sprintf("%s/project/%s?offset=%d&limit%d", domainsPath, projectIdentifier, options.Page, options.PerPage)
+	path, err := addOptions(fmt.Sprintf("%s/project/%s", domainsPath, projectIdentifier), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -132,9 +233,17 @@ func (d *domainsServiceHandler) ListDomainByProject(ctx context.Context, options
 		return nil, err
 	}
 
+	options.setMeta(domains.Total, len(domains.Data))
 	return domains.Data, nil
 }
 
+// DomainsByProjectAll iterates over every domain of the project, fetching pages lazily.
+func (d *domainsServiceHandler) DomainsByProjectAll(ctx context.Context, projectIdentifier string, opts ...IterOption) iter.Seq2[Domain, error] {
+	return paginate(ctx, func(ctx context.Context, options *ListOptions) ([]Domain, error) {
+		return d.ListDomainByProject(ctx, options, projectIdentifier)
+	}, opts)
+}
+
 func (d *domainsServiceHandler) CreateDnsRecord(ctx context.Context, createReq CreateDnsRecordReq) error {
 	path := fmt.Sprintf("%s/dnsRecord", domainPath)
 
@@ -196,7 +305,10 @@ func (d *domainsServiceHandler) DnsRecord(ctx context.Context, domainIdentifier
 }
 
 func (d *domainsServiceHandler) ListDomains(ctx context.Context, options *ListOptions) ([]Domain, error) {
-	path := fmt.Sprintf("%s?offset=%d&limit=%d", domainsPath, options.Page, options.PerPage)
+	path, err := addOptions(domainsPath, options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -206,9 +318,15 @@ func (d *domainsServiceHandler) ListDomains(ctx context.Context, options *ListOp
 	if err = d.client.Do(ctx, req, &domains); err != nil {
 		return nil, err
 	}
+	options.setMeta(domains.Total, len(domains.Data))
 	return domains.Data, nil
 }
 
+// DomainsAll iterates over every domain, fetching pages lazily.
+func (d *domainsServiceHandler) DomainsAll(ctx context.Context, opts ...IterOption) iter.Seq2[Domain, error] {
+	return paginate(ctx, d.ListDomains, opts)
+}
+
 func (d *domainsServiceHandler) ListAllDomains(ctx context.Context) ([]Domain, error) {
 	path := fmt.Sprintf("%s", domainsPath)
 
@@ -224,7 +342,10 @@ func (d *domainsServiceHandler) ListAllDomains(ctx context.Context) ([]Domain, e
 }
 
 func (d *domainsServiceHandler) ListDomainVpsies(ctx context.Context, options *ListOptions) ([]DomainVpsie, error) {
-	path := fmt.Sprintf("%s/vms?offset=%d&limit=%d", domainsPath, options.Page, options.PerPage)
+	path, err := addOptions(fmt.Sprintf("%s/vms", domainsPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := d.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -235,12 +356,19 @@ func (d *domainsServiceHandler) ListDomainVpsies(ctx context.Context, options *L
 		return nil, err
 	}
 
+	options.setMeta(vpsies.Total, len(vpsies.Vpsie))
 	return vpsies.Vpsie, nil
 }
 
+// DomainVpsiesAll iterates over every server usable for DNS records, fetching pages lazily.
+func (d *domainsServiceHandler) DomainVpsiesAll(ctx context.Context, opts ...IterOption) iter.Seq2[DomainVpsie, error] {
+	return paginate(ctx, d.ListDomainVpsies, opts)
+}
+
 func (d *domainsServiceHandler) CreateDomain(ctx context.Context, createReq *CreateDomainRequest) error {
 	path := fmt.Sprintf("%s/add", domainPath)
 
+	createReq = withDefaultProject(d.client, createReq, func(r *CreateDomainRequest) *string { return &r.ProjectIdentifier })
 	req, err := d.client.NewRequest(ctx, http.MethodPost, path, createReq)
 	if err != nil {
 		return err
@@ -0,0 +1,118 @@
+package goVPSie
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Plan collects the mutating requests a client in dry-run mode would have
+// sent. It is safe for concurrent use.
+type Plan struct {
+	mu       sync.Mutex
+	requests []PlannedRequest
+}
+
+// PlannedRequest is a request captured by a Plan instead of being sent.
+type PlannedRequest struct {
+	Method string
+	// Path includes the query string, if any.
+	Path string
+	// Body is the JSON request body decoded into maps, slices and scalars, or
+	// nil for an empty body.
+	Body    any
+	RawBody json.RawMessage
+}
+
+func (r PlannedRequest) String() string {
+	if len(r.RawBody) == 0 {
+		return r.Method + " " + r.Path
+	}
+	return r.Method + " " + r.Path + " " + string(r.RawBody)
+}
+
+// Requests returns the captured requests in the order they were made.
+func (p *Plan) Requests() []PlannedRequest {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]PlannedRequest(nil), p.requests...)
+}
+
+// Reset empties the plan.
+func (p *Plan) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.requests = nil
+}
+
+// String lists the captured requests, one per line.
+func (p *Plan) String() string {
+	var b strings.Builder
+	for _, r := range p.Requests() {
+		b.WriteString(r.String())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func (p *Plan) add(req *http.Request) error {
+	planned := PlannedRequest{Method: req.Method, Path: req.URL.RequestURI()}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return fmt.Errorf("reading planned request body: %w", err)
+		}
+
+		if trimmed := strings.TrimSpace(string(body)); trimmed != "" {
+			planned.RawBody = json.RawMessage(trimmed)
+			if err := json.Unmarshal(body, &planned.Body); err != nil {
+				return fmt.Errorf("decoding planned request body: %w", err)
+			}
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.requests = append(p.requests, planned)
+	return nil
+}
+
+// SetDryRun switches the client to dry-run mode: POST, PUT, PATCH and DELETE
+// requests are added to plan and reported as successful without being sent,
+// leaving the response value untouched. Reads still reach the API, so code
+// that reads before it writes behaves as it would for real; they include the
+// POST requests that only read, such as LB.ListOffers and Bucket.Get, and the
+// logins and refreshes of a LoginTokenSource. A nil plan turns dry-run mode
+// off.
+func (c *Client) SetDryRun(plan *Plan) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.plan = plan
+}
+
+// planned captures req if the client is in dry-run mode and req would modify
+// something, reporting whether it did.
+func (c *Client) planned(req *http.Request) (bool, error) {
+	c.mu.RLock()
+	plan := c.plan
+	c.mu.RUnlock()
+
+	if plan == nil || isRead(req) || !authEnabled(req.Context()) {
+		return false, nil
+	}
+
+	switch req.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true, plan.add(req)
+	}
+	return false, nil
+}
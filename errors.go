@@ -0,0 +1,153 @@
+package goVPSie
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// maxErrorBodySnippet bounds how much of a non-JSON error body is copied into
+// APIError.Message.
+const maxErrorBodySnippet = 512
+
+// APIError is returned by Client.Do for every non-2xx response. It keeps the
+// HTTP status, the VPSie error payload and the request that produced it so
+// callers can tell a missing resource apart from a broken server.
+type APIError struct {
+	// HTTP status code of the response.
+	StatusCode int
+
+	// Code, Message and Stack as reported in the VPSie error payload. When the
+	// body is not JSON (for example an HTML page from a proxy) Code is zero and
+	// Message holds a snippet of the raw body.
+	Code    int
+	Message string
+	Stack   string
+
+	// Method and URL of the request that failed.
+	Method string
+	URL    string
+
+	// Header of the failed response.
+	Header http.Header
+
+	// Body is the raw response body.
+	Body []byte
+}
+
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+
+	if e.Code != 0 && e.Code != e.StatusCode {
+		return fmt.Sprintf("%s %s: %d (code %d): %s", e.Method, e.URL, e.StatusCode, e.Code, msg)
+	}
+
+	return fmt.Sprintf("%s %s: %d: %s", e.Method, e.URL, e.StatusCode, msg)
+}
+
+// RequestID returns the request identifier echoed by the API, if any.
+func (e *APIError) RequestID() string {
+	return requestID(e.Header)
+}
+
+func requestID(h http.Header) string {
+	for _, name := range []string{"X-Request-Id", "X-Correlation-Id"} {
+		if v := h.Get(name); v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
+
+func (e *APIError) is(status int) bool {
+	return e.StatusCode == status || e.Code == status
+}
+
+func newAPIError(res *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		Body:       body,
+	}
+
+	if res.Request != nil {
+		apiErr.Method = res.Request.Method
+		if res.Request.URL != nil {
+			apiErr.URL = res.Request.URL.String()
+		}
+	}
+
+	var errRsp ErrorRsp
+	if err := json.Unmarshal(body, &errRsp); err == nil && (errRsp.Message != "" || errRsp.Code != 0) {
+		apiErr.Code = errRsp.Code
+		apiErr.Message = errRsp.Message
+		apiErr.Stack = errRsp.Stack
+		return apiErr
+	}
+
+	snippet := strings.TrimSpace(string(body))
+	if len(snippet) > maxErrorBodySnippet {
+		snippet = snippet[:maxErrorBodySnippet] + "..."
+	}
+	apiErr.Message = snippet
+
+	return apiErr
+}
+
+// ResponseTooLargeError is returned by Client.Do when a response body is
+// larger than the client's maximum response size.
+type ResponseTooLargeError struct {
+	Limit int64
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("response body exceeds the limit of %d bytes", e.Limit)
+}
+
+func asAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+
+	return nil, false
+}
+
+// IsNotFound reports whether err is an APIError for a resource that does not exist.
+func IsNotFound(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.is(http.StatusNotFound)
+}
+
+// IsUnauthorized reports whether err is an APIError caused by missing or
+// invalid credentials.
+func IsUnauthorized(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && (apiErr.is(http.StatusUnauthorized) || apiErr.is(http.StatusForbidden))
+}
+
+// IsRateLimited reports whether err is an APIError returned because the
+// client exceeded the API rate limit.
+func IsRateLimited(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.is(http.StatusTooManyRequests)
+}
+
+// IsConflict reports whether err is an APIError caused by a conflicting
+// resource state, such as a duplicate name.
+func IsConflict(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.is(http.StatusConflict)
+}
+
+// IsServerError reports whether err is an APIError with a 5xx status.
+func IsServerError(err error) bool {
+	apiErr, ok := asAPIError(err)
+	return ok && apiErr.StatusCode >= http.StatusInternalServerError
+}
@@ -3,6 +3,7 @@ package goVPSie
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -10,6 +11,7 @@ var firewallBasePath = "/apps/v2/firewall"
 
 type FirewallService interface {
 	ListMacros(ctx context.Context, options *ListOptions) ([]Macros, error)
+	MacrosAll(ctx context.Context, opts ...IterOption) iter.Seq2[Macros, error]
 	RemoveGroupVm(ctx context.Context, vmId, groupId string) error
 }
 
@@ -27,7 +29,10 @@ type Macros struct {
 type ListMacrosRoot []Macros
 
 func (f *firewallServiceHandler) ListMacros(ctx context.Context, options *ListOptions) ([]Macros, error) {
-	path := fmt.Sprintf("%s/macros", firewallBasePath)
+	path, err := addOptions(fmt.Sprintf("%s/macros", firewallBasePath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := f.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -39,10 +44,16 @@ func (f *firewallServiceHandler) ListMacros(ctx context.Context, options *ListOp
 		return nil, err
 	}
 
+	options.setMeta(0, len(macros))
 	return macros, nil
 
 }
 
+// MacrosAll iterates over every firewall macro, fetching pages lazily.
+func (f *firewallServiceHandler) MacrosAll(ctx context.Context, opts ...IterOption) iter.Seq2[Macros, error] {
+	return paginate(ctx, f.ListMacros, opts)
+}
+
 func (f *firewallServiceHandler) RemoveGroupVm(ctx context.Context, vmId, groupId string) error {
 	path := fmt.Sprintf("%s/detach/group", firewallBasePath)
 
@@ -3,8 +3,10 @@ package goVPSie
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
-	"time"
+	"strconv"
+	"strings"
 )
 
 var firewallGroupBasePath = "/apps/v2/firewall"
@@ -12,6 +14,7 @@ var firewallGroupBasePath = "/apps/v2/firewall"
 type FirewallGroupService interface {
 	Create(ctx context.Context, groupName string, firewallUpdateReq []FirewallUpdateReq) error
 	List(ctx context.Context, options *ListOptions) ([]FirewallGroupListData, error)
+	All(ctx context.Context, opts ...IterOption) iter.Seq2[FirewallGroupListData, error]
 	Get(ctx context.Context, fwGroupId string) (*FirewallGroupDetailData, error)
 	Delete(ctx context.Context, fwGroupId string) error
 	Update(ctx context.Context, fwGroupReq *FirewallUpdateReq, fwGroupId string) error
@@ -47,8 +50,8 @@ type FirewallGroupListData struct {
 	ID            int64          `json:"id"`
 	GroupName     string         `json:"group_name"`
 	Identifier    string         `json:"identifier"`
-	CreatedOn     string         `json:"created_on"`
-	UpdatedOn     string         `json:"updated_on"`
+	CreatedOn     Timestamp      `json:"created_on"`
+	UpdatedOn     Timestamp      `json:"updated_on"`
 	InboundCount  int64          `json:"inbound_count"`
 	OutboundCount int64          `json:"outbound_count"`
 	Vms           int64          `json:"vms"`
@@ -85,27 +88,27 @@ type FirewallRule struct {
 	Proto      string    `json:"proto"`
 	Source     []string  `json:"source,omitempty"`
 	Sport      string    `json:"sport"`
-	Enable     int64     `json:"enable"`
+	Enable     Flag      `json:"enable"`
 	Iface      string    `json:"iface,omitempty"`
 	Log        string    `json:"log,omitempty"`
 	Macro      string    `json:"macro,omitempty"`
 	Identifier string    `json:"identifier"`
-	CreatedOn  time.Time `json:"created_on"`
-	UpdatedOn  time.Time `json:"updated_on"`
+	CreatedOn  Timestamp `json:"created_on"`
+	UpdatedOn  Timestamp `json:"updated_on"`
 }
 
 // Existing FirewallGroup unchanged
 type FirewallGroup struct {
-	UserName      string `json:"user_name"`
-	ID            int64  `json:"id"`
-	GroupName     string `json:"group_name"`
-	Identifier    string `json:"identifier"`
-	CreatedOn     string `json:"created_on"`
-	UpdatedOn     string `json:"updated_on"`
-	InboundCount  int64  `json:"inbound_count"`
-	OutboundCount int64  `json:"outbound_count"`
-	Vms           int64  `json:"vms"`
-	CreatedBy     int64  `json:"created_by"`
+	UserName      string    `json:"user_name"`
+	ID            int64     `json:"id"`
+	GroupName     string    `json:"group_name"`
+	Identifier    string    `json:"identifier"`
+	CreatedOn     Timestamp `json:"created_on"`
+	UpdatedOn     Timestamp `json:"updated_on"`
+	InboundCount  int64     `json:"inbound_count"`
+	OutboundCount int64     `json:"outbound_count"`
+	Vms           int64     `json:"vms"`
+	CreatedBy     int64     `json:"created_by"`
 }
 
 // Request struct for create/update remains mostly same
@@ -122,6 +125,42 @@ type FirewallUpdateReq struct {
 	Dest    []string `json:"dest,omitempty"`
 }
 
+// firewallProtocols lists the protocols firewall rules accept by name.
+var firewallProtocols = []string{"tcp", "udp", "icmp", "ipv6-icmp", "icmpv6", "sctp", "dccp", "gre", "esp", "ah", "igmp"}
+
+// Validate reports the invalid fields of r. Ports are only accepted with a
+// port-based protocol, unless a macro supplies them.
+func (r FirewallUpdateReq) Validate() error {
+	var errs fieldErrors
+	errs.check(isOneOf(r.Action, "ACCEPT", "DROP", "REJECT"), "action", "must be ACCEPT, DROP or REJECT, got %q", r.Action)
+	errs.check(isOneOf(r.Type, "in", "out"), "type", "must be in or out, got %q", r.Type)
+	errs.flag("enable", r.Enable)
+
+	if r.Proto != "" {
+		n, err := strconv.Atoi(r.Proto)
+		errs.check(isOneOf(r.Proto, firewallProtocols...) || err == nil && n >= 0 && n <= 255, "proto", "must be one of %s or a protocol number, got %q", strings.Join(firewallProtocols, ", "), r.Proto)
+	}
+
+	portBased := isOneOf(r.Proto, "tcp", "udp", "sctp", "dccp")
+	for _, p := range []struct{ field, ports string }{{"dport", r.Dport}, {"sport", r.Sport}} {
+		switch {
+		case p.ports == "":
+		case !isPortSpec(p.ports):
+			errs.add(p.field, "must be ports or port ranges between 1 and 65535, got %q", p.ports)
+		case !portBased && r.Macro == "":
+			errs.add(p.field, "requires proto tcp, udp, sctp or dccp, got %q", r.Proto)
+		}
+	}
+
+	for i, source := range r.Source {
+		errs.check(isAddressSpec(source), fmt.Sprintf("source[%d]", i), "must be an IP address, CIDR prefix or range, got %q", source)
+	}
+	for i, dest := range r.Dest {
+		errs.check(isAddressSpec(dest), fmt.Sprintf("dest[%d]", i), "must be an IP address, CIDR prefix or range, got %q", dest)
+	}
+	return errs.err()
+}
+
 type IpsetObj struct {
 	Ipset string `json:"ipset"`
 }
@@ -146,7 +185,10 @@ func (f *firewallGroupServiceHandler) Create(ctx context.Context, groupName stri
 }
 
 func (f *firewallGroupServiceHandler) List(ctx context.Context, options *ListOptions) ([]FirewallGroupListData, error) {
-	path := fmt.Sprintf("%s/groups", firewallGroupBasePath)
+	path, err := addOptions(fmt.Sprintf("%s/groups", firewallGroupBasePath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := f.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -159,9 +201,15 @@ func (f *firewallGroupServiceHandler) List(ctx context.Context, options *ListOpt
 		return nil, err
 	}
 
+	options.setMeta(int(fwGroups.Total), len(fwGroups.Data))
 	return fwGroups.Data, nil
 }
 
+// All iterates over every firewall group, fetching pages lazily.
+func (f *firewallGroupServiceHandler) All(ctx context.Context, opts ...IterOption) iter.Seq2[FirewallGroupListData, error] {
+	return paginate(ctx, f.List, opts)
+}
+
 func (f *firewallGroupServiceHandler) Get(ctx context.Context, fwGroupId string) (*FirewallGroupDetailData, error) {
 	path := fmt.Sprintf("%s/group/%s", firewallGroupBasePath, fwGroupId)
 
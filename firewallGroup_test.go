@@ -1,24 +1,34 @@
-package goVPSie
+package goVPSie_test
 
 import (
 	"context"
 	"testing"
 
-	"golang.org/x/oauth2"
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+	"github.com/ahmedabdelkader99/goVPSie/govpsietest"
 )
 
 func TestFirewallGroupServiceHandlerDelete(t *testing.T) {
-	client := NewClient(oauth2.NewClient(context.Background(), nil))
+	fake := govpsietest.NewServer()
+	defer fake.Close()
+	fake.RequireToken(govpsietest.Token)
 
-	client.SetUserAgent(userAgent)
-	client.SetRequestHeaders(map[string]string{
-		"Vpsie-Auth": "sampleToken",
+	group := fake.AddFirewallGroup(goVPSie.FirewallGroupDetailData{
+		Group: goVPSie.FirewallGroup{GroupName: "web"},
 	})
 
-	err := client.FirewallGroup.Delete(context.Background(), "7dbcea52-f695-11ee-8bba-0050569c68dc")
+	client := fake.Client()
+	err := client.FirewallGroup.Delete(context.Background(), group.Group.Identifier)
 
 	if err != nil {
 		t.Error(err)
 	}
 
+	if groups := fake.FirewallGroups(); len(groups) != 0 {
+		t.Errorf("group was not deleted: %+v", groups)
+	}
+
+	if err := client.FirewallGroup.Delete(context.Background(), group.Group.Identifier); !goVPSie.IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
 }
@@ -3,14 +3,15 @@ package goVPSie
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
-	"time"
 )
 
 var gatewayPath = "/apps/v2/gateways"
 
 type GatewayService interface {
 	List(ctx context.Context, options *ListOptions) ([]Gateway, error)
+	All(ctx context.Context, opts ...IterOption) iter.Seq2[Gateway, error]
 	Delete(ctx context.Context, ipId int) error
 	Create(ctx context.Context, createReq *CreateGatewayReq) error
 	Get(ctx context.Context, id int64) (*Gateway, error)
@@ -46,15 +47,15 @@ type Gateway struct {
 	DatacenterID         int64        `json:"datacenter_id"`
 	IPPropertiesID       int64        `json:"ip_properties_id"`
 	IP                   string       `json:"ip"`
-	IsReserved           int64        `json:"is_reserved"`
+	IsReserved           Flag         `json:"is_reserved"`
 	IPVersion            string       `json:"ip_version"`
 	BoxID                *int64       `json:"box_id,omitempty"`
-	IsPrimary            int64        `json:"is_primary"`
+	IsPrimary            Flag         `json:"is_primary"`
 	Notes                *string      `json:"notes,omitempty"`
 	UserID               int64        `json:"user_id"`
-	UpdatedAt            time.Time    `json:"updated_at"`
-	IsGatewayReserved    int64        `json:"is_gateway_reserved"`
-	IsUserAccountGateway int64        `json:"is_user_account_gateway"`
+	UpdatedAt            Timestamp    `json:"updated_at"`
+	IsGatewayReserved    Flag         `json:"is_gateway_reserved"`
+	IsUserAccountGateway Flag         `json:"is_user_account_gateway"`
 	DatacenterName       string       `json:"datacenterName"`
 	State                string       `json:"state"`
 	DcIdentifier         string       `json:"dcIdentifier"`
@@ -73,8 +74,19 @@ type CreateGatewayReq struct {
 	DcIdentifier string   `json:"dcIdentifier"`
 }
 
+// Validate reports the invalid fields of r.
+func (r CreateGatewayReq) Validate() error {
+	var errs fieldErrors
+	errs.check(isOneOf(r.IPType, "ipv4", "ipv6"), "ipType", "must be ipv4 or ipv6, got %q", r.IPType)
+	errs.required("dcIdentifier", r.DcIdentifier)
+	return errs.err()
+}
+
 func (s *gatewayServiceHandler) List(ctx context.Context, options *ListOptions) ([]Gateway, error) {
-	path := fmt.Sprintf("%s/ips", gatewayPath)
+	path, err := addOptions(fmt.Sprintf("%s/ips", gatewayPath), options)
+	if err != nil {
+		return nil, err
+	}
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -85,9 +97,15 @@ func (s *gatewayServiceHandler) List(ctx context.Context, options *ListOptions)
 		return nil, err
 	}
 
+	options.setMeta(int(root.Data.Count), len(root.Data.Rows))
 	return root.Data.Rows, nil
 }
 
+// All iterates over every gateway, fetching pages lazily.
+func (s *gatewayServiceHandler) All(ctx context.Context, opts ...IterOption) iter.Seq2[Gateway, error] {
+	return paginate(ctx, s.List, opts)
+}
+
 func (s *gatewayServiceHandler) Create(ctx context.Context, createReq *CreateGatewayReq) error {
 	path := fmt.Sprintf("%s/add/ip", gatewayPath)
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, createReq)
@@ -6,8 +6,12 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"sync"
+
+	"golang.org/x/oauth2"
 )
 
 const (
@@ -15,19 +19,79 @@ const (
 	defaultBaseURL = "https://api.vpsie.com/api/v2"
 	userAgent      = "edeltacli/" + libraryVersion
 	mediaType      = "application/json"
+
+	defaultMaxResponseBytes = 64 << 20
 )
 
 type Client struct {
 	// HTTP client used to communicate with the VPSIE API.
 	client *http.Client
 
-	// Base URL for API requests.
+	// Base URL for API requests, as the client was constructed with.
+	//
+	// Deprecated: the field is kept so that code reading it still compiles,
+	// but the client never reads it back, so changing it has no effect.
+	// Read the base URL with GetBaseURL and set it with WithBaseURL.
 	BaseURL *url.URL
 
-	// User agent for client
+	// User agent for client, as the client was constructed with.
+	//
+	// Deprecated: the field is kept so that code reading it still compiles,
+	// but the client never reads it back, so changing it has no effect.
+	// Read the user agent with GetUserAgent and extend it with
+	// WithUserAgentSuffix.
 	UserAgent string
+
+	// Base URL and user agent requests are built with, guarded by mu
+	baseURL   *url.URL
+	userAgent string
 	headers   map[string]string
 
+	// Retry policy applied by Do
+	retry RetryPolicy
+
+	// Source of the Vpsie-Auth token sent with every request
+	tokenSource oauth2.TokenSource
+
+	// Client-side throttling
+	limiter *rateLimiter
+
+	// Optional logger for retried requests
+	logger *slog.Logger
+
+	// Middleware wrapping the transport, and the HTTP client running it
+	middleware []Middleware
+	chained    *http.Client
+
+	// Plan capturing mutating requests in dry-run mode
+	plan *Plan
+
+	// Optional cache of GET responses
+	cache *responseCache
+
+	// Largest response body Do reads
+	maxResponseBytes int64
+
+	// Optional reporter of schema drift in decoded responses
+	strict func(SchemaDrift)
+
+	// Whether request bodies are validated before they are sent
+	validate bool
+
+	// Optional idempotency keys and dedupe for create calls
+	idempotency *IdempotencyOptions
+
+	// Project of the profile the client was built from
+	defaultProject string
+
+	// mu guards the configuration above so the setters can be called while
+	// requests are in flight.
+	mu sync.RWMutex
+
+	// Rate-limit state reported by the API
+	rateMu sync.Mutex
+	rate   Rate
+
 	// services
 	Account       AccountService
 	Project       ProjectsService
@@ -71,11 +135,16 @@ type GeneralRspRoot struct {
 
 // ListOptions specifies the optional parameters to various List methods that support pagination.
 type ListOptions struct {
-	// For paginated result sets, page of results to retrieve.
+	// For paginated result sets, page of results to retrieve. Pages are
+	// zero-based and sent as the offset query parameter.
 	Page int `url:"page,omitempty"`
 
 	// For paginated result sets, the number of results to include per page.
 	PerPage int `url:"per_page,omitempty"`
+
+	// Meta is filled in by List methods with the total count and whether a
+	// next page exists.
+	Meta *Meta `url:"-"`
 }
 
 func NewClient(httpClient *http.Client) *Client {
@@ -87,8 +156,10 @@ func NewClient(httpClient *http.Client) *Client {
 
 	c := &Client{
 		client:    httpClient,
-		BaseURL:   baseURL,
-		UserAgent: userAgent,
+		baseURL:   baseURL,
+		userAgent: userAgent,
+
+		maxResponseBytes: defaultMaxResponseBytes,
 	}
 
 	c.Account = &accountServiceHandler{client: c}
@@ -119,22 +190,58 @@ func NewClient(httpClient *http.Client) *Client {
 	c.Monitoring = &monitoringServiceHandler{client: c}
 
 	c.headers = make(map[string]string)
+	c.exportConfig()
 	return c
 }
 
+// exportConfig copies the base URL and user agent to the deprecated public
+// fields, once the client is configured and before it is shared.
+func (c *Client) exportConfig() {
+	u := *c.baseURL
+	c.BaseURL, c.UserAgent = &u, c.userAgent
+}
+
+// SetRequestHeaders adds headers to every request. Requests already being
+// built keep the headers they started with.
 func (c *Client) SetRequestHeaders(headers map[string]string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
+	updated := make(map[string]string, len(c.headers)+len(headers))
+	for k, v := range c.headers {
+		updated[k] = v
+	}
 	for k, v := range headers {
-		c.headers[k] = v
+		updated[k] = v
 	}
+	c.headers = updated
 }
 
 // SetUserAgent Overrides the default UserAgent
+//
+// Deprecated: use NewClientWithOptions with WithUserAgentSuffix, so that the
+// user agent is set before the client is shared. SetUserAgent is safe for
+// concurrent use but, like SetBaseURL, leaves the deprecated field as it was.
 func (c *Client) SetUserAgent(ua string) {
-	c.UserAgent = ua
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.userAgent = ua
+}
+
+// GetUserAgent returns the User-Agent sent with every request.
+func (c *Client) GetUserAgent() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.userAgent
 }
 
 // SetBaseURL Overrides the default BaseUrl
+//
+// Deprecated: use NewClientWithOptions with WithBaseURL, so that the base URL
+// is set before the client is shared. SetBaseURL is safe for concurrent use
+// but leaves the deprecated BaseURL field as it was.
 func (c *Client) SetBaseURL(baseURL string) error {
 	updatedURL, err := url.Parse(baseURL)
 
@@ -142,13 +249,35 @@ func (c *Client) SetBaseURL(baseURL string) error {
 		return err
 	}
 
-	c.BaseURL = updatedURL
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.baseURL = updatedURL
 	return nil
 }
 
+// GetBaseURL returns a copy of the URL requests are resolved against.
+func (c *Client) GetBaseURL() *url.URL {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	u := *c.baseURL
+	return &u
+}
+
 // value pointed to by body is JSON encoded and included in as the request body.
 func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
-	u, err := c.BaseURL.Parse(urlStr)
+	c.mu.RLock()
+	baseURL, ua, headers, validate := c.baseURL, c.userAgent, c.headers, c.validate
+	c.mu.RUnlock()
+
+	if validate && body != nil {
+		if err := validateBody(body); err != nil {
+			return nil, err
+		}
+	}
+
+	u, err := baseURL.Parse(urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -170,60 +299,254 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 			}
 		}
 
-		req, err = http.NewRequest(method, u.String(), buf)
+		// A bytes.Reader lets http.NewRequest set GetBody, so the body can be
+		// replayed when Do retries the request.
+		req, err = http.NewRequest(method, u.String(), bytes.NewReader(buf.Bytes()))
 		if err != nil {
 			return nil, err
 		}
 		req.Header.Set("Content-Type", mediaType)
 	}
 
-	for k, v := range c.headers {
+	for k, v := range headers {
 		req.Header.Add(k, v)
 	}
 
 	req.Header.Set("Accept", mediaType)
-	req.Header.Set("User-Agent", c.UserAgent)
+	req.Header.Set("User-Agent", ua)
 
 	return req, nil
 }
 
+// Do sends an API request and JSON decodes the response into v. Responses
+// with a non-2xx status are returned as *APIError. Failed attempts are retried
+// according to the client's RetryPolicy, and a request rejected with 401 is
+// retried once with a fresh token when a token source is set.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) error {
-
 	req = req.WithContext(ctx)
-	res, err := c.client.Do(req)
-	if err != nil {
+
+	if planned, err := c.planned(req); planned || err != nil {
 		return err
 	}
 
-	defer res.Body.Close()
+	c.mu.RLock()
+	policy, logger, cache := c.retry, c.logger, c.cache
+	c.mu.RUnlock()
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return err
+	if cache != nil {
+		var cached []byte
+		var hit bool
+		if cached, req, hit = cache.lookup(req); hit {
+			return decodeBody(cached, v)
+		}
+	}
+
+	create := createCallFrom(ctx)
+	reauthorized := false
+	for attempt := 1; ; attempt++ {
+		sent, err := c.authorize(req)
+		if err != nil {
+			return err
+		}
+
+		res, err := c.send(sent, attempt)
+		if err == nil {
+			return c.decodeResponse(req, res, v, cache)
+		}
+		if cache != nil {
+			if cached, ok := cache.revalidated(req, err); ok {
+				return decodeBody(cached, v)
+			}
+		}
+
+		if apiErr, ok := asAPIError(err); ok && apiErr.StatusCode == http.StatusUnauthorized &&
+			!reauthorized && c.invalidateToken(sent) {
+			reauthorized = true
+			continue
+		}
+
+		if !policy.enabled(req.Method, attempt, create != nil && create.retryable) {
+			return err
+		}
+
+		delay := policy.backoff(attempt)
+		if apiErr, ok := asAPIError(err); ok {
+			if !policy.retryableStatus(apiErr.StatusCode) {
+				return err
+			}
+			if after, ok := retryAfter(&http.Response{Header: apiErr.Header}); ok {
+				delay = policy.retryAfterDelay(after)
+			}
+		} else if !policy.retryableError(ctx, err) {
+			return err
+		}
+
+		if logger != nil {
+			logger.DebugContext(ctx, "retrying request", "method", req.Method, "url", req.URL.String(),
+				"attempt", attempt+1, "delay", delay, "error", err)
+		}
+
+		if sleepErr := sleepContext(ctx, delay); sleepErr != nil {
+			return err
+		}
+
+		// The failed attempt may have created the resource anyway. If the
+		// lookup fails too, give up rather than risk a duplicate.
+		if create != nil {
+			identifier, lookupErr := create.lookup(ctx)
+			if lookupErr != nil {
+				return err
+			}
+			if identifier != "" {
+				if logger != nil {
+					logger.DebugContext(ctx, "create already succeeded", "method", req.Method, "url", req.URL.String(),
+						"identifier", identifier)
+				}
+				return decodeBody(createdBody(identifier), v)
+			}
+		}
+	}
+}
+
+func decodeBody(body []byte, v interface{}) error {
+	if v != nil && len(body) > 0 {
+		return json.Unmarshal(body, v)
+	}
+	return nil
+}
+
+// send performs a single attempt of req. A non-2xx response is returned as an
+// *APIError; otherwise the caller must close the response body.
+func (c *Client) send(req *http.Request, attempt int) (*http.Response, error) {
+	if attempt > 1 && req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return nil, errors.New("request body cannot be replayed")
+		}
+
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.Body = body
+	}
+
+	c.mu.RLock()
+	limiter, httpClient, limit := c.limiter, c.chained, c.maxResponseBytes
+	c.mu.RUnlock()
+
+	if httpClient == nil {
+		httpClient = c.client
+	}
+
+	if err := limiter.wait(req.Context(), req.Method); err != nil {
+		return nil, err
 	}
 
-	if res.StatusCode == http.StatusNoContent {
-		return nil
+	req = req.WithContext(withAttempt(req.Context(), attempt))
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
 	}
 
+	c.updateRate(res)
+
 	if res.StatusCode < http.StatusOK || res.StatusCode >= 300 {
-		var errRsp ErrorRsp
-		if err := json.Unmarshal(body, &errRsp); err != nil {
-			return err
+		defer res.Body.Close()
+
+		// Error bodies are kept whole on the APIError, up to the size limit.
+		body, err := io.ReadAll(io.LimitReader(res.Body, limit))
+		if err != nil {
+			return nil, err
+		}
+		return nil, newAPIError(res, body)
+	}
+
+	return res, nil
+}
+
+// decodeResponse streams the body of a successful response into v. The body
+// is only buffered when the response is cached or checked for schema drift.
+func (c *Client) decodeResponse(req *http.Request, res *http.Response, v interface{}, cache *responseCache) error {
+	defer res.Body.Close()
+
+	c.mu.RLock()
+	limit, strict := c.maxResponseBytes, c.strict
+	c.mu.RUnlock()
+
+	if v == nil {
+		if cache != nil {
+			cache.mutated(req)
 		}
+		_, err := io.Copy(io.Discard, io.LimitReader(res.Body, limit))
+		return err
+	}
+
+	var body io.Reader = &maxBytesReader{r: res.Body, remaining: limit, limit: limit}
 
-		return errors.New(errRsp.Message)
+	if cache != nil {
+		cache.mutated(req)
 	}
 
-	if v != nil {
-		if err := json.Unmarshal(body, v); err != nil {
-			return err
+	var raw *bytes.Buffer
+	if strict != nil || (cache != nil && cache.cacheable(req)) {
+		raw = new(bytes.Buffer)
+		body = io.TeeReader(body, raw)
+	}
+
+	dec := json.NewDecoder(body)
+	if err := dec.Decode(v); err != nil {
+		if err == io.EOF {
+			// An empty body leaves v untouched.
+			return nil
+		}
+		return err
+	}
+
+	// Reject trailing data like json.Unmarshal does. This also drains the
+	// body so that the connection can be reused.
+	if _, err := dec.Token(); err != io.EOF {
+		if err == nil {
+			err = errors.New("unexpected data after JSON response")
 		}
+		return err
 	}
 
+	if strict != nil {
+		checkSchema(req, raw.Bytes(), v, strict)
+	}
+	if cache != nil && cache.cacheable(req) {
+		cache.store(req, raw.Bytes(), res.Header)
+	}
 	return nil
 }
 
+// maxBytesReader fails with a *ResponseTooLargeError once more than limit
+// bytes have been read.
+type maxBytesReader struct {
+	r         io.Reader
+	remaining int64
+	limit     int64
+}
+
+func (m *maxBytesReader) Read(p []byte) (int, error) {
+	if m.remaining <= 0 {
+		var probe [1]byte
+		n, err := m.r.Read(probe[:])
+		if n > 0 {
+			return 0, &ResponseTooLargeError{Limit: m.limit}
+		}
+		return 0, err
+	}
+
+	if int64(len(p)) > m.remaining {
+		p = p[:m.remaining]
+	}
+	n, err := m.r.Read(p)
+	m.remaining -= int64(n)
+	return n, err
+}
+
 // StreamToString converts a reader to a string
 func StreamToString(stream io.Reader) string {
 	buf := new(bytes.Buffer)
@@ -0,0 +1,1381 @@
+package goVPSie
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	client := NewClient(srv.Client())
+	if err := client.SetBaseURL(srv.URL); err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func TestClientDoAPIError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-1")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":true,"code":404,"message":"vm not found","stack":"trace"}`))
+	})
+
+	_, err := client.Server.GetServerByIdentifier(context.Background(), "missing")
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T", err)
+	}
+	if apiErr.Message != "vm not found" || apiErr.Stack != "trace" || apiErr.Method != http.MethodGet {
+		t.Errorf("unexpected error fields: %+v", apiErr)
+	}
+	if apiErr.RequestID() != "req-1" {
+		t.Errorf("expected request id req-1, got %q", apiErr.RequestID())
+	}
+}
+
+func TestClientDoNonJSONError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte("<html>bad gateway</html>"))
+	})
+
+	err := client.Server.StartServer(context.Background(), "vm-1")
+	if !IsServerError(err) || IsNotFound(err) {
+		t.Fatalf("expected server error, got %v", err)
+	}
+
+	apiErr, _ := asAPIError(err)
+	if apiErr.StatusCode != http.StatusBadGateway || apiErr.Message != "<html>bad gateway</html>" {
+		t.Errorf("unexpected error fields: %+v", apiErr)
+	}
+}
+
+func TestClientDoRetriesReplayBody(t *testing.T) {
+	var attempts int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), `"vmIdentifier":"vm-1"`) {
+			t.Errorf("attempt %d: unexpected body %q", attempts, body)
+		}
+
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"error":false}`))
+	})
+
+	policy := DefaultRetryPolicy()
+	policy.BaseBackoff = time.Millisecond
+	policy.RetryableMethods = append(policy.RetryableMethods, http.MethodPost)
+	client.SetRetryPolicy(policy)
+
+	if err := client.Server.StartServer(context.Background(), "vm-1"); err != nil {
+		t.Fatal(err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestClientDoRetryStopsOnContextCancel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	policy := DefaultRetryPolicy()
+	policy.BaseBackoff = time.Hour
+	client.SetRetryPolicy(policy)
+
+	_, err := client.Server.List(ctx, &ListOptions{})
+	if !IsRateLimited(err) {
+		t.Fatalf("expected rate limited error, got %v", err)
+	}
+}
+
+func TestClientDoRetriesAttemptTimeout(t *testing.T) {
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+			return
+		}
+		_, _ = w.Write([]byte(`{"error":false,"data":[]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	httpClient := srv.Client()
+	httpClient.Timeout = 20 * time.Millisecond
+	client := NewClient(httpClient)
+	if err := client.SetBaseURL(srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	policy := DefaultRetryPolicy()
+	policy.BaseBackoff = time.Millisecond
+	client.SetRetryPolicy(policy)
+
+	if _, err := client.Server.List(context.Background(), &ListOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := attempts.Load(); got != 2 {
+		t.Errorf("expected 2 attempts, got %d", got)
+	}
+}
+
+func TestClientDoRetryClassifiesErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   error
+		retry bool
+	}{
+		{"connection reset", &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}, true},
+		{"connection refused", &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}, true},
+		{"truncated response", io.ErrUnexpectedEOF, true},
+		{"untrusted certificate", x509.UnknownAuthorityError{}, false},
+		{"other", errors.New("unsupported protocol scheme"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"error":false,"data":[]}`))
+			})
+			client.Use(func(next http.RoundTripper) http.RoundTripper {
+				return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					if attempts++; attempts == 1 {
+						return nil, tt.err
+					}
+					return next.RoundTrip(req)
+				})
+			})
+			policy := DefaultRetryPolicy()
+			policy.BaseBackoff = time.Millisecond
+			client.SetRetryPolicy(policy)
+
+			_, err := client.Server.List(context.Background(), &ListOptions{})
+			if retried := attempts == 2; retried != tt.retry || (err == nil) != tt.retry {
+				t.Errorf("attempts = %d, err = %v; want retry %v", attempts, err, tt.retry)
+			}
+		})
+	}
+}
+
+func TestClientDoRetryAfterCapped(t *testing.T) {
+	var attempts int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if attempts++; attempts == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"error":false,"data":[]}`))
+	})
+	policy := DefaultRetryPolicy()
+	policy.MaxBackoff = 10 * time.Millisecond
+	client.SetRetryPolicy(policy)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := client.Server.List(ctx, &ListOptions{}); err != nil {
+		t.Fatalf("expected the Retry-After delay to be capped, got %v", err)
+	}
+}
+
+func TestClientRateLimit(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		w.Header().Set("X-RateLimit-Reset", "30")
+		_, _ = w.Write([]byte(`{"error":false,"data":[]}`))
+	})
+	client.SetRateLimit(RateLimit{RequestsPerSecond: 0.001, Burst: 1})
+
+	if _, err := client.DataCenter.List(context.Background(), &ListOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	rate := client.Rate()
+	if rate.Limit != 100 || rate.Remaining != 42 || rate.Reset.IsZero() {
+		t.Errorf("unexpected rate: %+v", rate)
+	}
+
+	// The bucket is empty now, so the next call blocks until ctx expires.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := client.DataCenter.List(ctx, &ListOptions{}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestClientRateLimitPerMethod(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"error":false,"data":[]}`))
+	})
+	client.SetRateLimit(RateLimit{
+		RequestsPerSecond: 1000,
+		Burst:             10,
+		PerMethod:         map[string]RateLimit{"get": {RequestsPerSecond: 0.001, Burst: 1}},
+	})
+
+	if _, err := client.DataCenter.List(context.Background(), &ListOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The lowercase key limits GET requests, whose bucket is empty now.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := client.DataCenter.List(ctx, &ListOptions{}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestListOptionsPagination(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query(); r.URL.RawQuery != "" && (got.Get("offset") != "1" || got.Get("limit") != "2") {
+			t.Errorf("unexpected query %q", r.URL.RawQuery)
+		}
+		_, _ = w.Write([]byte(`{"error":false,"data":[{"id":3},{"id":4}],"total":5}`))
+	})
+
+	opts := &ListOptions{Page: 1, PerPage: 2}
+	ips, err := client.IP.ListAllIPs(context.Background(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ips) != 2 || opts.Meta == nil {
+		t.Fatalf("unexpected result %v, meta %v", ips, opts.Meta)
+	}
+	if opts.Meta.Total != 5 || !opts.Meta.HasNext {
+		t.Errorf("unexpected meta %+v", opts.Meta)
+	}
+
+	if _, err := client.IP.ListAllIPs(context.Background(), nil); err != nil {
+		t.Fatalf("nil options: %v", err)
+	}
+
+	opts = &ListOptions{Page: 1, PerPage: 2}
+	if _, err := client.Bucket.List(context.Background(), opts); err != nil {
+		t.Fatal(err)
+	}
+	if opts.Meta == nil || opts.Meta.Total != 5 || !opts.Meta.HasNext {
+		t.Errorf("unexpected bucket meta %+v", opts.Meta)
+	}
+}
+
+func TestIterAll(t *testing.T) {
+	var requests atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		page, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		var data []IP
+		for id := page*2 + 1; id <= min(page*2+2, 5); id++ {
+			data = append(data, IP{ID: id})
+		}
+		_ = json.NewEncoder(w).Encode(ListIPsRoot{Data: data, Total: 5})
+	})
+
+	for _, opts := range [][]IterOption{{WithPageSize(2)}, {WithPageSize(2), WithPrefetch()}} {
+		requests.Store(0)
+		var got []int
+		for ip, err := range client.IP.All(context.Background(), opts...) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, ip.ID)
+		}
+		if !slices.Equal(got, []int{1, 2, 3, 4, 5}) || requests.Load() != 3 {
+			t.Errorf("got %v after %d requests", got, requests.Load())
+		}
+	}
+
+	requests.Store(0)
+	for range client.IP.All(context.Background(), WithPageSize(2)) {
+		break
+	}
+	if requests.Load() != 1 {
+		t.Errorf("break fetched %d pages", requests.Load())
+	}
+}
+
+func TestLoginTokenSource(t *testing.T) {
+	var logins, refreshes atomic.Int32
+	var revoked atomic.Bool
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/apps/v2/auth/from/api":
+			n := logins.Add(1)
+			fmt.Fprintf(w, `{"error":false,"token":{"access":{"token":"login-%d","expires":"%s"},"refresh":{"token":"refresh","expires":"%s"}}}`,
+				n, time.Now().Add(30*time.Second).Format(time.RFC3339), time.Now().Add(time.Hour).Format(time.RFC3339))
+		case "/apps/v2/auth/refresh":
+			if revoked.Load() {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			refreshes.Add(1)
+			fmt.Fprintf(w, `{"error":false,"token":{"access":{"token":"refreshed","expires":"%s"},"refresh":{"token":"refresh","expires":"%s"}}}`,
+				time.Now().Add(time.Hour).Format(time.RFC3339), time.Now().Add(time.Hour).Format(time.RFC3339))
+		default:
+			want := "refreshed"
+			if revoked.Load() {
+				want = "login-2"
+			}
+			if r.Header.Get("Vpsie-Auth") != want {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"error":false,"data":[]}`))
+		}
+	})
+	client.SetTokenSource(NewLoginTokenSource(client.Account, &LoginReq{ClientID: "id", ClientSecret: "secret"}))
+
+	// The login token expires within the leeway, so it is refreshed before use.
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.IP.ListAllIPs(context.Background(), nil); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if logins.Load() != 1 || refreshes.Load() != 1 {
+		t.Errorf("got %d logins and %d refreshes", logins.Load(), refreshes.Load())
+	}
+
+	// Once the tokens are revoked, a 401 leads to a new login and one retry.
+	revoked.Store(true)
+	if _, err := client.IP.ListAllIPs(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if logins.Load() != 2 {
+		t.Errorf("got %d logins after revocation", logins.Load())
+	}
+}
+
+func TestNewClientWithOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Vpsie-Auth"); got != "token" {
+			t.Errorf("unexpected token %q", got)
+		}
+		if got := r.Header.Get("User-Agent"); got != userAgent+" test/1.0" {
+			t.Errorf("unexpected user agent %q", got)
+		}
+		_, _ = w.Write([]byte(`{"error":false,"data":[]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewClientWithOptions(
+		WithBaseURL(server.URL),
+		WithUserAgentSuffix("test/1.0"),
+		WithAuthToken("token"),
+		WithTimeout(time.Second),
+		WithRetryPolicy(DefaultRetryPolicy()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := client.GetBaseURL().String(); got != server.URL {
+		t.Errorf("unexpected base URL %q", got)
+	}
+	if got := client.GetUserAgent(); got != userAgent+" test/1.0" {
+		t.Errorf("unexpected user agent %q", got)
+	}
+
+	// The deprecated fields only report the configuration; writing them
+	// changes nothing.
+	if client.BaseURL.String() != server.URL || client.UserAgent != userAgent+" test/1.0" {
+		t.Errorf("unexpected fields %s, %q", client.BaseURL, client.UserAgent)
+	}
+	client.BaseURL.Host = "invalid.example"
+	client.UserAgent = "other"
+	if got := client.GetBaseURL().String(); got != server.URL {
+		t.Errorf("base URL changed by the field to %q", got)
+	}
+	if got := client.GetUserAgent(); got != userAgent+" test/1.0" {
+		t.Errorf("user agent changed by the field to %q", got)
+	}
+
+	var wg sync.WaitGroup
+	for i := range 5 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			client.SetRequestHeaders(map[string]string{"X-Test": strconv.Itoa(i)})
+		}()
+		go func() {
+			defer wg.Done()
+			if _, err := client.IP.ListAllIPs(context.Background(), nil); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, opt := range []ClientOption{
+		WithBaseURL("api.vpsie.com"),
+		WithTimeout(-time.Second),
+		WithAuthToken(""),
+		WithRetryPolicy(RetryPolicy{Jitter: 2}),
+	} {
+		if _, err := NewClientWithOptions(opt); err == nil {
+			t.Error("expected an invalid option to fail")
+		}
+	}
+}
+
+func TestWaitForState(t *testing.T) {
+	var polls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/apps/v2/vm/status/vm-1" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+
+		switch polls.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			_, _ = w.Write([]byte(`{"error":false,"data":{"status":"starting"}}`))
+		default:
+			_, _ = w.Write([]byte(`{"error":false,"data":{"status":"running"}}`))
+		}
+	})
+
+	var progress []WaitProgress
+	opts := &WaitOptions{
+		PollInterval: time.Millisecond,
+		Multiplier:   2,
+		OnProgress:   func(p WaitProgress) { progress = append(progress, p) },
+	}
+	status, err := client.Server.WaitForState(context.Background(), "vm-1", "Running", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Status != "running" || len(progress) != 3 || progress[0].Err == nil {
+		t.Errorf("unexpected status %+v after %d polls", status, len(progress))
+	}
+
+	opts = &WaitOptions{PollInterval: time.Millisecond, Timeout: 20 * time.Millisecond}
+	_, err = client.Server.WaitForState(context.Background(), "vm-1", "stopped", opts)
+
+	var timeout *WaitTimeoutError
+	if !errors.As(err, &timeout) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a timeout, got %v", err)
+	}
+	if timeout.LastStatus == nil || timeout.LastStatus.Status != "running" {
+		t.Errorf("unexpected last status %+v", timeout.LastStatus)
+	}
+}
+
+func TestCreateServerWithResult(t *testing.T) {
+	bodies := map[string]CreateResult{
+		`{"error":false,"data":{"vmIdentifier":"vm-1","processId":"p-1"}}`: {Identifier: "vm-1", ProcessID: "p-1"},
+		`{"error":false,"data":"vm-2"}`:                                    {Identifier: "vm-2"},
+		`{"error":false,"data":[{"storageIdentifier":"st-1"}]}`:            {Identifier: "st-1"},
+		`{"error":false,"processId":"p-2"}`:                                {ProcessID: "p-2"},
+		`{"error":false,"data":"Server created"}`:                          {},
+		`{"error":false,"data":{"message":"vm-3 queued","status":"ok"}}`:   {},
+	}
+
+	for body, want := range bodies {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(body))
+		})
+
+		got, err := client.Server.CreateServerWithResult(context.Background(), &CreateServerRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Identifier != want.Identifier || got.ProcessID != want.ProcessID || string(got.Raw) != body {
+			t.Errorf("%s: got %+v", body, got)
+		}
+	}
+}
+
+func TestLoggingMiddleware(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-1")
+		_, _ = w.Write([]byte(`{"error":false,"token":{"access":{"token":"secret-token"}},"data":{"initial_password":"hunter2"}}`))
+	})
+
+	var seen []string
+	var logs bytes.Buffer
+	client.Use(
+		func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				seen = append(seen, fmt.Sprintf("%s#%d", req.URL.Path, Attempt(req.Context())))
+				return next.RoundTrip(req)
+			})
+		},
+		LoggingMiddleware(slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})), &LogOptions{Bodies: true}),
+	)
+
+	if _, err := client.Account.Login(context.Background(), &LoginReq{ClientID: "id", ClientSecret: "s3cret"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(seen) != 1 || seen[0] != "/apps/v2/auth/from/api#1" {
+		t.Errorf("unexpected requests %v", seen)
+	}
+
+	var record map[string]any
+	if err := json.Unmarshal(logs.Bytes(), &record); err != nil {
+		t.Fatalf("%v: %s", err, logs.String())
+	}
+	if record["status"] != float64(200) || record["request_id"] != "req-1" || record["method"] != "POST" || record["level"] != "DEBUG" {
+		t.Errorf("unexpected record %v", record)
+	}
+	for _, secret := range []string{"s3cret", "hunter2", "secret-token"} {
+		if strings.Contains(logs.String(), secret) {
+			t.Errorf("log leaks %q: %s", secret, logs.String())
+		}
+	}
+}
+
+func TestLoggingMiddlewareClientError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":true,"message":"vm not found"}`))
+	})
+
+	var logs bytes.Buffer
+	client.Use(LoggingMiddleware(slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})), nil))
+
+	if _, err := client.Server.GetServerByIdentifier(context.Background(), "missing"); !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	var record map[string]any
+	if err := json.Unmarshal(logs.Bytes(), &record); err != nil {
+		t.Fatalf("%v: %s", err, logs.String())
+	}
+	if record["status"] != float64(404) || record["level"] != "WARN" {
+		t.Errorf("unexpected record %v", record)
+	}
+}
+
+func TestLoggingMiddlewareLargeBody(t *testing.T) {
+	vms := `{"identifier":"vm-1","initial_password":"hunter2"}` + strings.Repeat(`,{"identifier":"vm-2"}`, 1000)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"error":false,"data":[%s]}`, vms)
+	})
+	client.maxResponseBytes = 1024
+
+	// read counts the bytes taken from the connection, below the logger.
+	var read atomic.Int64
+	var logs bytes.Buffer
+	client.Use(
+		LoggingMiddleware(slog.New(slog.NewJSONHandler(&logs, nil)), &LogOptions{Level: slog.LevelInfo, Bodies: true, MaxBodyBytes: 64}),
+		func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				res, err := next.RoundTrip(req)
+				if err == nil {
+					res.Body = struct {
+						io.Reader
+						io.Closer
+					}{io.TeeReader(res.Body, writerFunc(func(p []byte) { read.Add(int64(len(p))) })), res.Body}
+				}
+				return res, err
+			})
+		},
+	)
+
+	_, err := client.Server.List(context.Background(), nil)
+	var tooLarge *ResponseTooLargeError
+	if !errors.As(err, &tooLarge) {
+		t.Errorf("expected the size limit to hold with bodies logged, got %v", err)
+	}
+	if got := read.Load(); got > 4<<10 {
+		t.Errorf("read %d bytes of a response limited to 1024", got)
+	}
+	if !strings.Contains(logs.String(), "more than 64 bytes") || strings.Contains(logs.String(), "hunter2") {
+		t.Errorf("unexpected log %s", logs.String())
+	}
+}
+
+type writerFunc func([]byte)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	f(p)
+	return len(p), nil
+}
+
+func TestDryRun(t *testing.T) {
+	var methods []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		_, _ = w.Write([]byte(`{"error":false,"data":{"vmData":{"identifier":"vm-1","hostname":"web"}}}`))
+	})
+
+	plan := &Plan{}
+	client.SetDryRun(plan)
+
+	ctx := context.Background()
+	vm, err := client.Server.GetServerByIdentifier(ctx, "vm-1")
+	if err != nil || vm.Hostname != "web" {
+		t.Fatalf("GetServerByIdentifier = %+v, %v", vm, err)
+	}
+	if err := client.Server.StopServer(ctx, vm.Identifier); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Server.DeleteServer(ctx, vm.Identifier, "secret", "test", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(methods, []string{http.MethodGet}) {
+		t.Errorf("sent %v, want only the GET", methods)
+	}
+
+	requests := plan.Requests()
+	if len(requests) != 2 {
+		t.Fatalf("planned %d requests, want 2:\n%s", len(requests), plan)
+	}
+	if requests[0].Method != http.MethodPost || requests[0].Path != "/apps/v2/vm/stop" {
+		t.Errorf("first planned request = %s", requests[0])
+	}
+	body, ok := requests[1].Body.(map[string]any)
+	if requests[1].Method != http.MethodDelete || !ok || body["vmIdentifier"] != "vm-1" {
+		t.Errorf("second planned request = %s", requests[1])
+	}
+
+	client.SetDryRun(nil)
+	if err := client.Server.StopServer(ctx, vm.Identifier); err != nil {
+		t.Fatal(err)
+	}
+	if len(methods) != 2 || len(plan.Requests()) != 2 {
+		t.Errorf("after SetDryRun(nil): sent %v, planned %d", methods, len(plan.Requests()))
+	}
+}
+
+func TestDryRunReads(t *testing.T) {
+	var sent []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, r.Method+" "+r.URL.Path)
+		switch r.URL.Path {
+		case "/apps/v2/auth/from/api":
+			fmt.Fprintf(w, `{"error":false,"token":{"access":{"token":"login","expires":"%s"},"refresh":{"token":"refresh","expires":"%s"}}}`,
+				time.Now().Add(time.Hour).Format(time.RFC3339), time.Now().Add(time.Hour).Format(time.RFC3339))
+		default:
+			if r.Header.Get("Vpsie-Auth") != "login" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"error":false,"data":{"bucketName":"logs"}}`))
+		}
+	})
+	client.SetTokenSource(NewLoginTokenSource(client.Account, &LoginReq{ClientID: "id", ClientSecret: "secret"}))
+	plan := &Plan{}
+	client.SetDryRun(plan)
+
+	ctx := context.Background()
+	bucket, err := client.Bucket.Get(ctx, "bucket-1")
+	if err != nil || bucket.BucketName != "logs" {
+		t.Fatalf("Get = %+v, %v", bucket, err)
+	}
+	if err := client.Bucket.Delete(ctx, "bucket-1", "test", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"POST /apps/v2/auth/from/api", "POST /apps/v2/bucket"}; !slices.Equal(sent, want) {
+		t.Errorf("sent %v, want %v", sent, want)
+	}
+	if requests := plan.Requests(); len(requests) != 1 || requests[0].Method != http.MethodDelete {
+		t.Errorf("unexpected plan:\n%s", plan)
+	}
+}
+
+func TestResponseCache(t *testing.T) {
+	var gets, revalidations atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			_, _ = w.Write([]byte(`{"error":false}`))
+			return
+		}
+
+		gets.Add(1)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			revalidations.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(`{"error":false,"data":[{"dc_name":"Toronto"}],"total":1}`))
+	})
+	client.SetCache(&CacheOptions{TTLs: DefaultCacheTTLs()})
+
+	ctx := context.Background()
+	list := func() {
+		t.Helper()
+		dcs, err := client.DataCenter.List(ctx, nil)
+		if err != nil || len(dcs) != 1 || dcs[0].DcName != "Toronto" {
+			t.Fatalf("List = %+v, %v", dcs, err)
+		}
+	}
+
+	list()
+	list()
+	if gets.Load() != 1 {
+		t.Fatalf("fresh entry: %d requests, want 1", gets.Load())
+	}
+
+	for _, entry := range client.cache.entries {
+		entry.expires = time.Now().Add(-time.Second)
+	}
+	list()
+	list()
+	if gets.Load() != 2 || revalidations.Load() != 1 {
+		t.Fatalf("stale entry: %d requests and %d revalidations, want 2 and 1", gets.Load(), revalidations.Load())
+	}
+
+	req, err := client.NewRequest(ctx, http.MethodPost, dataCenterBasePath+"s/update", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Do(ctx, req, nil); err != nil {
+		t.Fatal(err)
+	}
+	list()
+	if gets.Load() != 3 {
+		t.Fatalf("after mutation: %d requests, want 3", gets.Load())
+	}
+
+	client.InvalidateCache(dataCenterBasePath)
+	list()
+	if gets.Load() != 4 {
+		t.Fatalf("after InvalidateCache: %d requests, want 4", gets.Load())
+	}
+}
+
+func TestResponseCacheReadOnlyPost(t *testing.T) {
+	requests := make(map[string]int)
+	var mu sync.Mutex
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		requests[r.Method+" "+r.URL.Path+" "+strings.TrimSpace(string(body))]++
+		mu.Unlock()
+		if strings.HasSuffix(r.URL.Path, "/offers") {
+			_, _ = w.Write([]byte(`{"error":false,"Data":[{"nickname":"small"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"error":false,"data":[],"total":0}`))
+	})
+	client.SetCache(&CacheOptions{TTLs: DefaultCacheTTLs()})
+	ctx := context.Background()
+
+	if _, err := client.LB.ListLBDataCenters(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	for _, dc := range []string{"dc-1", "dc-1", "dc-2"} {
+		offers, err := client.LB.ListOffers(ctx, dc)
+		if err != nil || len(offers) != 1 || offers[0].NickName != "small" {
+			t.Fatalf("ListOffers = %+v, %v", offers, err)
+		}
+	}
+	if _, err := client.LB.ListLBDataCenters(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]int{
+		"GET /api/v1/lb/datacenter ":                     1,
+		`POST /api/v1/lb/offers {"dcIdentifier":"dc-1"}`: 1,
+		`POST /api/v1/lb/offers {"dcIdentifier":"dc-2"}`: 1,
+	}
+	for key, n := range want {
+		if requests[key] != n {
+			t.Errorf("%s: %d requests, want %d", key, requests[key], n)
+		}
+	}
+	if len(requests) != len(want) {
+		t.Errorf("unexpected requests %q", requests)
+	}
+}
+
+func TestClientDoMaxResponseSize(t *testing.T) {
+	bodies := map[string]string{
+		"/small":    `{"error":false,"data":[]}`,
+		"/large":    `{"error":false,"data":["` + strings.Repeat("x", 2048) + `"]}`,
+		"/trailing": `{"error":false} {"error":true}`,
+	}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(bodies[r.URL.Path]))
+	})
+	client.maxResponseBytes = 1024
+
+	do := func(path string) error {
+		req, err := client.NewRequest(context.Background(), http.MethodGet, path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var root struct {
+			Error bool     `json:"error"`
+			Data  []string `json:"data"`
+		}
+		return client.Do(context.Background(), req, &root)
+	}
+
+	if err := do("/small"); err != nil {
+		t.Errorf("small response: %v", err)
+	}
+
+	var tooLarge *ResponseTooLargeError
+	if err := do("/large"); !errors.As(err, &tooLarge) || tooLarge.Limit != 1024 {
+		t.Errorf("large response: got %v, want ResponseTooLargeError", err)
+	}
+
+	if err := do("/trailing"); err == nil {
+		t.Error("trailing data: got nil error")
+	}
+}
+
+func TestStrictDecoding(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"error":false,"data":[{"dc_name":"Toronto","region":"ca"}],"total":1,"page":1}`))
+	})
+
+	report := &SchemaReport{}
+	client.SetStrictDecoding(report.Record)
+
+	dcs, err := client.DataCenter.List(context.Background(), nil)
+	if err != nil || len(dcs) != 1 || dcs[0].DcName != "Toronto" {
+		t.Fatalf("List = %+v, %v", dcs, err)
+	}
+
+	drifts := report.Drifts()
+	if len(drifts) != 1 {
+		t.Fatalf("got %d drifts, want 1:\n%s", len(drifts), report)
+	}
+
+	d := drifts[0]
+	if d.Endpoint != "GET /apps/v2/datacenter" || d.Type != "*goVPSie.DataCenterListRoot" {
+		t.Errorf("drift for %s (%s)", d.Endpoint, d.Type)
+	}
+	if !slices.Equal(d.Unknown, []string{"data[].region", "page"}) {
+		t.Errorf("Unknown = %v", d.Unknown)
+	}
+	if !slices.Contains(d.Missing, "data[].identifier") || slices.Contains(d.Missing, "data[].dc_name") {
+		t.Errorf("Missing = %v", d.Missing)
+	}
+
+	if got := endpointPattern("/apps/v2/vm/00000000-0000-4000-8000-000000000001/snapshots/42"); got != "/apps/v2/vm/{id}/snapshots/{id}" {
+		t.Errorf("endpointPattern = %q", got)
+	}
+}
+
+func TestTolerantTypes(t *testing.T) {
+	var v struct {
+		Times []Timestamp `json:"times"`
+		Flags []Flag      `json:"flags"`
+		Money []Decimal   `json:"money"`
+	}
+	body := `{
+		"times": ["2024-03-01T10:00:00Z", "2024-03-01 10:00:00", 1709287200, "1709287200000", "2024-03-01", null, "", "0000-00-00 00:00:00"],
+		"flags": [1, 0, "1", "0", true, false, null, "yes"],
+		"money": ["12.50", 12.5, "0", null, ""]
+	}`
+	if err := json.Unmarshal([]byte(body), &v); err != nil {
+		t.Fatal(err)
+	}
+
+	want := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	for i, ts := range v.Times[:4] {
+		if !ts.Equal(want) {
+			t.Errorf("Times[%d] = %v, want %v", i, ts, want)
+		}
+	}
+	if !v.Times[4].Equal(want.Truncate(24 * time.Hour)) {
+		t.Errorf("Times[4] = %v", v.Times[4])
+	}
+	for i, ts := range v.Times[5:] {
+		if !ts.IsZero() {
+			t.Errorf("Times[%d] = %v, want zero", i+5, ts)
+		}
+	}
+
+	var flags []bool
+	for _, f := range v.Flags {
+		flags = append(flags, f.Bool())
+	}
+	if !slices.Equal(flags, []bool{true, false, true, false, true, false, false, true}) {
+		t.Errorf("Flags = %v", v.Flags)
+	}
+
+	if v.Money[0].Float64() != 12.5 || v.Money[1].Rat().Cmp(v.Money[0].Rat()) != 0 {
+		t.Errorf("Money = %v", v.Money)
+	}
+	for i, d := range v.Money[2:] {
+		if !d.IsZero() {
+			t.Errorf("Money[%d] = %q, want zero", i+2, d)
+		}
+	}
+
+	// Unknown formats decode to the zero value and keep their text.
+	if err := json.Unmarshal([]byte(`{"times":["next tuesday"],"flags":["maybe"],"money":["n/a"]}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if ts := v.Times[0]; !ts.IsZero() || ts.Raw() != "next tuesday" {
+		t.Errorf("Times[0] = %v, raw %q", ts, ts.Raw())
+	}
+	if f := v.Flags[0]; f.Bool() || f.Raw() != "maybe" {
+		t.Errorf("Flags[0] = %v, raw %q", f, f.Raw())
+	}
+	if d := v.Money[0]; !d.IsZero() || d.String() != "" || d.Raw() != "n/a" {
+		t.Errorf("Money[0] = %q, raw %q", d, d.Raw())
+	}
+
+	// Non-finite values are not numbers either.
+	if err := json.Unmarshal([]byte(`{"money":["NaN","Inf","-Infinity"]}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	for i, d := range v.Money {
+		if d.String() != "" || d.Rat() != nil || d.Raw() == "" {
+			t.Errorf("Money[%d] = %q, raw %q", i, d, d.Raw())
+		}
+	}
+	if _, err := ParseDecimal("NaN"); err == nil {
+		t.Error("ParseDecimal accepted NaN")
+	}
+
+	price, err := ParseDecimal("12.50")
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := json.Marshal(struct {
+		T Timestamp
+		F Flag
+		D Decimal
+	}{Timestamp{Time: want}, NewFlag(true), price})
+	if err != nil || string(out) != `{"T":"2024-03-01T10:00:00Z","F":1,"D":"12.50"}` {
+		t.Errorf("Marshal = %s, %v", out, err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    Validator
+		fields []string
+	}{
+		{"server", CreateServerRequest{ResourceIdentifier: "r", OsIdentifier: "o", DcIdentifier: "d", ProjectID: "p", Hostname: "web-1.example.com"}, nil},
+		{"server hostname", CreateServerRequest{ResourceIdentifier: "r", OsIdentifier: "o", DcIdentifier: "d", ProjectID: "p", Hostname: "-web_1"}, []string{"hostname"}},
+		{"vpc", CreateVpcReq{Name: "net", DcIdentifier: "d", NetworkRange: "10.10.0.0", NetworkSize: "24"}, nil},
+		{"vpc auto", CreateVpcReq{Name: "net", DcIdentifier: "d", AutoGenerate: 1}, nil},
+		{"vpc network", CreateVpcReq{Name: "net", DcIdentifier: "d", NetworkRange: "10.10.0.300", NetworkSize: "x"}, []string{"networkRange", "networkSize"}},
+		{"vpc unaligned", CreateVpcReq{Name: "net", DcIdentifier: "d", NetworkRange: "10.10.0.5", NetworkSize: "24"}, []string{"networkRange"}},
+		{"firewall", FirewallUpdateReq{Action: "ACCEPT", Type: "in", Proto: "tcp", Dport: "80,443,8000:8080", Enable: 1, Source: []string{"10.0.0.0/8", "+trusted"}}, nil},
+		{"firewall macro", FirewallUpdateReq{Action: "accept", Type: "out", Macro: "SSH"}, nil},
+		{"firewall ports", FirewallUpdateReq{Action: "ACCEPT", Type: "in", Proto: "tcp", Dport: "8080:80", Sport: "70000"}, []string{"dport", "sport"}},
+		{"firewall proto", FirewallUpdateReq{Action: "ALLOW", Type: "in", Proto: "tcpp", Dport: "22", Source: []string{"10.0.0.0/33"}}, []string{"action", "proto", "dport", "source[0]"}},
+		{"dns", DnsRecord{Name: "www", Type: "A", Content: "192.0.2.1", Ttl: 3600}, nil},
+		{"dns ttl", DnsRecord{Name: "www", Type: "AAAA", Content: "192.0.2.1", Ttl: -1}, []string{"ttl", "content"}},
+		{"dns srv", DnsRecord{Name: "_sip._tcp", Type: "SRV", Content: "sip.example.com", Priority: "10", Weight: "x", Port: "5060", Ttl: 300}, []string{"service", "protocol", "weight"}},
+		{"lb no rules", CreateLBReq{LBName: "lb", Algorithm: "roundrobin", ResourceIdentifier: "r", DcIdentifier: "d"}, []string{"rules"}},
+		{"lb rules", CreateLBReq{LBName: "lb", Algorithm: "roundrobin", ResourceIdentifier: "r", DcIdentifier: "d", Rule: []Rule{
+			{Scheme: "http", FrontPort: "80", BackPort: "8080", Backends: []Backend{{VmIdentifier: "vm-1"}}},
+			{Scheme: "https", FrontPort: "443", Domains: []LBDomain{{DomainName: "example.com", BackPort: "0", Backends: []Backend{{Ip: "10.0.0"}}}}},
+		}}, []string{"rules[1].domains[0].backPort", "rules[1].domains[0].backends[0].ip"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if len(tt.fields) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+
+			var verr *ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("Validate() = %v, want a *ValidationError", err)
+			}
+			var fields []string
+			for _, fe := range verr.Errors {
+				fields = append(fields, fe.Field)
+			}
+			if !slices.Equal(fields, tt.fields) {
+				t.Errorf("invalid fields = %v, want %v (%v)", fields, tt.fields, err)
+			}
+		})
+	}
+}
+
+func TestClientValidation(t *testing.T) {
+	var requests atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write([]byte(`{"error":false}`))
+	})
+
+	ctx := context.Background()
+	rules := []FirewallUpdateReq{{Action: "ACCEPT", Type: "in", Proto: "gopher", Dport: "22"}}
+	if err := client.FirewallGroup.Create(ctx, "web", rules); err != nil {
+		t.Fatalf("Create without validation = %v", err)
+	}
+
+	client.SetValidation(true)
+	err := client.FirewallGroup.Create(ctx, "web", rules)
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Create with validation = %v, want a *ValidationError", err)
+	}
+	if _, ok := verr.Field("rules[0].proto"); !ok {
+		t.Errorf("errors = %v, want rules[0].proto", verr.Errors)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("sent %d requests, want 1", got)
+	}
+}
+
+func TestIdempotentCreate(t *testing.T) {
+	var keys []string
+	var mu sync.Mutex
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		attempt := len(keys)
+		mu.Unlock()
+
+		if attempt == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"error":false,"data":{"vmIdentifier":"vm-1"}}`))
+	})
+	policy := DefaultRetryPolicy()
+	policy.BaseBackoff = time.Millisecond
+	client.SetRetryPolicy(policy)
+
+	ctx := context.Background()
+	server := &CreateServerRequest{Hostname: "web", ProjectID: "p-1"}
+	if _, err := client.Server.CreateServerWithResult(ctx, server); err == nil {
+		t.Fatal("POST without an idempotency key was retried")
+	}
+
+	// The key alone is no reason to retry: the API may not honor it.
+	client.SetIdempotency(&IdempotencyOptions{})
+	keys = nil
+	if _, err := client.Server.CreateServerWithResult(ctx, server); err == nil || len(keys) != 1 || len(keys[0]) != 36 {
+		t.Fatalf("sent keys %q and got %v, want one attempt with a generated key", keys, err)
+	}
+
+	client.SetIdempotency(&IdempotencyOptions{HeaderHonored: true})
+	keys = nil
+	result, err := client.Server.CreateServerWithResult(WithIdempotencyKey(ctx, "key-1"), server)
+	if err != nil || result.Identifier != "vm-1" {
+		t.Fatalf("CreateServerWithResult = %+v, %v", result, err)
+	}
+	if !slices.Equal(keys, []string{"key-1", "key-1"}) {
+		t.Errorf("sent keys %q, want key-1 on both attempts", keys)
+	}
+
+	keys = nil
+	if _, err := client.Server.CreateServerWithResult(ctx, server); err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || len(keys[0]) != 36 || keys[0] != keys[1] {
+		t.Errorf("sent keys %q, want the same generated key on both attempts", keys)
+	}
+}
+
+func TestIdempotentCreateDedupe(t *testing.T) {
+	var posts atomic.Int32
+	created := time.Now().UTC().Format(time.DateTime)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			posts.Add(1)
+			w.WriteHeader(http.StatusGatewayTimeout)
+			return
+		}
+		fmt.Fprintf(w, `{"error":false,"total":3,"data":[
+			{"identifier":"vm-undated","hostname":"web"},
+			{"identifier":"vm-old","hostname":"web","created_on":"2020-01-01 00:00:00"},
+			{"identifier":"vm-new","hostname":"web","created_on":%q}]}`, created)
+	})
+	policy := DefaultRetryPolicy()
+	policy.BaseBackoff = time.Millisecond
+	client.SetRetryPolicy(policy)
+	client.SetIdempotency(&IdempotencyOptions{Dedupe: true})
+
+	result, err := client.Server.CreateServerWithResult(context.Background(), &CreateServerRequest{Hostname: "web", ProjectID: "p-1"})
+	if err != nil || result.Identifier != "vm-new" {
+		t.Fatalf("CreateServerWithResult = %+v, %v", result, err)
+	}
+	if got := posts.Load(); got != 1 {
+		t.Errorf("sent %d POSTs, want 1", got)
+	}
+}
+
+func TestIdempotentCreateDedupeAfterTimeout(t *testing.T) {
+	var posts, lookups atomic.Int32
+	created := time.Now().UTC().Format(time.DateTime)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			// The server creates the VM but answers after the client gave up.
+			posts.Add(1)
+			_, _ = io.Copy(io.Discard, r.Body)
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+			return
+		}
+		lookups.Add(1)
+		fmt.Fprintf(w, `{"error":false,"total":1,"data":[{"identifier":"vm-new","hostname":"web","created_on":%q}]}`, created)
+	}))
+	t.Cleanup(srv.Close)
+
+	policy := DefaultRetryPolicy()
+	policy.BaseBackoff = time.Millisecond
+	client, err := NewClientWithOptions(
+		WithBaseURL(srv.URL),
+		WithTimeout(20*time.Millisecond),
+		WithRetryPolicy(policy),
+		WithIdempotency(IdempotencyOptions{Dedupe: true}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.Server.CreateServerWithResult(context.Background(), &CreateServerRequest{Hostname: "web", ProjectID: "p-1"})
+	if err != nil || result.Identifier != "vm-new" {
+		t.Fatalf("CreateServerWithResult = %+v, %v", result, err)
+	}
+	if got := posts.Load(); got != 1 {
+		t.Errorf("sent %d POSTs, want 1", got)
+	}
+	if got := lookups.Load(); got != 1 {
+		t.Errorf("ran %d dedupe lookups, want 1", got)
+	}
+}
+
+func TestBulk(t *testing.T) {
+	var running, peak atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		var body ActionRequest
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if strings.HasPrefix(body.VmIdentifier, "bad") {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error":true,"message":"locked"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"error":false}`))
+	})
+
+	ids := make([]string, 20)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("vm-%d", i)
+	}
+	ids[3], ids[11] = "bad-3", "bad-11"
+
+	var reported atomic.Int32
+	results, err := client.Server.StartMany(context.Background(), ids, &BulkOptions{
+		Concurrency: 3,
+		OnResult:    func(BulkResult) { reported.Add(1) },
+	})
+
+	var bulkErr *BulkError
+	if !errors.As(err, &bulkErr) || bulkErr.Total != 20 || len(bulkErr.Failed) != 2 {
+		t.Fatalf("StartMany error = %v", err)
+	}
+	if bulkErr.Failed[0].Identifier != "bad-3" || bulkErr.Failed[1].Identifier != "bad-11" {
+		t.Errorf("failed = %+v", bulkErr.Failed)
+	}
+	if apiErr, ok := asAPIError(err); !ok || apiErr.StatusCode != http.StatusConflict {
+		t.Errorf("errors.As(APIError) through BulkError = %v", apiErr)
+	}
+	if len(results) != 20 || results[0].Identifier != "vm-0" || results[0].Err != nil {
+		t.Errorf("results = %+v", results)
+	}
+	if got := peak.Load(); got > 3 {
+		t.Errorf("ran %d operations at once, want at most 3", got)
+	}
+	if got := reported.Load(); got != 20 {
+		t.Errorf("OnResult called %d times, want 20", got)
+	}
+
+	results, err = client.Server.StartMany(context.Background(), []string{"bad-0", "vm-1", "vm-2"}, &BulkOptions{Concurrency: 1, StopOnError: true})
+	if !errors.As(err, &bulkErr) || len(bulkErr.Failed) != 3 {
+		t.Fatalf("StartMany with StopOnError = %v", err)
+	}
+	if !errors.Is(results[1].Err, ErrSkipped) || !errors.Is(results[2].Err, ErrSkipped) {
+		t.Errorf("results after the failure = %+v, want ErrSkipped", results[1:])
+	}
+
+	// Cancelling ctx while every slot is taken skips the identifiers left.
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls atomic.Int32
+	results, _ = client.Bulk(ctx, []string{"vm-0", "vm-1", "vm-2"}, func(ctx context.Context, identifier string) error {
+		calls.Add(1)
+		cancel()
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	}, &BulkOptions{Concurrency: 1})
+	if calls.Load() != 1 || results[0].Err != nil || !errors.Is(results[1].Err, context.Canceled) || !errors.Is(results[2].Err, context.Canceled) {
+		t.Errorf("results after cancel = %+v after %d calls", results, calls.Load())
+	}
+}
+
+func TestNewClientFromEnv(t *testing.T) {
+	var loggedIn atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/apps/v2/auth/from/api" {
+			var req LoginReq
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			loggedIn.Store(req.ClientID == "env-id" && req.ClientSecret == "env-secret")
+			_, _ = w.Write([]byte(`{"error":false,"token":{"access":{"token":"login"},"refresh":{"token":"refresh"}}}`))
+			return
+		}
+		if r.Header.Get("Vpsie-Auth") != "login" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"error":false,"data":[]}`))
+	}))
+	defer srv.Close()
+
+	path := t.TempDir() + "/config.yaml"
+	cfg := &Config{
+		CurrentProfile: "work",
+		Profiles: map[string]*ConfigProfile{
+			"work": {Token: "file-token", BaseURL: srv.URL, Project: "file-project"},
+			"home": {Token: "home-token"},
+		},
+	}
+	if err := cfg.WriteFile(path); err != nil {
+		t.Fatal(err)
+	}
+	for _, env := range []string{EnvToken, EnvClientID, EnvClientSecret, EnvBaseURL, EnvProject, EnvProfile} {
+		t.Setenv(env, "")
+	}
+	t.Setenv(EnvConfig, path)
+
+	p, err := LoadConfig("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "work" || p.Token != "file-token" || p.Project != "file-project" {
+		t.Errorf("unexpected profile %+v", p)
+	}
+
+	t.Setenv(EnvProfile, "home")
+	if p, err := LoadConfig(""); err != nil || p.Token != "home-token" {
+		t.Errorf("VPSIE_PROFILE: got %+v, %v", p, err)
+	}
+	if p, err := LoadConfig("work"); err != nil || p.Token != "file-token" {
+		t.Errorf("explicit profile: got %+v, %v", p, err)
+	}
+	if _, err := LoadConfig("missing"); err == nil {
+		t.Error("expected an error for a missing profile")
+	}
+	t.Setenv(EnvProfile, "")
+
+	// Client credentials in the environment replace the profile's token.
+	t.Setenv(EnvClientID, "env-id")
+	t.Setenv(EnvClientSecret, "env-secret")
+	t.Setenv(EnvProject, "env-project")
+	client, err := NewClientFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.DefaultProject() != "env-project" || client.GetBaseURL().String() != srv.URL {
+		t.Errorf("unexpected client: project %q, base URL %s", client.DefaultProject(), client.GetBaseURL())
+	}
+	if _, err := client.IP.ListAllIPs(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if !loggedIn.Load() {
+		t.Error("expected a login with the environment's client credentials")
+	}
+
+	if _, err := NewClientFromProfile(&ConfigProfile{ClientID: "id"}); !errors.Is(err, ErrNoCredentials) {
+		t.Errorf("expected ErrNoCredentials, got %v", err)
+	}
+}
+
+func TestDefaultProject(t *testing.T) {
+	var projects []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			ProjectID         string `json:"projectId"`
+			ProjectIdentifier string `json:"projectIdentifier"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		projects = append(projects, body.ProjectID+body.ProjectIdentifier)
+		_, _ = w.Write([]byte(`{"error":false}`))
+	}))
+	defer srv.Close()
+
+	client, err := NewClientWithOptions(WithBaseURL(srv.URL), WithDefaultProject("proj-1"), WithValidation())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	server := &CreateServerRequest{ResourceIdentifier: "plan", OsIdentifier: "os", DcIdentifier: "dc", Hostname: "web-1"}
+	if err := client.Server.CreateServer(ctx, server); err != nil {
+		t.Fatal(err)
+	}
+	if server.ProjectID != "" {
+		t.Errorf("the caller's request was changed: %+v", server)
+	}
+	if err := client.Domain.CreateDomain(ctx, &CreateDomainRequest{Domain: "example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Server.CreateServer(ctx, &CreateServerRequest{ResourceIdentifier: "plan", OsIdentifier: "os", DcIdentifier: "dc", Hostname: "web-2", ProjectID: "proj-2"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(projects, ","); got != "proj-1,proj-1,proj-2" {
+		t.Errorf("unexpected projects %s", got)
+	}
+}
@@ -0,0 +1,214 @@
+// Package govpsieotel instruments a goVPSie.Client with OpenTelemetry.
+//
+// Instrument wraps every service of the client so each call runs in a span
+// named after the service method, such as "ServerService.CreateServer",
+// carrying the identifiers passed to it under keys named after the resource,
+// such as "vpsie.server.identifier", and, on failure, the HTTP status and API
+// error code. It also installs a middleware that records per-endpoint
+// request counts, latencies and retries as metrics.
+package govpsieotel
+
+//go:generate go run ../internal/gen -dir .. -kind otel -out services_gen.go
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"net/http"
+	"time"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope of the tracer and meter.
+const ScopeName = "github.com/ahmedabdelkader99/goVPSie/govpsieotel"
+
+// Option configures Instrument.
+type Option func(*config)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// WithTracerProvider sets the provider of the tracer. The global provider is
+// used by default.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider sets the provider of the meter. The global provider is
+// used by default.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = mp
+	}
+}
+
+// Instrument adds tracing and metrics to c. It replaces the client's service
+// fields, so it must be called once, before c is shared between goroutines.
+func Instrument(c *goVPSie.Client, opts ...Option) error {
+	cfg := config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	inst, err := newInstrumentation(cfg)
+	if err != nil {
+		return err
+	}
+
+	c.Use(inst.middleware)
+	wrapServices(c, inst)
+	return nil
+}
+
+type instrumentation struct {
+	tracer   trace.Tracer
+	requests metric.Int64Counter
+	duration metric.Float64Histogram
+	retries  metric.Int64Counter
+}
+
+func newInstrumentation(cfg config) (*instrumentation, error) {
+	meter := cfg.meterProvider.Meter(ScopeName)
+	inst := &instrumentation{tracer: cfg.tracerProvider.Tracer(ScopeName)}
+
+	var err error
+	inst.requests, err = meter.Int64Counter("vpsie.client.requests",
+		metric.WithDescription("HTTP requests sent to the VPSie API, retries included."),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		return nil, err
+	}
+
+	inst.duration, err = meter.Float64Histogram("vpsie.client.request.duration",
+		metric.WithDescription("Duration of HTTP requests sent to the VPSie API."),
+		metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	inst.retries, err = meter.Int64Counter("vpsie.client.retries",
+		metric.WithDescription("HTTP requests to the VPSie API that retried an earlier attempt."),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		return nil, err
+	}
+
+	return inst, nil
+}
+
+type operationContextKey struct{}
+
+// start begins the span of a service call. The operation name is kept in the
+// context so the middleware can label metrics with it.
+func (i *instrumentation) start(ctx context.Context, operation string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	ctx = context.WithValue(ctx, operationContextKey{}, operation)
+	return i.tracer.Start(ctx, operation, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// end finishes span, recording err and the API error details it carries.
+func (i *instrumentation) end(span trace.Span, err error) {
+	if err != nil {
+		var apiErr *goVPSie.APIError
+		if errors.As(err, &apiErr) {
+			span.SetAttributes(
+				attribute.Int("http.response.status_code", apiErr.StatusCode),
+				attribute.Int("vpsie.error.code", apiErr.Code),
+			)
+			if id := apiErr.RequestID(); id != "" {
+				span.SetAttributes(attribute.String("vpsie.request_id", id))
+			}
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// seq traces an iterator over its whole run, from the first page to the last
+// item the caller consumes.
+func seq[T any](ctx context.Context, i *instrumentation, operation string, next func(context.Context) iter.Seq2[T, error], attrs ...attribute.KeyValue) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		ctx, span := i.start(ctx, operation, attrs...)
+
+		var err error
+		for item, itemErr := range next(ctx) {
+			if itemErr != nil {
+				err = itemErr
+			}
+			if !yield(item, itemErr) {
+				break
+			}
+		}
+
+		i.end(span, err)
+	}
+}
+
+// middleware records metrics for every attempt and adds the HTTP status to the
+// span of the service call that made it.
+func (i *instrumentation) middleware(next http.RoundTripper) http.RoundTripper {
+	return goVPSie.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		ctx := req.Context()
+
+		operation, _ := ctx.Value(operationContextKey{}).(string)
+		if operation == "" {
+			operation = "other"
+		}
+		attrs := []attribute.KeyValue{
+			attribute.String("vpsie.operation", operation),
+			attribute.String("http.request.method", req.Method),
+		}
+
+		attempt := goVPSie.Attempt(ctx)
+		if attempt > 1 {
+			i.retries.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+
+		start := time.Now()
+		res, err := next.RoundTrip(req)
+		elapsed := time.Since(start)
+
+		span := trace.SpanFromContext(ctx)
+		if err != nil {
+			attrs = append(attrs, attribute.String("error.type", errorType(err)))
+		} else {
+			attrs = append(attrs, attribute.Int("http.response.status_code", res.StatusCode))
+			span.SetAttributes(attribute.Int("http.response.status_code", res.StatusCode))
+		}
+		if attempt > 0 {
+			span.SetAttributes(attribute.Int("vpsie.attempts", attempt))
+		}
+
+		set := metric.WithAttributes(attrs...)
+		i.requests.Add(ctx, 1, set)
+		i.duration.Record(ctx, elapsed.Seconds(), set)
+
+		return res, err
+	})
+}
+
+func errorType(err error) string {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	default:
+		return "transport"
+	}
+}
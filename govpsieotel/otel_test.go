@@ -0,0 +1,118 @@
+package govpsieotel_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+	"github.com/ahmedabdelkader99/goVPSie/govpsieotel"
+	"github.com/ahmedabdelkader99/goVPSie/govpsietest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestInstrument(t *testing.T) {
+	fake := govpsietest.NewServer()
+	defer fake.Close()
+	fake.AddServer(goVPSie.VmData{Identifier: "vm-1", Hostname: "web"})
+	fake.AddFault(govpsietest.Fault{Method: http.MethodGet, PathPrefix: "/apps/v2/vm/", Status: http.StatusServiceUnavailable, Times: 1})
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	policy := goVPSie.DefaultRetryPolicy()
+	policy.BaseBackoff = time.Millisecond
+	client := fake.Client(goVPSie.WithRetryPolicy(policy))
+	err := govpsieotel.Instrument(client,
+		govpsieotel.WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		govpsieotel.WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	if _, err := client.Server.GetServerByIdentifier(ctx, "vm-1"); err != nil {
+		t.Fatalf("GetServerByIdentifier: %v", err)
+	}
+	if _, err := client.Server.GetServerByIdentifier(ctx, "missing"); !goVPSie.IsNotFound(err) {
+		t.Fatalf("GetServerByIdentifier(missing) = %v, want not found", err)
+	}
+	for _, err := range client.Server.All(ctx) {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ended := spans.Ended()
+	if len(ended) != 3 {
+		t.Fatalf("got %d spans, want 3", len(ended))
+	}
+
+	ok, failed, listed := ended[0], ended[1], ended[2]
+	if ok.Name() != "ServerService.GetServerByIdentifier" || listed.Name() != "ServerService.All" {
+		t.Errorf("span names = %q, %q", ok.Name(), listed.Name())
+	}
+	if got := attr(ok.Attributes(), "vpsie.server.identifier"); got.AsString() != "vm-1" {
+		t.Errorf("vpsie.server.identifier = %v, want vm-1", got.Emit())
+	}
+	if got := attr(ok.Attributes(), "vpsie.attempts"); got.AsInt64() != 2 {
+		t.Errorf("vpsie.attempts = %v, want 2", got.Emit())
+	}
+	if failed.Status().Code != codes.Error {
+		t.Errorf("failed span status = %v, want error", failed.Status())
+	}
+	if got := attr(failed.Attributes(), "http.response.status_code"); got.AsInt64() != http.StatusNotFound {
+		t.Errorf("http.response.status_code = %v, want 404", got.Emit())
+	}
+	if got := attr(failed.Attributes(), "vpsie.error.code"); got.AsInt64() != http.StatusNotFound {
+		t.Errorf("vpsie.error.code = %v, want 404", got.Emit())
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(ctx, &rm); err != nil {
+		t.Fatal(err)
+	}
+
+	sums := map[string]int64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				for _, dp := range data.DataPoints {
+					sums[m.Name] += dp.Value
+				}
+			case metricdata.Histogram[float64]:
+				for _, dp := range data.DataPoints {
+					sums[m.Name] += int64(dp.Count)
+				}
+			}
+		}
+	}
+
+	want := map[string]int64{
+		"vpsie.client.requests":         4,
+		"vpsie.client.request.duration": 4,
+		"vpsie.client.retries":          1,
+	}
+	for name, count := range want {
+		if sums[name] != count {
+			t.Errorf("%s = %d, want %d", name, sums[name], count)
+		}
+	}
+}
+
+func attr(attrs []attribute.KeyValue, key attribute.Key) attribute.Value {
+	for _, kv := range attrs {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
@@ -0,0 +1,2071 @@
+// Code generated by internal/gen; DO NOT EDIT.
+
+package govpsieotel
+
+import (
+	"context"
+	"iter"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// wrapServices replaces every service of c with a traced wrapper.
+func wrapServices(c *goVPSie.Client, inst *instrumentation) {
+	c.Account = &tracedAccountService{next: c.Account, inst: inst}
+	c.Project = &tracedProjectsService{next: c.Project, inst: inst}
+	c.Server = &tracedServerService{next: c.Server, inst: inst}
+	c.Image = &tracedImagesService{next: c.Image, inst: inst}
+	c.SShKey = &tracedSshkeysService{next: c.SShKey, inst: inst}
+	c.Profile = &tracedProfilesService{next: c.Profile, inst: inst}
+	c.Backup = &tracedBackupsService{next: c.Backup, inst: inst}
+	c.IP = &tracedIPsService{next: c.IP, inst: inst}
+	c.Domain = &tracedDomainService{next: c.Domain, inst: inst}
+	c.Fip = &tracedFipService{next: c.Fip, inst: inst}
+	c.FirewallGroup = &tracedFirewallGroupService{next: c.FirewallGroup, inst: inst}
+	c.Firewall = &tracedFirewallService{next: c.Firewall, inst: inst}
+	c.Storage = &tracedStorageService{next: c.Storage, inst: inst}
+	c.Snapshot = &tracedSnapshotService{next: c.Snapshot, inst: inst}
+	c.Logs = &tracedLogsService{next: c.Logs, inst: inst}
+	c.DataCenter = &tracedDataCenterService{next: c.DataCenter, inst: inst}
+	c.LB = &tracedLBsService{next: c.LB, inst: inst}
+	c.Scripts = &tracedScriptsService{next: c.Scripts, inst: inst}
+	c.Pending = &tracedPendingService{next: c.Pending, inst: inst}
+	c.Gateway = &tracedGatewayService{next: c.Gateway, inst: inst}
+	c.VPC = &tracedVPCService{next: c.VPC, inst: inst}
+	c.Bucket = &tracedBucketService{next: c.Bucket, inst: inst}
+	c.K8s = &tracedK8sService{next: c.K8s, inst: inst}
+	c.AccessToken = &tracedAccessTokenService{next: c.AccessToken, inst: inst}
+	c.Billing = &tracedBillingService{next: c.Billing, inst: inst}
+	c.Monitoring = &tracedMonitoringService{next: c.Monitoring, inst: inst}
+}
+
+type tracedAccountService struct {
+	next goVPSie.AccountService
+	inst *instrumentation
+}
+
+func (w *tracedAccountService) Login(ctx context.Context, loginCredentials *goVPSie.LoginReq) (*goVPSie.Token, error) {
+	ctx, span := w.inst.start(ctx, "AccountService.Login")
+	r0, err := w.next.Login(ctx, loginCredentials)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedAccountService) RefreshToken(ctx context.Context, refreshToken string) (*goVPSie.Token, error) {
+	ctx, span := w.inst.start(ctx, "AccountService.RefreshToken")
+	r0, err := w.next.RefreshToken(ctx, refreshToken)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+type tracedProjectsService struct {
+	next goVPSie.ProjectsService
+	inst *instrumentation
+}
+
+func (w *tracedProjectsService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Project, error) {
+	ctx, span := w.inst.start(ctx, "ProjectsService.List")
+	r0, err := w.next.List(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedProjectsService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Project, error] {
+	return seq(ctx, w.inst, "ProjectsService.All", func(ctx context.Context) iter.Seq2[goVPSie.Project, error] {
+		return w.next.All(ctx, opts...)
+	})
+}
+
+func (w *tracedProjectsService) SetDefault(ctx context.Context, projectIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "ProjectsService.SetDefault", attribute.String("vpsie.project.identifier", projectIdentifier))
+	err := w.next.SetDefault(ctx, projectIdentifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedProjectsService) Get(ctx context.Context, identifer string) (*goVPSie.Project, error) {
+	ctx, span := w.inst.start(ctx, "ProjectsService.Get", attribute.String("vpsie.project.identifier", identifer))
+	r0, err := w.next.Get(ctx, identifer)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedProjectsService) Create(ctx context.Context, projectReq *goVPSie.CreateProjectRequest) error {
+	ctx, span := w.inst.start(ctx, "ProjectsService.Create")
+	err := w.next.Create(ctx, projectReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedProjectsService) ListAnotherVms(ctx context.Context, projectId string) ([]goVPSie.VmData, error) {
+	ctx, span := w.inst.start(ctx, "ProjectsService.ListAnotherVms", attribute.String("vpsie.project.identifier", projectId))
+	r0, err := w.next.ListAnotherVms(ctx, projectId)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedProjectsService) MoveVms(ctx context.Context, projectIdentifier string, projectId string) error {
+	ctx, span := w.inst.start(ctx, "ProjectsService.MoveVms", attribute.String("vpsie.server.identifier", projectIdentifier), attribute.String("vpsie.project.identifier", projectId))
+	err := w.next.MoveVms(ctx, projectIdentifier, projectId)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedProjectsService) AssignToVms(ctx context.Context, projectIdentifier string, projectId string) error {
+	ctx, span := w.inst.start(ctx, "ProjectsService.AssignToVms", attribute.String("vpsie.server.identifier", projectIdentifier), attribute.String("vpsie.project.identifier", projectId))
+	err := w.next.AssignToVms(ctx, projectIdentifier, projectId)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedProjectsService) ListDomains(ctx context.Context, projectIdentifier string) ([]goVPSie.Domain, error) {
+	ctx, span := w.inst.start(ctx, "ProjectsService.ListDomains", attribute.String("vpsie.project.identifier", projectIdentifier))
+	r0, err := w.next.ListDomains(ctx, projectIdentifier)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedProjectsService) Delete(ctx context.Context, id string) error {
+	ctx, span := w.inst.start(ctx, "ProjectsService.Delete", attribute.String("vpsie.project.identifier", id))
+	err := w.next.Delete(ctx, id)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedProjectsService) ListUserLimits(ctx context.Context) (*goVPSie.UserLimit, error) {
+	ctx, span := w.inst.start(ctx, "ProjectsService.ListUserLimits")
+	r0, err := w.next.ListUserLimits(ctx)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+type tracedServerService struct {
+	next goVPSie.ServerService
+	inst *instrumentation
+}
+
+func (w *tracedServerService) ListServer(ctx context.Context, options *goVPSie.ListOptions, projectId string) ([]goVPSie.VmData, error) {
+	ctx, span := w.inst.start(ctx, "ServerService.ListServer", attribute.String("vpsie.project.identifier", projectId))
+	r0, err := w.next.ListServer(ctx, options, projectId)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedServerService) AllByProject(ctx context.Context, projectId string, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.VmData, error] {
+	return seq(ctx, w.inst, "ServerService.AllByProject", func(ctx context.Context) iter.Seq2[goVPSie.VmData, error] {
+		return w.next.AllByProject(ctx, projectId, opts...)
+	}, attribute.String("vpsie.project.identifier", projectId))
+}
+
+func (w *tracedServerService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.VmData, error) {
+	ctx, span := w.inst.start(ctx, "ServerService.List")
+	r0, err := w.next.List(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedServerService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.VmData, error] {
+	return seq(ctx, w.inst, "ServerService.All", func(ctx context.Context) iter.Seq2[goVPSie.VmData, error] {
+		return w.next.All(ctx, opts...)
+	})
+}
+
+func (w *tracedServerService) GetServerByIdentifier(ctx context.Context, identifierId string) (*goVPSie.VmData, error) {
+	ctx, span := w.inst.start(ctx, "ServerService.GetServerByIdentifier", attribute.String("vpsie.server.identifier", identifierId))
+	r0, err := w.next.GetServerByIdentifier(ctx, identifierId)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedServerService) GetServerStatusByIdentifier(ctx context.Context, identifierId string) (*goVPSie.Status, error) {
+	ctx, span := w.inst.start(ctx, "ServerService.GetServerStatusByIdentifier", attribute.String("vpsie.server.identifier", identifierId))
+	r0, err := w.next.GetServerStatusByIdentifier(ctx, identifierId)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedServerService) GetServerConsole(ctx context.Context, identifierId string) (*goVPSie.ServerConsole, error) {
+	ctx, span := w.inst.start(ctx, "ServerService.GetServerConsole", attribute.String("vpsie.server.identifier", identifierId))
+	r0, err := w.next.GetServerConsole(ctx, identifierId)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedServerService) CreateServer(ctx context.Context, server *goVPSie.CreateServerRequest) error {
+	ctx, span := w.inst.start(ctx, "ServerService.CreateServer")
+	err := w.next.CreateServer(ctx, server)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) CreateServerWithResult(ctx context.Context, server *goVPSie.CreateServerRequest) (*goVPSie.CreateResult, error) {
+	ctx, span := w.inst.start(ctx, "ServerService.CreateServerWithResult")
+	r0, err := w.next.CreateServerWithResult(ctx, server)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedServerService) DeleteServer(ctx context.Context, identifierId string, password string, reason string, note string) error {
+	ctx, span := w.inst.start(ctx, "ServerService.DeleteServer", attribute.String("vpsie.server.identifier", identifierId))
+	err := w.next.DeleteServer(ctx, identifierId, password, reason, note)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) StartServer(ctx context.Context, identifierId string) error {
+	ctx, span := w.inst.start(ctx, "ServerService.StartServer", attribute.String("vpsie.server.identifier", identifierId))
+	err := w.next.StartServer(ctx, identifierId)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) StopServer(ctx context.Context, identifierId string) error {
+	ctx, span := w.inst.start(ctx, "ServerService.StopServer", attribute.String("vpsie.server.identifier", identifierId))
+	err := w.next.StopServer(ctx, identifierId)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) RestartServer(ctx context.Context, identifierId string) error {
+	ctx, span := w.inst.start(ctx, "ServerService.RestartServer", attribute.String("vpsie.server.identifier", identifierId))
+	err := w.next.RestartServer(ctx, identifierId)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) StartMany(ctx context.Context, identifiers []string, opts *goVPSie.BulkOptions) ([]goVPSie.BulkResult, error) {
+	ctx, span := w.inst.start(ctx, "ServerService.StartMany", attribute.StringSlice("vpsie.server.identifiers", identifiers))
+	r0, err := w.next.StartMany(ctx, identifiers, opts)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedServerService) StopMany(ctx context.Context, identifiers []string, opts *goVPSie.BulkOptions) ([]goVPSie.BulkResult, error) {
+	ctx, span := w.inst.start(ctx, "ServerService.StopMany", attribute.StringSlice("vpsie.server.identifiers", identifiers))
+	r0, err := w.next.StopMany(ctx, identifiers, opts)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedServerService) RestartMany(ctx context.Context, identifiers []string, opts *goVPSie.BulkOptions) ([]goVPSie.BulkResult, error) {
+	ctx, span := w.inst.start(ctx, "ServerService.RestartMany", attribute.StringSlice("vpsie.server.identifiers", identifiers))
+	r0, err := w.next.RestartMany(ctx, identifiers, opts)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedServerService) ChangePassword(ctx context.Context, identifierId string, newPassword string) error {
+	ctx, span := w.inst.start(ctx, "ServerService.ChangePassword", attribute.String("vpsie.server.identifier", identifierId))
+	err := w.next.ChangePassword(ctx, identifierId, newPassword)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) ChangeHostName(ctx context.Context, identifierId string, newHostname string) error {
+	ctx, span := w.inst.start(ctx, "ServerService.ChangeHostName", attribute.String("vpsie.server.identifier", identifierId))
+	err := w.next.ChangeHostName(ctx, identifierId, newHostname)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) AddVPC(ctx context.Context, request *goVPSie.VpcRequest) error {
+	ctx, span := w.inst.start(ctx, "ServerService.AddVPC")
+	err := w.next.AddVPC(ctx, request)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) MoveVPC(ctx context.Context, request *goVPSie.VpcRequest) error {
+	ctx, span := w.inst.start(ctx, "ServerService.MoveVPC")
+	err := w.next.MoveVPC(ctx, request)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) AddTags(ctx context.Context, identifierId string, tags []string) error {
+	ctx, span := w.inst.start(ctx, "ServerService.AddTags", attribute.String("vpsie.server.identifier", identifierId))
+	err := w.next.AddTags(ctx, identifierId, tags)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) ResizeServer(ctx context.Context, identifierId string, cpu string, ram string) error {
+	ctx, span := w.inst.start(ctx, "ServerService.ResizeServer", attribute.String("vpsie.server.identifier", identifierId))
+	err := w.next.ResizeServer(ctx, identifierId, cpu, ram)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) AddSsh(ctx context.Context, identifierId string, sshKeyIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "ServerService.AddSsh", attribute.String("vpsie.server.identifier", identifierId), attribute.String("vpsie.ssh_key.identifier", sshKeyIdentifier))
+	err := w.next.AddSsh(ctx, identifierId, sshKeyIdentifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) AddScript(ctx context.Context, identifierId string, scriptIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "ServerService.AddScript", attribute.String("vpsie.server.identifier", identifierId), attribute.String("vpsie.script.identifier", scriptIdentifier))
+	err := w.next.AddScript(ctx, identifierId, scriptIdentifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) Lock(ctx context.Context, identifierId string) error {
+	ctx, span := w.inst.start(ctx, "ServerService.Lock", attribute.String("vpsie.server.identifier", identifierId))
+	err := w.next.Lock(ctx, identifierId)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) UnLock(ctx context.Context, identifierId string) error {
+	ctx, span := w.inst.start(ctx, "ServerService.UnLock", attribute.String("vpsie.server.identifier", identifierId))
+	err := w.next.UnLock(ctx, identifierId)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) DoMultiActions(ctx context.Context, vmsIdentifiers []string, actionType string, sshKeyIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "ServerService.DoMultiActions", attribute.StringSlice("vpsie.server.identifiers", vmsIdentifiers), attribute.String("vpsie.ssh_key.identifier", sshKeyIdentifier))
+	err := w.next.DoMultiActions(ctx, vmsIdentifiers, actionType, sshKeyIdentifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) EnableIpv6(ctx context.Context, identifierId string) error {
+	ctx, span := w.inst.start(ctx, "ServerService.EnableIpv6", attribute.String("vpsie.server.identifier", identifierId))
+	err := w.next.EnableIpv6(ctx, identifierId)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) EnableIpv4(ctx context.Context, identifierId string) error {
+	ctx, span := w.inst.start(ctx, "ServerService.EnableIpv4", attribute.String("vpsie.server.identifier", identifierId))
+	err := w.next.EnableIpv4(ctx, identifierId)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) AddFip(ctx context.Context, identifierId string, dcIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "ServerService.AddFip", attribute.String("vpsie.server.identifier", identifierId), attribute.String("vpsie.datacenter.identifier", dcIdentifier))
+	err := w.next.AddFip(ctx, identifierId, dcIdentifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) Resume(ctx context.Context, resumeReq *goVPSie.ResumeReq) error {
+	ctx, span := w.inst.start(ctx, "ServerService.Resume")
+	err := w.next.Resume(ctx, resumeReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) ResetNetwork(ctx context.Context, vmIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "ServerService.ResetNetwork", attribute.String("vpsie.server.identifier", vmIdentifier))
+	err := w.next.ResetNetwork(ctx, vmIdentifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) EditTag(ctx context.Context, tags []string, vmIdentifer string) error {
+	ctx, span := w.inst.start(ctx, "ServerService.EditTag", attribute.String("vpsie.server.identifier", vmIdentifer))
+	err := w.next.EditTag(ctx, tags, vmIdentifer)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) ResetAllFirewalls(ctx context.Context) error {
+	ctx, span := w.inst.start(ctx, "ServerService.ResetAllFirewalls")
+	err := w.next.ResetAllFirewalls(ctx)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedServerService) ListVirtualMachines(ctx context.Context) ([]goVPSie.VirtualMachine, error) {
+	ctx, span := w.inst.start(ctx, "ServerService.ListVirtualMachines")
+	r0, err := w.next.ListVirtualMachines(ctx)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedServerService) ListAllNodesOfUser(ctx context.Context) ([]goVPSie.VmData, error) {
+	ctx, span := w.inst.start(ctx, "ServerService.ListAllNodesOfUser")
+	r0, err := w.next.ListAllNodesOfUser(ctx)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedServerService) CheckAgentStatus(ctx context.Context, vmIdentifier string) (bool, error) {
+	ctx, span := w.inst.start(ctx, "ServerService.CheckAgentStatus", attribute.String("vpsie.server.identifier", vmIdentifier))
+	r0, err := w.next.CheckAgentStatus(ctx, vmIdentifier)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedServerService) WaitForState(ctx context.Context, identifierId string, state string, opts *goVPSie.WaitOptions) (*goVPSie.Status, error) {
+	ctx, span := w.inst.start(ctx, "ServerService.WaitForState", attribute.String("vpsie.server.identifier", identifierId))
+	r0, err := w.next.WaitForState(ctx, identifierId, state, opts)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedServerService) WaitForCreation(ctx context.Context, result *goVPSie.CreateResult, opts *goVPSie.WaitOptions) (*goVPSie.VmData, error) {
+	ctx, span := w.inst.start(ctx, "ServerService.WaitForCreation")
+	r0, err := w.next.WaitForCreation(ctx, result, opts)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+type tracedImagesService struct {
+	next goVPSie.ImagesService
+	inst *instrumentation
+}
+
+func (w *tracedImagesService) DeleteImage(ctx context.Context, imageIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "ImagesService.DeleteImage", attribute.String("vpsie.image.identifier", imageIdentifier))
+	err := w.next.DeleteImage(ctx, imageIdentifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedImagesService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.CustomImage, error) {
+	ctx, span := w.inst.start(ctx, "ImagesService.List")
+	r0, err := w.next.List(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedImagesService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.CustomImage, error] {
+	return seq(ctx, w.inst, "ImagesService.All", func(ctx context.Context) iter.Seq2[goVPSie.CustomImage, error] {
+		return w.next.All(ctx, opts...)
+	})
+}
+
+func (w *tracedImagesService) CreateImages(ctx context.Context, dcIdentifier string, imageName string, imageUrl string) error {
+	ctx, span := w.inst.start(ctx, "ImagesService.CreateImages", attribute.String("vpsie.datacenter.identifier", dcIdentifier))
+	err := w.next.CreateImages(ctx, dcIdentifier, imageName, imageUrl)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedImagesService) CreateServerByImage(ctx context.Context, createServerReq *goVPSie.CreateServerRequest) error {
+	ctx, span := w.inst.start(ctx, "ImagesService.CreateServerByImage")
+	err := w.next.CreateServerByImage(ctx, createServerReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedImagesService) CreateServerByImageWithResult(ctx context.Context, createServerReq *goVPSie.CreateServerRequest) (*goVPSie.CreateResult, error) {
+	ctx, span := w.inst.start(ctx, "ImagesService.CreateServerByImageWithResult")
+	r0, err := w.next.CreateServerByImageWithResult(ctx, createServerReq)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedImagesService) GetImage(ctx context.Context, imageIdentifier string) (*goVPSie.CustomImage, error) {
+	ctx, span := w.inst.start(ctx, "ImagesService.GetImage", attribute.String("vpsie.image.identifier", imageIdentifier))
+	r0, err := w.next.GetImage(ctx, imageIdentifier)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+type tracedSshkeysService struct {
+	next goVPSie.SshkeysService
+	inst *instrumentation
+}
+
+func (w *tracedSshkeysService) List(ctx context.Context) ([]goVPSie.SShKey, error) {
+	ctx, span := w.inst.start(ctx, "SshkeysService.List")
+	r0, err := w.next.List(ctx)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedSshkeysService) Delete(ctx context.Context, sshKeyIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "SshkeysService.Delete", attribute.String("vpsie.ssh_key.identifier", sshKeyIdentifier))
+	err := w.next.Delete(ctx, sshKeyIdentifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedSshkeysService) Get(ctx context.Context, sshKeyIdentifier string) (*goVPSie.SShKey, error) {
+	ctx, span := w.inst.start(ctx, "SshkeysService.Get", attribute.String("vpsie.ssh_key.identifier", sshKeyIdentifier))
+	r0, err := w.next.Get(ctx, sshKeyIdentifier)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedSshkeysService) Create(ctx context.Context, privateKey string, name string) error {
+	ctx, span := w.inst.start(ctx, "SshkeysService.Create")
+	err := w.next.Create(ctx, privateKey, name)
+	w.inst.end(span, err)
+	return err
+}
+
+type tracedProfilesService struct {
+	next goVPSie.ProfilesService
+	inst *instrumentation
+}
+
+func (w *tracedProfilesService) ListQuickActionOfUser(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.QuickActions, error) {
+	ctx, span := w.inst.start(ctx, "ProfilesService.ListQuickActionOfUser")
+	r0, err := w.next.ListQuickActionOfUser(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedProfilesService) QuickActionsOfUserAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.QuickActions, error] {
+	return seq(ctx, w.inst, "ProfilesService.QuickActionsOfUserAll", func(ctx context.Context) iter.Seq2[goVPSie.QuickActions, error] {
+		return w.next.QuickActionsOfUserAll(ctx, opts...)
+	})
+}
+
+func (w *tracedProfilesService) ListQuickActionOfAccount(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.QuickActions, error) {
+	ctx, span := w.inst.start(ctx, "ProfilesService.ListQuickActionOfAccount")
+	r0, err := w.next.ListQuickActionOfAccount(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedProfilesService) QuickActionsOfAccountAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.QuickActions, error] {
+	return seq(ctx, w.inst, "ProfilesService.QuickActionsOfAccountAll", func(ctx context.Context) iter.Seq2[goVPSie.QuickActions, error] {
+		return w.next.QuickActionsOfAccountAll(ctx, opts...)
+	})
+}
+
+func (w *tracedProfilesService) SaveQuickActions(ctx context.Context, actions []int) error {
+	ctx, span := w.inst.start(ctx, "ProfilesService.SaveQuickActions")
+	err := w.next.SaveQuickActions(ctx, actions)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedProfilesService) GetProfile(ctx context.Context) (*goVPSie.Profile, error) {
+	ctx, span := w.inst.start(ctx, "ProfilesService.GetProfile")
+	r0, err := w.next.GetProfile(ctx)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedProfilesService) UpdateProfile(ctx context.Context, updateReq goVPSie.UpdateProfileRequest) error {
+	ctx, span := w.inst.start(ctx, "ProfilesService.UpdateProfile")
+	err := w.next.UpdateProfile(ctx, updateReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedProfilesService) GetPermissionGroups(ctx context.Context) ([]goVPSie.PermissionGroup, error) {
+	ctx, span := w.inst.start(ctx, "ProfilesService.GetPermissionGroups")
+	r0, err := w.next.GetPermissionGroups(ctx)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedProfilesService) DeletePermissionGroup(ctx context.Context, groupId string) error {
+	ctx, span := w.inst.start(ctx, "ProfilesService.DeletePermissionGroup", attribute.String("vpsie.permission_group.identifier", groupId))
+	err := w.next.DeletePermissionGroup(ctx, groupId)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedProfilesService) CreatePermissionGroup(ctx context.Context, groupName string) error {
+	ctx, span := w.inst.start(ctx, "ProfilesService.CreatePermissionGroup")
+	err := w.next.CreatePermissionGroup(ctx, groupName)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedProfilesService) ChangePassword(ctx context.Context, oldPassword string, newPassword string) error {
+	ctx, span := w.inst.start(ctx, "ProfilesService.ChangePassword")
+	err := w.next.ChangePassword(ctx, oldPassword, newPassword)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedProfilesService) UpdateBilling(ctx context.Context, billing goVPSie.BillingAddress) error {
+	ctx, span := w.inst.start(ctx, "ProfilesService.UpdateBilling")
+	err := w.next.UpdateBilling(ctx, billing)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedProfilesService) ValidatePhone(ctx context.Context, phone string) error {
+	ctx, span := w.inst.start(ctx, "ProfilesService.ValidatePhone")
+	err := w.next.ValidatePhone(ctx, phone)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedProfilesService) VerifyPhone(ctx context.Context, code string) error {
+	ctx, span := w.inst.start(ctx, "ProfilesService.VerifyPhone")
+	err := w.next.VerifyPhone(ctx, code)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedProfilesService) EnableTwofa(ctx context.Context) error {
+	ctx, span := w.inst.start(ctx, "ProfilesService.EnableTwofa")
+	err := w.next.EnableTwofa(ctx)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedProfilesService) DisableTwofa(ctx context.Context) error {
+	ctx, span := w.inst.start(ctx, "ProfilesService.DisableTwofa")
+	err := w.next.DisableTwofa(ctx)
+	w.inst.end(span, err)
+	return err
+}
+
+type tracedBackupsService struct {
+	next goVPSie.BackupsService
+	inst *instrumentation
+}
+
+func (w *tracedBackupsService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Backup, error) {
+	ctx, span := w.inst.start(ctx, "BackupsService.List")
+	r0, err := w.next.List(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedBackupsService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Backup, error] {
+	return seq(ctx, w.inst, "BackupsService.All", func(ctx context.Context) iter.Seq2[goVPSie.Backup, error] {
+		return w.next.All(ctx, opts...)
+	})
+}
+
+func (w *tracedBackupsService) DeleteBackup(ctx context.Context, backupIdentifier string, deleteReason string, deleteNote string) error {
+	ctx, span := w.inst.start(ctx, "BackupsService.DeleteBackup", attribute.String("vpsie.backup.identifier", backupIdentifier))
+	err := w.next.DeleteBackup(ctx, backupIdentifier, deleteReason, deleteNote)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedBackupsService) CreateBackups(ctx context.Context, vmIdentifier string, name string, notes string) error {
+	ctx, span := w.inst.start(ctx, "BackupsService.CreateBackups", attribute.String("vpsie.server.identifier", vmIdentifier))
+	err := w.next.CreateBackups(ctx, vmIdentifier, name, notes)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedBackupsService) ListByServer(ctx context.Context, options *goVPSie.ListOptions, vmIdentifier string) ([]goVPSie.Backup, error) {
+	ctx, span := w.inst.start(ctx, "BackupsService.ListByServer", attribute.String("vpsie.server.identifier", vmIdentifier))
+	r0, err := w.next.ListByServer(ctx, options, vmIdentifier)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedBackupsService) AllByServer(ctx context.Context, vmIdentifier string, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Backup, error] {
+	return seq(ctx, w.inst, "BackupsService.AllByServer", func(ctx context.Context) iter.Seq2[goVPSie.Backup, error] {
+		return w.next.AllByServer(ctx, vmIdentifier, opts...)
+	}, attribute.String("vpsie.server.identifier", vmIdentifier))
+}
+
+func (w *tracedBackupsService) CreateServerByBackup(ctx context.Context, backupIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "BackupsService.CreateServerByBackup", attribute.String("vpsie.backup.identifier", backupIdentifier))
+	err := w.next.CreateServerByBackup(ctx, backupIdentifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedBackupsService) CreateServerByBackupWithResult(ctx context.Context, backupIdentifier string) (*goVPSie.CreateResult, error) {
+	ctx, span := w.inst.start(ctx, "BackupsService.CreateServerByBackupWithResult", attribute.String("vpsie.backup.identifier", backupIdentifier))
+	r0, err := w.next.CreateServerByBackupWithResult(ctx, backupIdentifier)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedBackupsService) Get(ctx context.Context, identifer string) (*goVPSie.Backup, error) {
+	ctx, span := w.inst.start(ctx, "BackupsService.Get", attribute.String("vpsie.backup.identifier", identifer))
+	r0, err := w.next.Get(ctx, identifer)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedBackupsService) EnableAutoBackup(ctx context.Context, enableAutoReq *goVPSie.EnableAutoBackupReq) error {
+	ctx, span := w.inst.start(ctx, "BackupsService.EnableAutoBackup")
+	err := w.next.EnableAutoBackup(ctx, enableAutoReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedBackupsService) Rename(ctx context.Context, backupIdentifier string, newName string) error {
+	ctx, span := w.inst.start(ctx, "BackupsService.Rename", attribute.String("vpsie.backup.identifier", backupIdentifier))
+	err := w.next.Rename(ctx, backupIdentifier, newName)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedBackupsService) GetBackupPolicy(ctx context.Context, identifier string) (*goVPSie.BackupPolicy, error) {
+	ctx, span := w.inst.start(ctx, "BackupsService.GetBackupPolicy", attribute.String("vpsie.backup_policy.identifier", identifier))
+	r0, err := w.next.GetBackupPolicy(ctx, identifier)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedBackupsService) CreateBackupPolicy(ctx context.Context, createReq *goVPSie.CreateBackupPolicyReq) error {
+	ctx, span := w.inst.start(ctx, "BackupsService.CreateBackupPolicy")
+	err := w.next.CreateBackupPolicy(ctx, createReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedBackupsService) DeleteBackupPolicy(ctx context.Context, policyId string, identifier string) error {
+	ctx, span := w.inst.start(ctx, "BackupsService.DeleteBackupPolicy", attribute.String("vpsie.backup_policy.id", policyId), attribute.String("vpsie.backup_policy.identifier", identifier))
+	err := w.next.DeleteBackupPolicy(ctx, policyId, identifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedBackupsService) ManageRetainBackupPolicy(ctx context.Context, policyId string, keep int) error {
+	ctx, span := w.inst.start(ctx, "BackupsService.ManageRetainBackupPolicy", attribute.String("vpsie.backup_policy.id", policyId))
+	err := w.next.ManageRetainBackupPolicy(ctx, policyId, keep)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedBackupsService) AttachBackupPolicy(ctx context.Context, policyId string, vms []string) error {
+	ctx, span := w.inst.start(ctx, "BackupsService.AttachBackupPolicy", attribute.String("vpsie.backup_policy.id", policyId))
+	err := w.next.AttachBackupPolicy(ctx, policyId, vms)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedBackupsService) DetachBackupPolicy(ctx context.Context, policyId string, vms []string) error {
+	ctx, span := w.inst.start(ctx, "BackupsService.DetachBackupPolicy", attribute.String("vpsie.backup_policy.id", policyId))
+	err := w.next.DetachBackupPolicy(ctx, policyId, vms)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedBackupsService) ListBackupPolicies(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.BackupPolicyListDetail, error) {
+	ctx, span := w.inst.start(ctx, "BackupsService.ListBackupPolicies")
+	r0, err := w.next.ListBackupPolicies(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedBackupsService) BackupPoliciesAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.BackupPolicyListDetail, error] {
+	return seq(ctx, w.inst, "BackupsService.BackupPoliciesAll", func(ctx context.Context) iter.Seq2[goVPSie.BackupPolicyListDetail, error] {
+		return w.next.BackupPoliciesAll(ctx, opts...)
+	})
+}
+
+type tracedIPsService struct {
+	next goVPSie.IPsService
+	inst *instrumentation
+}
+
+func (w *tracedIPsService) ListPrivateIPs(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.IP, error) {
+	ctx, span := w.inst.start(ctx, "IPsService.ListPrivateIPs")
+	r0, err := w.next.ListPrivateIPs(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedIPsService) PrivateIPsAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.IP, error] {
+	return seq(ctx, w.inst, "IPsService.PrivateIPsAll", func(ctx context.Context) iter.Seq2[goVPSie.IP, error] {
+		return w.next.PrivateIPsAll(ctx, opts...)
+	})
+}
+
+func (w *tracedIPsService) ListPublicIPs(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.IP, error) {
+	ctx, span := w.inst.start(ctx, "IPsService.ListPublicIPs")
+	r0, err := w.next.ListPublicIPs(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedIPsService) PublicIPsAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.IP, error] {
+	return seq(ctx, w.inst, "IPsService.PublicIPsAll", func(ctx context.Context) iter.Seq2[goVPSie.IP, error] {
+		return w.next.PublicIPsAll(ctx, opts...)
+	})
+}
+
+func (w *tracedIPsService) ListAllIPs(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.IP, error) {
+	ctx, span := w.inst.start(ctx, "IPsService.ListAllIPs")
+	r0, err := w.next.ListAllIPs(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedIPsService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.IP, error] {
+	return seq(ctx, w.inst, "IPsService.All", func(ctx context.Context) iter.Seq2[goVPSie.IP, error] {
+		return w.next.All(ctx, opts...)
+	})
+}
+
+func (w *tracedIPsService) DeleteIP(ctx context.Context, ip string, vmIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "IPsService.DeleteIP", attribute.String("vpsie.server.identifier", vmIdentifier))
+	err := w.next.DeleteIP(ctx, ip, vmIdentifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedIPsService) CreateIps(ctx context.Context, ipType string, vmIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "IPsService.CreateIps", attribute.String("vpsie.server.identifier", vmIdentifier))
+	err := w.next.CreateIps(ctx, ipType, vmIdentifier)
+	w.inst.end(span, err)
+	return err
+}
+
+type tracedDomainService struct {
+	next goVPSie.DomainService
+	inst *instrumentation
+}
+
+func (w *tracedDomainService) ListDomainByProject(ctx context.Context, options *goVPSie.ListOptions, projectIdentifier string) ([]goVPSie.Domain, error) {
+	ctx, span := w.inst.start(ctx, "DomainService.ListDomainByProject", attribute.String("vpsie.project.identifier", projectIdentifier))
+	r0, err := w.next.ListDomainByProject(ctx, options, projectIdentifier)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedDomainService) DomainsByProjectAll(ctx context.Context, projectIdentifier string, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Domain, error] {
+	return seq(ctx, w.inst, "DomainService.DomainsByProjectAll", func(ctx context.Context) iter.Seq2[goVPSie.Domain, error] {
+		return w.next.DomainsByProjectAll(ctx, projectIdentifier, opts...)
+	}, attribute.String("vpsie.project.identifier", projectIdentifier))
+}
+
+func (w *tracedDomainService) DnsRecord(ctx context.Context, domainIdentifier string, dnsRecord *goVPSie.DnsRecord) error {
+	ctx, span := w.inst.start(ctx, "DomainService.DnsRecord", attribute.String("vpsie.domain.identifier", domainIdentifier))
+	err := w.next.DnsRecord(ctx, domainIdentifier, dnsRecord)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedDomainService) ListDomains(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Domain, error) {
+	ctx, span := w.inst.start(ctx, "DomainService.ListDomains")
+	r0, err := w.next.ListDomains(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedDomainService) DomainsAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Domain, error] {
+	return seq(ctx, w.inst, "DomainService.DomainsAll", func(ctx context.Context) iter.Seq2[goVPSie.Domain, error] {
+		return w.next.DomainsAll(ctx, opts...)
+	})
+}
+
+func (w *tracedDomainService) ListAllDomains(ctx context.Context) ([]goVPSie.Domain, error) {
+	ctx, span := w.inst.start(ctx, "DomainService.ListAllDomains")
+	r0, err := w.next.ListAllDomains(ctx)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedDomainService) ListDomainVpsies(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.DomainVpsie, error) {
+	ctx, span := w.inst.start(ctx, "DomainService.ListDomainVpsies")
+	r0, err := w.next.ListDomainVpsies(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedDomainService) DomainVpsiesAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.DomainVpsie, error] {
+	return seq(ctx, w.inst, "DomainService.DomainVpsiesAll", func(ctx context.Context) iter.Seq2[goVPSie.DomainVpsie, error] {
+		return w.next.DomainVpsiesAll(ctx, opts...)
+	})
+}
+
+func (w *tracedDomainService) CreateDomain(ctx context.Context, createReq *goVPSie.CreateDomainRequest) error {
+	ctx, span := w.inst.start(ctx, "DomainService.CreateDomain")
+	err := w.next.CreateDomain(ctx, createReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedDomainService) GetDomainByVpsie(ctx context.Context, domainIdentifier string) ([]goVPSie.Domain, error) {
+	ctx, span := w.inst.start(ctx, "DomainService.GetDomainByVpsie", attribute.String("vpsie.domain.identifier", domainIdentifier))
+	r0, err := w.next.GetDomainByVpsie(ctx, domainIdentifier)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedDomainService) UpdateReverse(ctx context.Context, reverseReq *goVPSie.ReverseRequest) error {
+	ctx, span := w.inst.start(ctx, "DomainService.UpdateReverse")
+	err := w.next.UpdateReverse(ctx, reverseReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedDomainService) AddReverse(ctx context.Context, reverseReq *goVPSie.ReverseRequest) error {
+	ctx, span := w.inst.start(ctx, "DomainService.AddReverse")
+	err := w.next.AddReverse(ctx, reverseReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedDomainService) UpdateDomain(ctx context.Context, dnsRecord *goVPSie.DnsRecord, domainIdentifier string, vmIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "DomainService.UpdateDomain", attribute.String("vpsie.domain.identifier", domainIdentifier), attribute.String("vpsie.server.identifier", vmIdentifier))
+	err := w.next.UpdateDomain(ctx, dnsRecord, domainIdentifier, vmIdentifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedDomainService) DeleteReverse(ctx context.Context, ip string, vmIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "DomainService.DeleteReverse", attribute.String("vpsie.server.identifier", vmIdentifier))
+	err := w.next.DeleteReverse(ctx, ip, vmIdentifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedDomainService) CreateDnsRecord(ctx context.Context, createReq goVPSie.CreateDnsRecordReq) error {
+	ctx, span := w.inst.start(ctx, "DomainService.CreateDnsRecord")
+	err := w.next.CreateDnsRecord(ctx, createReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedDomainService) UpdateDnsRecord(ctx context.Context, updateReq *goVPSie.UpdateDnsRecordReq) error {
+	ctx, span := w.inst.start(ctx, "DomainService.UpdateDnsRecord")
+	err := w.next.UpdateDnsRecord(ctx, updateReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedDomainService) DeleteDomain(ctx context.Context, domainIdentifier string, reason string, note string) error {
+	ctx, span := w.inst.start(ctx, "DomainService.DeleteDomain", attribute.String("vpsie.domain.identifier", domainIdentifier))
+	err := w.next.DeleteDomain(ctx, domainIdentifier, reason, note)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedDomainService) DeleteDnsRecord(ctx context.Context, domainIdentifier string, record *goVPSie.Record) error {
+	ctx, span := w.inst.start(ctx, "DomainService.DeleteDnsRecord", attribute.String("vpsie.domain.identifier", domainIdentifier))
+	err := w.next.DeleteDnsRecord(ctx, domainIdentifier, record)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedDomainService) ListReversePTRRecords(ctx context.Context) ([]goVPSie.ReversePTR, error) {
+	ctx, span := w.inst.start(ctx, "DomainService.ListReversePTRRecords")
+	r0, err := w.next.ListReversePTRRecords(ctx)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+type tracedFipService struct {
+	next goVPSie.FipService
+	inst *instrumentation
+}
+
+func (w *tracedFipService) AssignFloatingIP(ctx context.Context) error {
+	ctx, span := w.inst.start(ctx, "FipService.AssignFloatingIP")
+	err := w.next.AssignFloatingIP(ctx)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedFipService) UnassignFloatingIP(ctx context.Context, id string) error {
+	ctx, span := w.inst.start(ctx, "FipService.UnassignFloatingIP", attribute.String("vpsie.floating_ip.identifier", id))
+	err := w.next.UnassignFloatingIP(ctx, id)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedFipService) CreateFloatingIP(ctx context.Context, vmIdentifier string, dcIdentifier string, ipType string) error {
+	ctx, span := w.inst.start(ctx, "FipService.CreateFloatingIP", attribute.String("vpsie.server.identifier", vmIdentifier), attribute.String("vpsie.datacenter.identifier", dcIdentifier))
+	err := w.next.CreateFloatingIP(ctx, vmIdentifier, dcIdentifier, ipType)
+	w.inst.end(span, err)
+	return err
+}
+
+type tracedFirewallGroupService struct {
+	next goVPSie.FirewallGroupService
+	inst *instrumentation
+}
+
+func (w *tracedFirewallGroupService) Create(ctx context.Context, groupName string, firewallUpdateReq []goVPSie.FirewallUpdateReq) error {
+	ctx, span := w.inst.start(ctx, "FirewallGroupService.Create")
+	err := w.next.Create(ctx, groupName, firewallUpdateReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedFirewallGroupService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.FirewallGroupListData, error) {
+	ctx, span := w.inst.start(ctx, "FirewallGroupService.List")
+	r0, err := w.next.List(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedFirewallGroupService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.FirewallGroupListData, error] {
+	return seq(ctx, w.inst, "FirewallGroupService.All", func(ctx context.Context) iter.Seq2[goVPSie.FirewallGroupListData, error] {
+		return w.next.All(ctx, opts...)
+	})
+}
+
+func (w *tracedFirewallGroupService) Get(ctx context.Context, fwGroupId string) (*goVPSie.FirewallGroupDetailData, error) {
+	ctx, span := w.inst.start(ctx, "FirewallGroupService.Get", attribute.String("vpsie.firewall_group.identifier", fwGroupId))
+	r0, err := w.next.Get(ctx, fwGroupId)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedFirewallGroupService) Delete(ctx context.Context, fwGroupId string) error {
+	ctx, span := w.inst.start(ctx, "FirewallGroupService.Delete", attribute.String("vpsie.firewall_group.identifier", fwGroupId))
+	err := w.next.Delete(ctx, fwGroupId)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedFirewallGroupService) Update(ctx context.Context, fwGroupReq *goVPSie.FirewallUpdateReq, fwGroupId string) error {
+	ctx, span := w.inst.start(ctx, "FirewallGroupService.Update", attribute.String("vpsie.firewall_group.identifier", fwGroupId))
+	err := w.next.Update(ctx, fwGroupReq, fwGroupId)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedFirewallGroupService) AssignToVpsie(ctx context.Context, groupId string, vmId string) error {
+	ctx, span := w.inst.start(ctx, "FirewallGroupService.AssignToVpsie", attribute.String("vpsie.firewall_group.identifier", groupId), attribute.String("vpsie.server.identifier", vmId))
+	err := w.next.AssignToVpsie(ctx, groupId, vmId)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedFirewallGroupService) DetachFromVpsie(ctx context.Context, groupId string, vmId string) error {
+	ctx, span := w.inst.start(ctx, "FirewallGroupService.DetachFromVpsie", attribute.String("vpsie.firewall_group.identifier", groupId), attribute.String("vpsie.server.identifier", vmId))
+	err := w.next.DetachFromVpsie(ctx, groupId, vmId)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedFirewallGroupService) AttachToVpsie(ctx context.Context, groupId string, vmId string) error {
+	ctx, span := w.inst.start(ctx, "FirewallGroupService.AttachToVpsie", attribute.String("vpsie.firewall_group.identifier", groupId), attribute.String("vpsie.server.identifier", vmId))
+	err := w.next.AttachToVpsie(ctx, groupId, vmId)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedFirewallGroupService) DeleteFirewallGroupOfServer(ctx context.Context, groupId string, vmId string) error {
+	ctx, span := w.inst.start(ctx, "FirewallGroupService.DeleteFirewallGroupOfServer", attribute.String("vpsie.firewall_group.identifier", groupId), attribute.String("vpsie.server.identifier", vmId))
+	err := w.next.DeleteFirewallGroupOfServer(ctx, groupId, vmId)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedFirewallGroupService) GetFirewallGroup(ctx context.Context, fwGroupId string) (*goVPSie.FirewallGroupDetailData, error) {
+	ctx, span := w.inst.start(ctx, "FirewallGroupService.GetFirewallGroup", attribute.String("vpsie.firewall_group.identifier", fwGroupId))
+	r0, err := w.next.GetFirewallGroup(ctx, fwGroupId)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+type tracedFirewallService struct {
+	next goVPSie.FirewallService
+	inst *instrumentation
+}
+
+func (w *tracedFirewallService) ListMacros(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Macros, error) {
+	ctx, span := w.inst.start(ctx, "FirewallService.ListMacros")
+	r0, err := w.next.ListMacros(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedFirewallService) MacrosAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Macros, error] {
+	return seq(ctx, w.inst, "FirewallService.MacrosAll", func(ctx context.Context) iter.Seq2[goVPSie.Macros, error] {
+		return w.next.MacrosAll(ctx, opts...)
+	})
+}
+
+func (w *tracedFirewallService) RemoveGroupVm(ctx context.Context, vmId string, groupId string) error {
+	ctx, span := w.inst.start(ctx, "FirewallService.RemoveGroupVm", attribute.String("vpsie.server.identifier", vmId), attribute.String("vpsie.firewall_group.identifier", groupId))
+	err := w.next.RemoveGroupVm(ctx, vmId, groupId)
+	w.inst.end(span, err)
+	return err
+}
+
+type tracedStorageService struct {
+	next goVPSie.StorageService
+	inst *instrumentation
+}
+
+func (w *tracedStorageService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Storage, error) {
+	ctx, span := w.inst.start(ctx, "StorageService.List")
+	r0, err := w.next.List(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedStorageService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Storage, error] {
+	return seq(ctx, w.inst, "StorageService.All", func(ctx context.Context) iter.Seq2[goVPSie.Storage, error] {
+		return w.next.All(ctx, opts...)
+	})
+}
+
+func (w *tracedStorageService) Delete(ctx context.Context, storageIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "StorageService.Delete", attribute.String("vpsie.storage.identifier", storageIdentifier))
+	err := w.next.Delete(ctx, storageIdentifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedStorageService) AttachToServer(ctx context.Context, storageIdentifier string, vmIdentifier string, vmType string) error {
+	ctx, span := w.inst.start(ctx, "StorageService.AttachToServer", attribute.String("vpsie.storage.identifier", storageIdentifier), attribute.String("vpsie.server.identifier", vmIdentifier))
+	err := w.next.AttachToServer(ctx, storageIdentifier, vmIdentifier, vmType)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedStorageService) DetachToServer(ctx context.Context, storageIdentifier string, vmIdentifier string, vmType string) error {
+	ctx, span := w.inst.start(ctx, "StorageService.DetachToServer", attribute.String("vpsie.storage.identifier", storageIdentifier), attribute.String("vpsie.server.identifier", vmIdentifier))
+	err := w.next.DetachToServer(ctx, storageIdentifier, vmIdentifier, vmType)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedStorageService) CreateContainer(ctx context.Context, dcIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "StorageService.CreateContainer", attribute.String("vpsie.datacenter.identifier", dcIdentifier))
+	err := w.next.CreateContainer(ctx, dcIdentifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedStorageService) ListAll(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Storage, error) {
+	ctx, span := w.inst.start(ctx, "StorageService.ListAll")
+	r0, err := w.next.ListAll(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedStorageService) Create(ctx context.Context, createReq *goVPSie.StorageCreateRequest, vmIdentifier string, vmType string) error {
+	ctx, span := w.inst.start(ctx, "StorageService.Create", attribute.String("vpsie.server.identifier", vmIdentifier))
+	err := w.next.Create(ctx, createReq, vmIdentifier, vmType)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedStorageService) ListVmsToAttach(ctx context.Context) ([]goVPSie.VmToAttach, error) {
+	ctx, span := w.inst.start(ctx, "StorageService.ListVmsToAttach")
+	r0, err := w.next.ListVmsToAttach(ctx)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedStorageService) CreateVolume(ctx context.Context, creatReq *goVPSie.StorageCreateRequest) error {
+	ctx, span := w.inst.start(ctx, "StorageService.CreateVolume")
+	err := w.next.CreateVolume(ctx, creatReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedStorageService) CreateVolumeWithResult(ctx context.Context, creatReq *goVPSie.StorageCreateRequest) (*goVPSie.CreateResult, error) {
+	ctx, span := w.inst.start(ctx, "StorageService.CreateVolumeWithResult")
+	r0, err := w.next.CreateVolumeWithResult(ctx, creatReq)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedStorageService) CreateStorage(ctx context.Context, createReq *goVPSie.StorageCreateRequest) error {
+	ctx, span := w.inst.start(ctx, "StorageService.CreateStorage")
+	err := w.next.CreateStorage(ctx, createReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedStorageService) DetachAllFromServer(ctx context.Context, vmIdentifier string, vmType string) error {
+	ctx, span := w.inst.start(ctx, "StorageService.DetachAllFromServer", attribute.String("vpsie.server.identifier", vmIdentifier))
+	err := w.next.DetachAllFromServer(ctx, vmIdentifier, vmType)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedStorageService) UpdateSize(ctx context.Context, storageIdentifier string, size string) error {
+	ctx, span := w.inst.start(ctx, "StorageService.UpdateSize", attribute.String("vpsie.storage.identifier", storageIdentifier))
+	err := w.next.UpdateSize(ctx, storageIdentifier, size)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedStorageService) UpdateName(ctx context.Context, storageIdentifier string, name string) error {
+	ctx, span := w.inst.start(ctx, "StorageService.UpdateName", attribute.String("vpsie.storage.identifier", storageIdentifier))
+	err := w.next.UpdateName(ctx, storageIdentifier, name)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedStorageService) CreateSnapshot(ctx context.Context, storageIdentifier string, name string, storageType string) error {
+	ctx, span := w.inst.start(ctx, "StorageService.CreateSnapshot", attribute.String("vpsie.storage.identifier", storageIdentifier))
+	err := w.next.CreateSnapshot(ctx, storageIdentifier, name, storageType)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedStorageService) ListSnapshots(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.StorageSnapShot, error) {
+	ctx, span := w.inst.start(ctx, "StorageService.ListSnapshots")
+	r0, err := w.next.ListSnapshots(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedStorageService) SnapshotsAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.StorageSnapShot, error] {
+	return seq(ctx, w.inst, "StorageService.SnapshotsAll", func(ctx context.Context) iter.Seq2[goVPSie.StorageSnapShot, error] {
+		return w.next.SnapshotsAll(ctx, opts...)
+	})
+}
+
+func (w *tracedStorageService) UpdateSnapshotName(ctx context.Context, snapshotIdentifier string, name string) error {
+	ctx, span := w.inst.start(ctx, "StorageService.UpdateSnapshotName", attribute.String("vpsie.snapshot.identifier", snapshotIdentifier))
+	err := w.next.UpdateSnapshotName(ctx, snapshotIdentifier, name)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedStorageService) RollbackSnapshot(ctx context.Context, snapshotIdentifier string, snapType string) error {
+	ctx, span := w.inst.start(ctx, "StorageService.RollbackSnapshot", attribute.String("vpsie.snapshot.identifier", snapshotIdentifier))
+	err := w.next.RollbackSnapshot(ctx, snapshotIdentifier, snapType)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedStorageService) CloneSnapshot(ctx context.Context, snapshotIdentifier string, snapType string) error {
+	ctx, span := w.inst.start(ctx, "StorageService.CloneSnapshot", attribute.String("vpsie.snapshot.identifier", snapshotIdentifier))
+	err := w.next.CloneSnapshot(ctx, snapshotIdentifier, snapType)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedStorageService) DeleteSnapshot(ctx context.Context, snapshotIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "StorageService.DeleteSnapshot", attribute.String("vpsie.snapshot.identifier", snapshotIdentifier))
+	err := w.next.DeleteSnapshot(ctx, snapshotIdentifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedStorageService) DeleteAllSnapshots(ctx context.Context, storageIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "StorageService.DeleteAllSnapshots", attribute.String("vpsie.storage.identifier", storageIdentifier))
+	err := w.next.DeleteAllSnapshots(ctx, storageIdentifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedStorageService) Get(ctx context.Context, identifier string) (*goVPSie.StorageDetail, error) {
+	ctx, span := w.inst.start(ctx, "StorageService.Get", attribute.String("vpsie.storage.identifier", identifier))
+	r0, err := w.next.Get(ctx, identifier)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedStorageService) ListStorageDataCenter(ctx context.Context) ([]goVPSie.DataCenter, error) {
+	ctx, span := w.inst.start(ctx, "StorageService.ListStorageDataCenter")
+	r0, err := w.next.ListStorageDataCenter(ctx)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+type tracedSnapshotService struct {
+	next goVPSie.SnapshotService
+	inst *instrumentation
+}
+
+func (w *tracedSnapshotService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Snapshot, error) {
+	ctx, span := w.inst.start(ctx, "SnapshotService.List")
+	r0, err := w.next.List(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedSnapshotService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Snapshot, error] {
+	return seq(ctx, w.inst, "SnapshotService.All", func(ctx context.Context) iter.Seq2[goVPSie.Snapshot, error] {
+		return w.next.All(ctx, opts...)
+	})
+}
+
+func (w *tracedSnapshotService) Create(ctx context.Context, name string, vmIdentifier string, note string) error {
+	ctx, span := w.inst.start(ctx, "SnapshotService.Create", attribute.String("vpsie.server.identifier", vmIdentifier))
+	err := w.next.Create(ctx, name, vmIdentifier, note)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedSnapshotService) ListByVm(ctx context.Context, options *goVPSie.ListOptions, vmIdentifier string) ([]goVPSie.Snapshot, error) {
+	ctx, span := w.inst.start(ctx, "SnapshotService.ListByVm", attribute.String("vpsie.server.identifier", vmIdentifier))
+	r0, err := w.next.ListByVm(ctx, options, vmIdentifier)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedSnapshotService) AllByVm(ctx context.Context, vmIdentifier string, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Snapshot, error] {
+	return seq(ctx, w.inst, "SnapshotService.AllByVm", func(ctx context.Context) iter.Seq2[goVPSie.Snapshot, error] {
+		return w.next.AllByVm(ctx, vmIdentifier, opts...)
+	}, attribute.String("vpsie.server.identifier", vmIdentifier))
+}
+
+func (w *tracedSnapshotService) Rollback(ctx context.Context, snapshotIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "SnapshotService.Rollback", attribute.String("vpsie.snapshot.identifier", snapshotIdentifier))
+	err := w.next.Rollback(ctx, snapshotIdentifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedSnapshotService) EnableAuto(ctx context.Context, enableReq *goVPSie.EnableAutoSnapshotReq) error {
+	ctx, span := w.inst.start(ctx, "SnapshotService.EnableAuto")
+	err := w.next.EnableAuto(ctx, enableReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedSnapshotService) Delete(ctx context.Context, snapshotIdentifier string, reason string, note string) error {
+	ctx, span := w.inst.start(ctx, "SnapshotService.Delete", attribute.String("vpsie.snapshot.identifier", snapshotIdentifier))
+	err := w.next.Delete(ctx, snapshotIdentifier, reason, note)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedSnapshotService) DeleteMany(ctx context.Context, snapshotIdentifiers []string, reason string, note string, opts *goVPSie.BulkOptions) ([]goVPSie.BulkResult, error) {
+	ctx, span := w.inst.start(ctx, "SnapshotService.DeleteMany", attribute.StringSlice("vpsie.snapshot.identifiers", snapshotIdentifiers))
+	r0, err := w.next.DeleteMany(ctx, snapshotIdentifiers, reason, note, opts)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedSnapshotService) Update(ctx context.Context, snapshotIdentifier string, newNote string) error {
+	ctx, span := w.inst.start(ctx, "SnapshotService.Update", attribute.String("vpsie.snapshot.identifier", snapshotIdentifier))
+	err := w.next.Update(ctx, snapshotIdentifier, newNote)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedSnapshotService) Get(ctx context.Context, buckupIdentifier string) (*goVPSie.Snapshot, error) {
+	ctx, span := w.inst.start(ctx, "SnapshotService.Get", attribute.String("vpsie.backup.identifier", buckupIdentifier))
+	r0, err := w.next.Get(ctx, buckupIdentifier)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedSnapshotService) GetSnapShotPolicy(ctx context.Context, identifier string) (*goVPSie.SnapShotPolicy, error) {
+	ctx, span := w.inst.start(ctx, "SnapshotService.GetSnapShotPolicy", attribute.String("vpsie.snapshot_policy.identifier", identifier))
+	r0, err := w.next.GetSnapShotPolicy(ctx, identifier)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedSnapshotService) CreateSnapShotPolicy(ctx context.Context, createReq *goVPSie.CreateSnapShotPolicyReq) error {
+	ctx, span := w.inst.start(ctx, "SnapshotService.CreateSnapShotPolicy")
+	err := w.next.CreateSnapShotPolicy(ctx, createReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedSnapshotService) DeleteSnapShotPolicy(ctx context.Context, policyId string, identifier string) error {
+	ctx, span := w.inst.start(ctx, "SnapshotService.DeleteSnapShotPolicy", attribute.String("vpsie.snapshot_policy.id", policyId), attribute.String("vpsie.snapshot_policy.identifier", identifier))
+	err := w.next.DeleteSnapShotPolicy(ctx, policyId, identifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedSnapshotService) ManageRetainSnapShotPolicy(ctx context.Context, policyId string, keep int64) error {
+	ctx, span := w.inst.start(ctx, "SnapshotService.ManageRetainSnapShotPolicy", attribute.String("vpsie.snapshot_policy.id", policyId))
+	err := w.next.ManageRetainSnapShotPolicy(ctx, policyId, keep)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedSnapshotService) AttachSnapShotPolicy(ctx context.Context, policyId string, vms []string) error {
+	ctx, span := w.inst.start(ctx, "SnapshotService.AttachSnapShotPolicy", attribute.String("vpsie.snapshot_policy.id", policyId))
+	err := w.next.AttachSnapShotPolicy(ctx, policyId, vms)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedSnapshotService) DetachSnapShotPolicy(ctx context.Context, policyId string, vms []string) error {
+	ctx, span := w.inst.start(ctx, "SnapshotService.DetachSnapShotPolicy", attribute.String("vpsie.snapshot_policy.id", policyId))
+	err := w.next.DetachSnapShotPolicy(ctx, policyId, vms)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedSnapshotService) ListSnapShotPolicies(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.SnapShotPolicyListDetail, error) {
+	ctx, span := w.inst.start(ctx, "SnapshotService.ListSnapShotPolicies")
+	r0, err := w.next.ListSnapShotPolicies(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedSnapshotService) SnapShotPoliciesAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.SnapShotPolicyListDetail, error] {
+	return seq(ctx, w.inst, "SnapshotService.SnapShotPoliciesAll", func(ctx context.Context) iter.Seq2[goVPSie.SnapShotPolicyListDetail, error] {
+		return w.next.SnapShotPoliciesAll(ctx, opts...)
+	})
+}
+
+type tracedLogsService struct {
+	next goVPSie.LogsService
+	inst *instrumentation
+}
+
+func (w *tracedLogsService) ListActivityLogs(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.ActivityLog, error) {
+	ctx, span := w.inst.start(ctx, "LogsService.ListActivityLogs")
+	r0, err := w.next.ListActivityLogs(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedLogsService) ActivityLogsAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.ActivityLog, error] {
+	return seq(ctx, w.inst, "LogsService.ActivityLogsAll", func(ctx context.Context) iter.Seq2[goVPSie.ActivityLog, error] {
+		return w.next.ActivityLogsAll(ctx, opts...)
+	})
+}
+
+func (w *tracedLogsService) ListBillingLogs(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.BillingLog, error) {
+	ctx, span := w.inst.start(ctx, "LogsService.ListBillingLogs")
+	r0, err := w.next.ListBillingLogs(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedLogsService) BillingLogsAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.BillingLog, error] {
+	return seq(ctx, w.inst, "LogsService.BillingLogsAll", func(ctx context.Context) iter.Seq2[goVPSie.BillingLog, error] {
+		return w.next.BillingLogsAll(ctx, opts...)
+	})
+}
+
+func (w *tracedLogsService) ListAuditLogs(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.AuditLog, error) {
+	ctx, span := w.inst.start(ctx, "LogsService.ListAuditLogs")
+	r0, err := w.next.ListAuditLogs(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedLogsService) AuditLogsAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.AuditLog, error] {
+	return seq(ctx, w.inst, "LogsService.AuditLogsAll", func(ctx context.Context) iter.Seq2[goVPSie.AuditLog, error] {
+		return w.next.AuditLogsAll(ctx, opts...)
+	})
+}
+
+func (w *tracedLogsService) ListVPSieLogs(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.VmLog, error) {
+	ctx, span := w.inst.start(ctx, "LogsService.ListVPSieLogs")
+	r0, err := w.next.ListVPSieLogs(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedLogsService) VPSieLogsAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.VmLog, error] {
+	return seq(ctx, w.inst, "LogsService.VPSieLogsAll", func(ctx context.Context) iter.Seq2[goVPSie.VmLog, error] {
+		return w.next.VPSieLogsAll(ctx, opts...)
+	})
+}
+
+type tracedDataCenterService struct {
+	next goVPSie.DataCenterService
+	inst *instrumentation
+}
+
+func (w *tracedDataCenterService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.DataCenter, error) {
+	ctx, span := w.inst.start(ctx, "DataCenterService.List")
+	r0, err := w.next.List(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedDataCenterService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.DataCenter, error] {
+	return seq(ctx, w.inst, "DataCenterService.All", func(ctx context.Context) iter.Seq2[goVPSie.DataCenter, error] {
+		return w.next.All(ctx, opts...)
+	})
+}
+
+type tracedLBsService struct {
+	next goVPSie.LBsService
+	inst *instrumentation
+}
+
+func (w *tracedLBsService) ListLBs(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.LB, error) {
+	ctx, span := w.inst.start(ctx, "LBsService.ListLBs")
+	r0, err := w.next.ListLBs(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedLBsService) LBsAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.LB, error] {
+	return seq(ctx, w.inst, "LBsService.LBsAll", func(ctx context.Context) iter.Seq2[goVPSie.LB, error] {
+		return w.next.LBsAll(ctx, opts...)
+	})
+}
+
+func (w *tracedLBsService) ListLBDataCenters(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.LBDataCenter, error) {
+	ctx, span := w.inst.start(ctx, "LBsService.ListLBDataCenters")
+	r0, err := w.next.ListLBDataCenters(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedLBsService) LBDataCentersAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.LBDataCenter, error] {
+	return seq(ctx, w.inst, "LBsService.LBDataCentersAll", func(ctx context.Context) iter.Seq2[goVPSie.LBDataCenter, error] {
+		return w.next.LBDataCentersAll(ctx, opts...)
+	})
+}
+
+func (w *tracedLBsService) ListOffers(ctx context.Context, dcIdentifier string) ([]goVPSie.LBOffers, error) {
+	ctx, span := w.inst.start(ctx, "LBsService.ListOffers", attribute.String("vpsie.datacenter.identifier", dcIdentifier))
+	r0, err := w.next.ListOffers(ctx, dcIdentifier)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedLBsService) GetLB(ctx context.Context, lbID string) (*goVPSie.LBDetails, error) {
+	ctx, span := w.inst.start(ctx, "LBsService.GetLB", attribute.String("vpsie.load_balancer.identifier", lbID))
+	r0, err := w.next.GetLB(ctx, lbID)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedLBsService) CreateLB(ctx context.Context, createLBReq *goVPSie.CreateLBReq) error {
+	ctx, span := w.inst.start(ctx, "LBsService.CreateLB")
+	err := w.next.CreateLB(ctx, createLBReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedLBsService) DeleteLB(ctx context.Context, lbID string, reason string, note string) error {
+	ctx, span := w.inst.start(ctx, "LBsService.DeleteLB", attribute.String("vpsie.load_balancer.identifier", lbID))
+	err := w.next.DeleteLB(ctx, lbID, reason, note)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedLBsService) AddLBRule(ctx context.Context, addRuleReq *goVPSie.AddRuleReq) error {
+	ctx, span := w.inst.start(ctx, "LBsService.AddLBRule")
+	err := w.next.AddLBRule(ctx, addRuleReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedLBsService) DeleteLBRule(ctx context.Context, ruleID string) error {
+	ctx, span := w.inst.start(ctx, "LBsService.DeleteLBRule", attribute.String("vpsie.lb_rule.identifier", ruleID))
+	err := w.next.DeleteLBRule(ctx, ruleID)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedLBsService) AddLBDomain(ctx context.Context, domainAddReq *goVPSie.DomainAddReq) error {
+	ctx, span := w.inst.start(ctx, "LBsService.AddLBDomain")
+	err := w.next.AddLBDomain(ctx, domainAddReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedLBsService) ReplaceDomain(ctx context.Context, domainId string, newDomainId string) error {
+	ctx, span := w.inst.start(ctx, "LBsService.ReplaceDomain", attribute.String("vpsie.lb_domain.identifier", domainId), attribute.String("vpsie.lb_domain.new_identifier", newDomainId))
+	err := w.next.ReplaceDomain(ctx, domainId, newDomainId)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedLBsService) UpdateDomainBackend(ctx context.Context, domainId string, backends []goVPSie.Backend) error {
+	ctx, span := w.inst.start(ctx, "LBsService.UpdateDomainBackend", attribute.String("vpsie.lb_domain.identifier", domainId))
+	err := w.next.UpdateDomainBackend(ctx, domainId, backends)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedLBsService) UpdateLBDomain(ctx context.Context, domainUpdateReq *goVPSie.DomainUpdateReq) error {
+	ctx, span := w.inst.start(ctx, "LBsService.UpdateLBDomain")
+	err := w.next.UpdateLBDomain(ctx, domainUpdateReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedLBsService) UpdateLBRules(ctx context.Context, ruleUpdateReq *goVPSie.RuleUpdateReq) error {
+	ctx, span := w.inst.start(ctx, "LBsService.UpdateLBRules")
+	err := w.next.UpdateLBRules(ctx, ruleUpdateReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedLBsService) DeleteLBDomain(ctx context.Context, domainID string) error {
+	ctx, span := w.inst.start(ctx, "LBsService.DeleteLBDomain", attribute.String("vpsie.lb_domain.identifier", domainID))
+	err := w.next.DeleteLBDomain(ctx, domainID)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedLBsService) DeleteLBBackend(ctx context.Context, lbBackendID string) error {
+	ctx, span := w.inst.start(ctx, "LBsService.DeleteLBBackend", attribute.String("vpsie.lb_backend.identifier", lbBackendID))
+	err := w.next.DeleteLBBackend(ctx, lbBackendID)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedLBsService) ListPendingLBs(ctx context.Context) ([]goVPSie.PendingLB, error) {
+	ctx, span := w.inst.start(ctx, "LBsService.ListPendingLBs")
+	r0, err := w.next.ListPendingLBs(ctx)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+type tracedScriptsService struct {
+	next goVPSie.ScriptsService
+	inst *instrumentation
+}
+
+func (w *tracedScriptsService) GetScripts(ctx context.Context) ([]goVPSie.Script, error) {
+	ctx, span := w.inst.start(ctx, "ScriptsService.GetScripts")
+	r0, err := w.next.GetScripts(ctx)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedScriptsService) GetScript(ctx context.Context, scriptId string) (goVPSie.ScriptDetail, error) {
+	ctx, span := w.inst.start(ctx, "ScriptsService.GetScript", attribute.String("vpsie.script.identifier", scriptId))
+	r0, err := w.next.GetScript(ctx, scriptId)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedScriptsService) CreateScript(ctx context.Context, createScriptRequest *goVPSie.CreateScriptRequest) error {
+	ctx, span := w.inst.start(ctx, "ScriptsService.CreateScript")
+	err := w.next.CreateScript(ctx, createScriptRequest)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedScriptsService) UpdateScript(ctx context.Context, scriptUpdateRequest *goVPSie.ScriptUpdateRequest) error {
+	ctx, span := w.inst.start(ctx, "ScriptsService.UpdateScript")
+	err := w.next.UpdateScript(ctx, scriptUpdateRequest)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedScriptsService) DeleteScript(ctx context.Context, scriptId string) error {
+	ctx, span := w.inst.start(ctx, "ScriptsService.DeleteScript", attribute.String("vpsie.script.identifier", scriptId))
+	err := w.next.DeleteScript(ctx, scriptId)
+	w.inst.end(span, err)
+	return err
+}
+
+type tracedPendingService struct {
+	next goVPSie.PendingService
+	inst *instrumentation
+}
+
+func (w *tracedPendingService) GetPendingVms(ctx context.Context) ([]goVPSie.PendingVm, error) {
+	ctx, span := w.inst.start(ctx, "PendingService.GetPendingVms")
+	r0, err := w.next.GetPendingVms(ctx)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+type tracedGatewayService struct {
+	next goVPSie.GatewayService
+	inst *instrumentation
+}
+
+func (w *tracedGatewayService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Gateway, error) {
+	ctx, span := w.inst.start(ctx, "GatewayService.List")
+	r0, err := w.next.List(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedGatewayService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Gateway, error] {
+	return seq(ctx, w.inst, "GatewayService.All", func(ctx context.Context) iter.Seq2[goVPSie.Gateway, error] {
+		return w.next.All(ctx, opts...)
+	})
+}
+
+func (w *tracedGatewayService) Delete(ctx context.Context, ipId int) error {
+	ctx, span := w.inst.start(ctx, "GatewayService.Delete", attribute.Int("vpsie.gateway_ip.id", ipId))
+	err := w.next.Delete(ctx, ipId)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedGatewayService) Create(ctx context.Context, createReq *goVPSie.CreateGatewayReq) error {
+	ctx, span := w.inst.start(ctx, "GatewayService.Create")
+	err := w.next.Create(ctx, createReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedGatewayService) Get(ctx context.Context, id int64) (*goVPSie.Gateway, error) {
+	ctx, span := w.inst.start(ctx, "GatewayService.Get", attribute.Int64("vpsie.gateway.id", id))
+	r0, err := w.next.Get(ctx, id)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedGatewayService) AttachVM(ctx context.Context, id int64, vms []string, ignoreLegacyVms int64) error {
+	ctx, span := w.inst.start(ctx, "GatewayService.AttachVM", attribute.Int64("vpsie.gateway.id", id))
+	err := w.next.AttachVM(ctx, id, vms, ignoreLegacyVms)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedGatewayService) DetachVM(ctx context.Context, id int64, mapping_id []int64) error {
+	ctx, span := w.inst.start(ctx, "GatewayService.DetachVM", attribute.Int64("vpsie.gateway.id", id))
+	err := w.next.DetachVM(ctx, id, mapping_id)
+	w.inst.end(span, err)
+	return err
+}
+
+type tracedVPCService struct {
+	next goVPSie.VPCService
+	inst *instrumentation
+}
+
+func (w *tracedVPCService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.VPC, error) {
+	ctx, span := w.inst.start(ctx, "VPCService.List")
+	r0, err := w.next.List(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedVPCService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.VPC, error] {
+	return seq(ctx, w.inst, "VPCService.All", func(ctx context.Context) iter.Seq2[goVPSie.VPC, error] {
+		return w.next.All(ctx, opts...)
+	})
+}
+
+func (w *tracedVPCService) Get(ctx context.Context, id string) (*goVPSie.VPC, error) {
+	ctx, span := w.inst.start(ctx, "VPCService.Get", attribute.String("vpsie.vpc.identifier", id))
+	r0, err := w.next.Get(ctx, id)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedVPCService) AssignServer(ctx context.Context, assignReq *goVPSie.AssignServerReq) error {
+	ctx, span := w.inst.start(ctx, "VPCService.AssignServer")
+	err := w.next.AssignServer(ctx, assignReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedVPCService) MoveServer(ctx context.Context, assignReq *goVPSie.AssignServerReq) error {
+	ctx, span := w.inst.start(ctx, "VPCService.MoveServer")
+	err := w.next.MoveServer(ctx, assignReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedVPCService) CreateVpc(ctx context.Context, createReq *goVPSie.CreateVpcReq) error {
+	ctx, span := w.inst.start(ctx, "VPCService.CreateVpc")
+	err := w.next.CreateVpc(ctx, createReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedVPCService) ReleasePrivateIP(ctx context.Context, vmIdentifer string, privateIpId int) error {
+	ctx, span := w.inst.start(ctx, "VPCService.ReleasePrivateIP", attribute.String("vpsie.server.identifier", vmIdentifer), attribute.Int("vpsie.private_ip.id", privateIpId))
+	err := w.next.ReleasePrivateIP(ctx, vmIdentifer, privateIpId)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedVPCService) DeleteVpc(ctx context.Context, vpcId string, reason string, note string) error {
+	ctx, span := w.inst.start(ctx, "VPCService.DeleteVpc", attribute.String("vpsie.vpc.identifier", vpcId))
+	err := w.next.DeleteVpc(ctx, vpcId, reason, note)
+	w.inst.end(span, err)
+	return err
+}
+
+type tracedBucketService struct {
+	next goVPSie.BucketService
+	inst *instrumentation
+}
+
+func (w *tracedBucketService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Bucket, error) {
+	ctx, span := w.inst.start(ctx, "BucketService.List")
+	r0, err := w.next.List(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedBucketService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Bucket, error] {
+	return seq(ctx, w.inst, "BucketService.All", func(ctx context.Context) iter.Seq2[goVPSie.Bucket, error] {
+		return w.next.All(ctx, opts...)
+	})
+}
+
+func (w *tracedBucketService) Get(ctx context.Context, id string) (*goVPSie.Bucket, error) {
+	ctx, span := w.inst.start(ctx, "BucketService.Get", attribute.String("vpsie.bucket.identifier", id))
+	r0, err := w.next.Get(ctx, id)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedBucketService) Create(ctx context.Context, createReq *goVPSie.CreateBucketReq) error {
+	ctx, span := w.inst.start(ctx, "BucketService.Create")
+	err := w.next.Create(ctx, createReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedBucketService) Delete(ctx context.Context, bucketId string, reason string, note string) error {
+	ctx, span := w.inst.start(ctx, "BucketService.Delete", attribute.String("vpsie.bucket.identifier", bucketId))
+	err := w.next.Delete(ctx, bucketId, reason, note)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedBucketService) ToggleFileListing(ctx context.Context, bucketId string, fileListing bool) (bool, error) {
+	ctx, span := w.inst.start(ctx, "BucketService.ToggleFileListing", attribute.String("vpsie.bucket.identifier", bucketId))
+	r0, err := w.next.ToggleFileListing(ctx, bucketId, fileListing)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedBucketService) CheckFileListingStatus(ctx context.Context, bucketId string) (bool, error) {
+	ctx, span := w.inst.start(ctx, "BucketService.CheckFileListingStatus", attribute.String("vpsie.bucket.identifier", bucketId))
+	r0, err := w.next.CheckFileListingStatus(ctx, bucketId)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedBucketService) GenerateKey(ctx context.Context, keyName string) error {
+	ctx, span := w.inst.start(ctx, "BucketService.GenerateKey")
+	err := w.next.GenerateKey(ctx, keyName)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedBucketService) ListBucketKeys(ctx context.Context) ([]goVPSie.BucketKey, error) {
+	ctx, span := w.inst.start(ctx, "BucketService.ListBucketKeys")
+	r0, err := w.next.ListBucketKeys(ctx)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+type tracedK8sService struct {
+	next goVPSie.K8sService
+	inst *instrumentation
+}
+
+func (w *tracedK8sService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.ListK8s, error) {
+	ctx, span := w.inst.start(ctx, "K8sService.List")
+	r0, err := w.next.List(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedK8sService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.ListK8s, error] {
+	return seq(ctx, w.inst, "K8sService.All", func(ctx context.Context) iter.Seq2[goVPSie.ListK8s, error] {
+		return w.next.All(ctx, opts...)
+	})
+}
+
+func (w *tracedK8sService) Delete(ctx context.Context, identifier string, reason string, note string) error {
+	ctx, span := w.inst.start(ctx, "K8sService.Delete", attribute.String("vpsie.k8s.identifier", identifier))
+	err := w.next.Delete(ctx, identifier, reason, note)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedK8sService) Create(ctx context.Context, createReq *goVPSie.CreateK8sReq) error {
+	ctx, span := w.inst.start(ctx, "K8sService.Create")
+	err := w.next.Create(ctx, createReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedK8sService) Get(ctx context.Context, identifier string) (*goVPSie.K8s, error) {
+	ctx, span := w.inst.start(ctx, "K8sService.Get", attribute.String("vpsie.k8s.identifier", identifier))
+	r0, err := w.next.Get(ctx, identifier)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedK8sService) AddSlave(ctx context.Context, identifier string) error {
+	ctx, span := w.inst.start(ctx, "K8sService.AddSlave", attribute.String("vpsie.k8s.identifier", identifier))
+	err := w.next.AddSlave(ctx, identifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedK8sService) RemoveSlave(ctx context.Context, identifier string) error {
+	ctx, span := w.inst.start(ctx, "K8sService.RemoveSlave", attribute.String("vpsie.k8s.identifier", identifier))
+	err := w.next.RemoveSlave(ctx, identifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedK8sService) ListK8sGroups(ctx context.Context, identifier string) ([]goVPSie.K8sGroup, error) {
+	ctx, span := w.inst.start(ctx, "K8sService.ListK8sGroups", attribute.String("vpsie.k8s.identifier", identifier))
+	r0, err := w.next.ListK8sGroups(ctx, identifier)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedK8sService) AddNode(ctx context.Context, identifier string, nodeType string, groupId int) error {
+	ctx, span := w.inst.start(ctx, "K8sService.AddNode", attribute.String("vpsie.k8s.identifier", identifier), attribute.Int("vpsie.k8s_group.id", groupId))
+	err := w.next.AddNode(ctx, identifier, nodeType, groupId)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedK8sService) RemoveNode(ctx context.Context, identifier string, nodeType string, groupId int) error {
+	ctx, span := w.inst.start(ctx, "K8sService.RemoveNode", attribute.String("vpsie.k8s.identifier", identifier), attribute.Int("vpsie.k8s_group.id", groupId))
+	err := w.next.RemoveNode(ctx, identifier, nodeType, groupId)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedK8sService) CreateK8sGroup(ctx context.Context, createReq *goVPSie.CreateK8sGroupReq) error {
+	ctx, span := w.inst.start(ctx, "K8sService.CreateK8sGroup")
+	err := w.next.CreateK8sGroup(ctx, createReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedK8sService) DeleteK8sGroup(ctx context.Context, groupId string, reason string, note string) error {
+	ctx, span := w.inst.start(ctx, "K8sService.DeleteK8sGroup", attribute.String("vpsie.k8s_group.identifier", groupId))
+	err := w.next.DeleteK8sGroup(ctx, groupId, reason, note)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedK8sService) UpgradeK8sVersion(ctx context.Context, identifier string) error {
+	ctx, span := w.inst.start(ctx, "K8sService.UpgradeK8sVersion", attribute.String("vpsie.k8s.identifier", identifier))
+	err := w.next.UpgradeK8sVersion(ctx, identifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedK8sService) PatchK8sVersion(ctx context.Context, identifier string, processId string) error {
+	ctx, span := w.inst.start(ctx, "K8sService.PatchK8sVersion", attribute.String("vpsie.k8s.identifier", identifier), attribute.String("vpsie.process.identifier", processId))
+	err := w.next.PatchK8sVersion(ctx, identifier, processId)
+	w.inst.end(span, err)
+	return err
+}
+
+type tracedAccessTokenService struct {
+	next goVPSie.AccessTokenService
+	inst *instrumentation
+}
+
+func (w *tracedAccessTokenService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.AccessToken, error) {
+	ctx, span := w.inst.start(ctx, "AccessTokenService.List")
+	r0, err := w.next.List(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedAccessTokenService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.AccessToken, error] {
+	return seq(ctx, w.inst, "AccessTokenService.All", func(ctx context.Context) iter.Seq2[goVPSie.AccessToken, error] {
+		return w.next.All(ctx, opts...)
+	})
+}
+
+func (w *tracedAccessTokenService) Create(ctx context.Context, name string, accessToken string, expirationDate string) error {
+	ctx, span := w.inst.start(ctx, "AccessTokenService.Create")
+	err := w.next.Create(ctx, name, accessToken, expirationDate)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedAccessTokenService) Delete(ctx context.Context, accessTokenIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "AccessTokenService.Delete", attribute.String("vpsie.access_token.identifier", accessTokenIdentifier))
+	err := w.next.Delete(ctx, accessTokenIdentifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedAccessTokenService) Update(ctx context.Context, accessTokenIdentifier string, name string, expirationDate string) error {
+	ctx, span := w.inst.start(ctx, "AccessTokenService.Update", attribute.String("vpsie.access_token.identifier", accessTokenIdentifier))
+	err := w.next.Update(ctx, accessTokenIdentifier, name, expirationDate)
+	w.inst.end(span, err)
+	return err
+}
+
+type tracedBillingService struct {
+	next goVPSie.BillingService
+	inst *instrumentation
+}
+
+func (w *tracedBillingService) ListInvoices(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Invoice, error) {
+	ctx, span := w.inst.start(ctx, "BillingService.ListInvoices")
+	r0, err := w.next.ListInvoices(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedBillingService) InvoicesAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Invoice, error] {
+	return seq(ctx, w.inst, "BillingService.InvoicesAll", func(ctx context.Context) iter.Seq2[goVPSie.Invoice, error] {
+		return w.next.InvoicesAll(ctx, opts...)
+	})
+}
+
+func (w *tracedBillingService) ListPurchaseLog(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.PurchaseLog, error) {
+	ctx, span := w.inst.start(ctx, "BillingService.ListPurchaseLog")
+	r0, err := w.next.ListPurchaseLog(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedBillingService) PurchaseLogAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.PurchaseLog, error] {
+	return seq(ctx, w.inst, "BillingService.PurchaseLogAll", func(ctx context.Context) iter.Seq2[goVPSie.PurchaseLog, error] {
+		return w.next.PurchaseLogAll(ctx, opts...)
+	})
+}
+
+func (w *tracedBillingService) ApplyVoucher(ctx context.Context, couponIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "BillingService.ApplyVoucher", attribute.String("vpsie.coupon.identifier", couponIdentifier))
+	err := w.next.ApplyVoucher(ctx, couponIdentifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedBillingService) ListAppliedVouchers(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.AppliedVouchers, error) {
+	ctx, span := w.inst.start(ctx, "BillingService.ListAppliedVouchers")
+	r0, err := w.next.ListAppliedVouchers(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedBillingService) AppliedVouchersAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.AppliedVouchers, error] {
+	return seq(ctx, w.inst, "BillingService.AppliedVouchersAll", func(ctx context.Context) iter.Seq2[goVPSie.AppliedVouchers, error] {
+		return w.next.AppliedVouchersAll(ctx, opts...)
+	})
+}
+
+func (w *tracedBillingService) ListEstimatedUsages(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.EstimatedUsages, error) {
+	ctx, span := w.inst.start(ctx, "BillingService.ListEstimatedUsages")
+	r0, err := w.next.ListEstimatedUsages(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedBillingService) EstimatedUsagesAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.EstimatedUsages, error] {
+	return seq(ctx, w.inst, "BillingService.EstimatedUsagesAll", func(ctx context.Context) iter.Seq2[goVPSie.EstimatedUsages, error] {
+		return w.next.EstimatedUsagesAll(ctx, opts...)
+	})
+}
+
+type tracedMonitoringService struct {
+	next goVPSie.MonitoringService
+	inst *instrumentation
+}
+
+func (w *tracedMonitoringService) ListMonitoringRule(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.MonitoringRule, error) {
+	ctx, span := w.inst.start(ctx, "MonitoringService.ListMonitoringRule")
+	r0, err := w.next.ListMonitoringRule(ctx, options)
+	w.inst.end(span, err)
+	return r0, err
+}
+
+func (w *tracedMonitoringService) MonitoringRulesAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.MonitoringRule, error] {
+	return seq(ctx, w.inst, "MonitoringService.MonitoringRulesAll", func(ctx context.Context) iter.Seq2[goVPSie.MonitoringRule, error] {
+		return w.next.MonitoringRulesAll(ctx, opts...)
+	})
+}
+
+func (w *tracedMonitoringService) CreateRule(ctx context.Context, createReq *goVPSie.CreateMonitoringRuleReq) error {
+	ctx, span := w.inst.start(ctx, "MonitoringService.CreateRule")
+	err := w.next.CreateRule(ctx, createReq)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedMonitoringService) ToggleMonitoringRuleStatus(ctx context.Context, status string, ruleIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "MonitoringService.ToggleMonitoringRuleStatus", attribute.String("vpsie.monitoring_rule.identifier", ruleIdentifier))
+	err := w.next.ToggleMonitoringRuleStatus(ctx, status, ruleIdentifier)
+	w.inst.end(span, err)
+	return err
+}
+
+func (w *tracedMonitoringService) DeleteMonitoringRule(ctx context.Context, ruleIdentifier string) error {
+	ctx, span := w.inst.start(ctx, "MonitoringService.DeleteMonitoringRule", attribute.String("vpsie.monitoring_rule.identifier", ruleIdentifier))
+	err := w.next.DeleteMonitoringRule(ctx, ruleIdentifier)
+	w.inst.end(span, err)
+	return err
+}
@@ -0,0 +1,106 @@
+package govpsietest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Fault describes a failure injected into matching requests.
+type Fault struct {
+	// Method and PathPrefix select the requests the fault applies to. Empty
+	// values match every request.
+	Method     string
+	PathPrefix string
+
+	// Latency delays the response. The request still reaches the fake API
+	// unless Status or Body is set as well.
+	Latency time.Duration
+
+	// Status answers with this HTTP status and a VPSie error payload.
+	Status int
+
+	// Body answers with this raw body instead, e.g. truncated JSON or an HTML
+	// error page. The status defaults to 200.
+	Body string
+
+	// Times limits the fault to the first Times matching requests. Zero
+	// applies it to every matching request.
+	Times int
+}
+
+// AddFault injects f into matching requests. Faults are checked in the order
+// they were added, and the first one that answers a request ends it.
+func (s *Server) AddFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes every injected fault.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+// applyFaults runs the faults matching r and reports whether one of them
+// wrote the response.
+func (s *Server) applyFaults(w http.ResponseWriter, r *http.Request) bool {
+	for _, f := range s.matchFaults(r) {
+		if f.Latency > 0 {
+			timer := time.NewTimer(f.Latency)
+			select {
+			case <-r.Context().Done():
+				timer.Stop()
+				return true
+			case <-timer.C:
+			}
+		}
+
+		switch {
+		case f.Body != "":
+			status := f.Status
+			if status == 0 {
+				status = http.StatusOK
+			}
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(f.Body))
+			return true
+		case f.Status != 0:
+			writeError(w, f.Status, http.StatusText(f.Status))
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchFaults returns the faults applying to r: the matching ones up to and
+// including the first that answers it. Only they use up one of their Times.
+func (s *Server) matchFaults(r *http.Request) []Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var matched []Fault
+	answered := false
+	remaining := s.faults[:0]
+	for _, f := range s.faults {
+		if !answered && (f.Method == "" || strings.EqualFold(f.Method, r.Method)) && strings.HasPrefix(r.URL.Path, f.PathPrefix) {
+			matched = append(matched, *f)
+			answered = f.Body != "" || f.Status != 0
+			if f.Times > 0 {
+				f.Times--
+				if f.Times == 0 {
+					continue
+				}
+			}
+		}
+		remaining = append(remaining, f)
+	}
+	s.faults = remaining
+
+	return matched
+}
@@ -0,0 +1,259 @@
+package govpsietest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	govpsie "github.com/ahmedabdelkader99/goVPSie"
+)
+
+// Mode selects whether a Recorder talks to the API or to its cassette.
+type Mode int
+
+const (
+	// ModeReplay serves responses from the cassette and never touches the
+	// network. A request without a matching recording fails.
+	ModeReplay Mode = iota
+
+	// ModeRecord forwards requests to the API and records every exchange.
+	// The cassette is written by Stop.
+	ModeRecord
+)
+
+// Cassette is the on-disk form of a recording.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is one recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// NoRecordingError is returned in replay mode for a request that matches no
+// unused recording. Body has its secrets redacted, like the cassette.
+type NoRecordingError struct {
+	Method string
+	URL    string
+	Body   string
+}
+
+func (e *NoRecordingError) Error() string {
+	msg := fmt.Sprintf("govpsietest: no recording matches %s %s", e.Method, e.URL)
+	if e.Body != "" {
+		msg += " with body " + e.Body
+	}
+	return msg
+}
+
+// Recorder is an http.RoundTripper that records API exchanges to a cassette
+// file or replays them from it. Pass it to goVPSie.NewClient through an
+// http.Client, or to goVPSie.WithTransport. Credential headers and secret
+// fields are replaced by goVPSie.Redacted before anything is written.
+//
+// Requests are matched on method, path, query and JSON body, compared after
+// normalization so that field order does not matter. Recordings are served
+// in the order they were made, each one once, so polling loops replay
+// faithfully.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+var _ http.RoundTripper = &Recorder{}
+
+// NewRecorder returns a recorder backed by the cassette at path. In replay
+// mode the cassette must exist. In record mode transport, or
+// http.DefaultTransport if nil, carries the requests.
+func NewRecorder(path string, mode Mode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	r := &Recorder{path: path, mode: mode, transport: transport}
+	if mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("govpsietest: reading cassette: %w", err)
+		}
+		if err := json.Unmarshal(data, &r.cassette); err != nil {
+			return nil, fmt.Errorf("govpsietest: decoding cassette %s: %w", path, err)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+	}
+
+	return r, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, body)
+	}
+
+	return r.record(req, body)
+}
+
+// Stop writes the cassette in record mode. It is a no-op in replay mode.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.path, append(data, '\n'), 0o644)
+}
+
+// Unused returns the recordings that have not been replayed, so tests can
+// check that every expected call was made.
+func (r *Recorder) Unused() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unused []Interaction
+	for i, used := range r.used {
+		if !used {
+			unused = append(unused, r.cassette.Interactions[i])
+		}
+	}
+
+	return unused
+}
+
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	res, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: govpsie.RedactHeader(req.Header),
+			Body:   string(govpsie.RedactJSON(body)),
+		},
+		Response: RecordedResponse{
+			StatusCode: res.StatusCode,
+			Header:     govpsie.RedactHeader(res.Header),
+			Body:       string(govpsie.RedactJSON(resBody)),
+		},
+	})
+
+	return res, nil
+}
+
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	key := matchKey(req.Method, req.URL.Path, req.URL.Query().Encode(), string(govpsie.RedactJSON(body)))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, in := range r.cassette.Interactions {
+		if r.used[i] {
+			continue
+		}
+
+		recorded, err := req.URL.Parse(in.Request.URL)
+		if err != nil {
+			continue
+		}
+		if matchKey(in.Request.Method, recorded.Path, recorded.Query().Encode(), in.Request.Body) != key {
+			continue
+		}
+
+		r.used[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        in.Response.Header.Clone(),
+			Body:          io.NopCloser(strings.NewReader(in.Response.Body)),
+			ContentLength: int64(len(in.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, &NoRecordingError{Method: req.Method, URL: req.URL.String(), Body: string(govpsie.RedactJSON(body))}
+}
+
+// matchKey identifies a request for replay. JSON bodies are re-encoded so
+// that whitespace and field order do not matter.
+func matchKey(method, path, query, body string) string {
+	var v any
+	if err := json.Unmarshal([]byte(body), &v); err == nil {
+		if normalized, err := json.Marshal(v); err == nil {
+			body = string(normalized)
+		}
+	}
+
+	return strings.Join([]string{strings.ToUpper(method), path, query, body}, "\n")
+}
+
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
@@ -0,0 +1,78 @@
+package govpsietest_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	govpsie "github.com/ahmedabdelkader99/goVPSie"
+	"github.com/ahmedabdelkader99/goVPSie/govpsietest"
+)
+
+func TestRecorder(t *testing.T) {
+	cassette := filepath.Join(t.TempDir(), "cassettes", "servers.json")
+	ctx := context.Background()
+
+	fake := govpsietest.NewServer()
+	fake.AddServer(govpsie.VmData{Hostname: "web-1", InitialPassword: "hunter2"})
+
+	rec, err := govpsietest.NewRecorder(cassette, govpsietest.ModeRecord, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := fake.Client(govpsie.WithTransport(rec))
+
+	recorded, err := client.Server.List(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Server.ChangePassword(ctx, recorded[0].Identifier, "s3cret"); !govpsie.IsNotFound(err) {
+		t.Fatalf("expected the fake to reject the call, got %v", err)
+	}
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	fake.Close()
+
+	data, err := os.ReadFile(cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"hunter2", "s3cret", govpsietest.Token} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette leaks %q", secret)
+		}
+	}
+
+	rec, err = govpsietest.NewRecorder(cassette, govpsietest.ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err = govpsie.NewClientWithOptions(govpsie.WithBaseURL(fake.URL), govpsie.WithTransport(rec))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	replayed, err := client.Server.List(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(replayed) != 1 || replayed[0].Identifier != recorded[0].Identifier {
+		t.Errorf("replayed %+v, recorded %+v", replayed, recorded)
+	}
+
+	var missing *govpsietest.NoRecordingError
+	if _, err := client.Server.List(ctx, nil); !errors.As(err, &missing) {
+		t.Errorf("expected a missing recording, got %v", err)
+	}
+	err = client.Server.ChangePassword(ctx, "vm-unknown", "hunter3")
+	if !errors.As(err, &missing) || strings.Contains(err.Error(), "hunter3") {
+		t.Errorf("expected a redacted missing recording, got %v", err)
+	}
+	if unused := rec.Unused(); len(unused) != 1 {
+		t.Errorf("expected the password change to be unused, got %d", len(unused))
+	}
+}
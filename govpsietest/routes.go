@@ -0,0 +1,1027 @@
+package govpsietest
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	govpsie "github.com/ahmedabdelkader99/goVPSie"
+)
+
+const loginPath = "/apps/v2/auth/from/api"
+
+type pendingCreation struct {
+	vm    govpsie.PendingVm
+	polls int
+}
+
+func (s *Server) routes() {
+	s.mux.HandleFunc("POST "+loginPath, s.login)
+	s.mux.HandleFunc("POST /apps/v2/auth/refresh", s.login)
+
+	s.mux.HandleFunc("GET /apps/v2/vm", s.listServers)
+	s.mux.HandleFunc("POST /apps/v2/vm", s.createServer)
+	s.mux.HandleFunc("DELETE /apps/v2/vm", s.deleteServer)
+	s.mux.HandleFunc("GET /apps/v2/vm/{id}", s.getServer)
+	s.mux.HandleFunc("GET /apps/v2/vm/status/{id}", s.getServerStatus)
+	s.mux.HandleFunc("GET /apps/v2/vm/pending", s.listPending)
+	s.mux.HandleFunc("POST /apps/v2/vm/start", s.setServerState("running"))
+	s.mux.HandleFunc("POST /apps/v2/vm/restart", s.setServerState("running"))
+	s.mux.HandleFunc("POST /apps/v2/vm/stop", s.setServerState("stopped"))
+	s.mux.HandleFunc("POST /apps/v2/vm/resize", s.resizeServer)
+
+	s.mux.HandleFunc("GET /apps/v2/firewall/groups", s.listFirewallGroups)
+	s.mux.HandleFunc("GET /apps/v2/firewall/group/{id}", s.getFirewallGroup)
+	s.mux.HandleFunc("POST /apps/v2/firewall/create/group", s.createFirewallGroup)
+	s.mux.HandleFunc("DELETE /apps/v2/firewall/delete/group", s.deleteFirewallGroup)
+	s.mux.HandleFunc("POST /apps/v2/firewall/attach/group", s.attachFirewallGroup(true))
+	s.mux.HandleFunc("POST /apps/v2/firewall/detach/group", s.attachFirewallGroup(false))
+
+	s.mux.HandleFunc("GET /apps/v2/storages", s.listStorages)
+	s.mux.HandleFunc("GET /apps/v2/storages/{id}", s.getStorage)
+	s.mux.HandleFunc("POST /apps/v2/storage/create", s.createStorage)
+	s.mux.HandleFunc("DELETE /apps/v2/storages", s.deleteStorage)
+
+	s.mux.HandleFunc("GET /apps/v2/snapshot", s.listSnapshots)
+	s.mux.HandleFunc("GET /apps/v2/vm/snapshot/{vm}", s.listSnapshots)
+	s.mux.HandleFunc("GET /apps/v2/backup/{id}", s.getSnapshot)
+	s.mux.HandleFunc("POST /apps/v2/snapshot/add", s.createSnapshot)
+	s.mux.HandleFunc("DELETE /apps/v2/snapshot", s.deleteSnapshot)
+
+	s.mux.HandleFunc("GET /apps/v2/vpc", s.listVPCs)
+	s.mux.HandleFunc("GET /apps/v2/vpc/{id}", s.getVPC)
+	s.mux.HandleFunc("POST /apps/v2/vpc/add", s.createVPC)
+	s.mux.HandleFunc("DELETE /apps/v2/vpc/{id}", s.deleteVPC)
+	s.mux.HandleFunc("POST /apps/v2/vm/add/vpc", s.assignVPC)
+
+	s.mux.HandleFunc("GET /apps/v2/domains", s.listDomains)
+	s.mux.HandleFunc("POST /apps/v2/domain/add", s.createDomain)
+	s.mux.HandleFunc("DELETE /apps/v2/domain/delete", s.deleteDomain)
+
+	s.mux.HandleFunc("GET /api/v1/lb/all", s.listLBs)
+	s.mux.HandleFunc("GET /api/v1/lb/{id}", s.getLB)
+	s.mux.HandleFunc("POST /api/v1/lb/rule/add", s.addLBRule)
+	s.mux.HandleFunc("POST /api/v1/lb/rule/update", s.updateLBRule)
+	s.mux.HandleFunc("DELETE /api/v1/lb/delete/rule", s.deleteLBRule)
+
+	s.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "no fake for "+r.Method+" "+r.URL.Path)
+	})
+}
+
+func (s *Server) login(w http.ResponseWriter, r *http.Request) {
+	expires := govpsie.Timestamp{Time: time.Now().Add(time.Hour).UTC()}
+	writeJSON(w, http.StatusOK, govpsie.TokenRoot{Token: govpsie.Token{
+		Access:  govpsie.TokenDetails{Token: Token, Expires: expires},
+		Refresh: govpsie.TokenDetails{Token: Token + "-refresh", Expires: expires},
+	}})
+}
+
+// Servers
+
+// AddServer stores vm, assigning an identifier if it has none, and returns
+// the stored copy.
+func (s *Server) AddServer(vm govpsie.VmData) govpsie.VmData {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if vm.Identifier == "" {
+		vm.Identifier = s.newIdentifier()
+	}
+	if vm.State == "" {
+		vm.State = "running"
+	}
+	s.servers.add(vm)
+	return vm
+}
+
+// Servers returns the stored servers.
+func (s *Server) Servers() []govpsie.VmData {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.servers.list()
+}
+
+func (s *Server) listServers(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	servers := s.servers.list()
+	if projectID := r.URL.Query().Get("projectId"); projectID != "" {
+		filtered := servers[:0]
+		for _, vm := range servers {
+			if vm.ProjectID == projectID {
+				filtered = append(filtered, vm)
+			}
+		}
+		servers = filtered
+	}
+
+	writeList(w, r, servers)
+}
+
+func (s *Server) getServer(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	vm, ok := s.servers.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "server not found")
+		return
+	}
+
+	writeData(w, map[string]any{"vmData": vm})
+}
+
+func (s *Server) getServerStatus(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	vm, ok := s.servers.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "server not found")
+		return
+	}
+
+	writeData(w, govpsie.Status{Status: vm.State, Fullname: vm.FullName})
+}
+
+func (s *Server) createServer(w http.ResponseWriter, r *http.Request) {
+	var req govpsie.CreateServerRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Hostname == "" || req.DcIdentifier == "" || req.OsIdentifier == "" || req.ResourceIdentifier == "" {
+		writeError(w, http.StatusBadRequest, "hostname, dcIdentifier, osIdentifier and resourceIdentifier are required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	vm := govpsie.VmData{
+		Identifier:   s.newIdentifier(),
+		Hostname:     req.Hostname,
+		DcIdentifier: req.DcIdentifier,
+		ProjectID:    req.ProjectID,
+		State:        "running",
+		CreatedOn:    now(),
+	}
+	s.servers.add(vm)
+
+	if s.pendingPolls == 0 {
+		writeData(w, map[string]string{"identifier": vm.Identifier})
+		return
+	}
+
+	processID := s.newIdentifier()
+	var pending govpsie.PendingVm
+	pending.ID = processID
+	pending.Type = "vm"
+	pending.Data.ProcessID = processID
+	pending.Data.Hostname = req.Hostname
+	pending.Data.DcIdentifier = req.DcIdentifier
+	pending.Data.OsIdentifier = req.OsIdentifier
+	pending.Data.ResourceIdentifier = req.ResourceIdentifier
+	s.pending = append(s.pending, pendingCreation{vm: pending, polls: s.pendingPolls})
+
+	writeData(w, map[string]string{"identifier": vm.Identifier, "processId": processID})
+}
+
+func (s *Server) deleteServer(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		VMIdentifier string `json:"vmIdentifier"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.servers.remove(req.VMIdentifier) {
+		writeError(w, http.StatusNotFound, "server not found")
+		return
+	}
+
+	writeData(w, nil)
+}
+
+func (s *Server) setServerState(state string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req govpsie.ActionRequest
+		if !decode(w, r, &req) {
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		vm, ok := s.servers.get(req.VmIdentifier)
+		if !ok {
+			writeError(w, http.StatusNotFound, "server not found")
+			return
+		}
+
+		vm.State = state
+		writeData(w, nil)
+	}
+}
+
+func (s *Server) resizeServer(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		VMIdentifier string `json:"vmIdentifier"`
+		RAM          string `json:"ram"`
+		CPU          string `json:"cup"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	cpu, cpuErr := strconv.ParseInt(req.CPU, 10, 64)
+	ram, ramErr := strconv.ParseInt(req.RAM, 10, 64)
+	if cpuErr != nil || ramErr != nil {
+		writeError(w, http.StatusBadRequest, "cpu and ram must be numbers")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	vm, ok := s.servers.get(req.VMIdentifier)
+	if !ok {
+		writeError(w, http.StatusNotFound, "server not found")
+		return
+	}
+
+	vm.Cpu, vm.Ram = cpu, ram
+	writeData(w, nil)
+}
+
+func (s *Server) listPending(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pending := make([]govpsie.PendingVm, 0, len(s.pending))
+	remaining := s.pending[:0]
+	for _, p := range s.pending {
+		pending = append(pending, p.vm)
+		if p.polls--; p.polls > 0 {
+			remaining = append(remaining, p)
+		}
+	}
+	s.pending = remaining
+
+	writeData(w, pending)
+}
+
+// Firewall groups
+
+// AddFirewallGroup stores group, assigning an identifier if it has none, and
+// returns the stored copy.
+func (s *Server) AddFirewallGroup(group govpsie.FirewallGroupDetailData) govpsie.FirewallGroupDetailData {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if group.Group.Identifier == "" {
+		group.Group.Identifier = s.newIdentifier()
+	}
+	s.firewallGroups.add(group)
+	return group
+}
+
+// FirewallGroups returns the stored firewall groups.
+func (s *Server) FirewallGroups() []govpsie.FirewallGroupDetailData {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.firewallGroups.list()
+}
+
+func (s *Server) listFirewallGroups(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	groups := make([]govpsie.FirewallGroupListData, 0, len(s.firewallGroups.items))
+	for _, g := range s.firewallGroups.items {
+		groups = append(groups, govpsie.FirewallGroupListData{
+			ID:            g.Group.ID,
+			GroupName:     g.Group.GroupName,
+			Identifier:    g.Group.Identifier,
+			CreatedOn:     g.Group.CreatedOn,
+			UpdatedOn:     g.Group.UpdatedOn,
+			InboundCount:  g.Group.InboundCount,
+			OutboundCount: g.Group.OutboundCount,
+			Vms:           int64(len(g.Vms)),
+			Rules:         g.Rules,
+			VmsData:       g.Vms,
+		})
+	}
+
+	writeList(w, r, groups)
+}
+
+func (s *Server) getFirewallGroup(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	group, ok := s.firewallGroups.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "firewall group not found")
+		return
+	}
+
+	writeData(w, group)
+}
+
+func (s *Server) createFirewallGroup(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		GroupName string                      `json:"groupName"`
+		Rules     []govpsie.FirewallUpdateReq `json:"rules"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.GroupName == "" {
+		writeError(w, http.StatusBadRequest, "groupName is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	group := govpsie.FirewallGroupDetailData{Group: govpsie.FirewallGroup{
+		ID:        int64(s.nextID),
+		GroupName: req.GroupName,
+		CreatedOn: now(),
+		UpdatedOn: now(),
+	}}
+	group.Group.Identifier = s.newIdentifier()
+
+	for _, rule := range req.Rules {
+		if rule.Type == "in" {
+			group.Group.InboundCount++
+		} else {
+			group.Group.OutboundCount++
+		}
+		group.Rules = append(group.Rules, govpsie.FirewallRule{
+			GroupID:    group.Group.ID,
+			Action:     rule.Action,
+			Type:       rule.Type,
+			Comment:    rule.Comment,
+			Dest:       rule.Dest,
+			Dport:      rule.Dport,
+			Proto:      rule.Proto,
+			Source:     rule.Source,
+			Sport:      rule.Sport,
+			Enable:     govpsie.NewFlag(rule.Enable != 0),
+			Macro:      rule.Macro,
+			Identifier: s.newIdentifier(),
+		})
+	}
+	s.firewallGroups.add(group)
+
+	writeData(w, map[string]string{"identifier": group.Group.Identifier})
+}
+
+func (s *Server) deleteFirewallGroup(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		GroupID string `json:"groupId"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.firewallGroups.remove(req.GroupID) {
+		writeError(w, http.StatusNotFound, "firewall group not found")
+		return
+	}
+
+	writeData(w, nil)
+}
+
+func (s *Server) attachFirewallGroup(attach bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			VMID    string `json:"vmId"`
+			GroupID string `json:"groupId"`
+		}
+		if !decode(w, r, &req) {
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		group, ok := s.firewallGroups.get(req.GroupID)
+		if !ok {
+			writeError(w, http.StatusNotFound, "firewall group not found")
+			return
+		}
+		vm, ok := s.servers.get(req.VMID)
+		if !ok {
+			writeError(w, http.StatusNotFound, "server not found")
+			return
+		}
+
+		vms := group.Vms[:0]
+		for _, attached := range group.Vms {
+			if attached.Identifier != vm.Identifier {
+				vms = append(vms, attached)
+			}
+		}
+		if attach {
+			vms = append(vms, govpsie.VmsData{Hostname: vm.Hostname, Identifier: vm.Identifier, Fullname: vm.FullName, Category: vm.Category})
+		}
+		group.Vms = vms
+		group.Count = int64(len(vms))
+		group.Group.Vms = group.Count
+		writeData(w, nil)
+	}
+}
+
+// Storages
+
+// AddStorage stores storage, assigning an identifier if it has none, and
+// returns the stored copy.
+func (s *Server) AddStorage(storage govpsie.Storage) govpsie.Storage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if storage.Identifier == "" {
+		storage.Identifier = s.newIdentifier()
+	}
+	s.storages.add(storage)
+	return storage
+}
+
+// Storages returns the stored storages.
+func (s *Server) Storages() []govpsie.Storage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.storages.list()
+}
+
+func (s *Server) listStorages(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeList(w, r, s.storages.list())
+}
+
+func (s *Server) getStorage(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, ok := s.storages.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "storage not found")
+		return
+	}
+
+	writeData(w, govpsie.StorageDetail{
+		ID:           st.ID,
+		Name:         st.Name,
+		Description:  st.Description,
+		Identifier:   st.Identifier,
+		StorageType:  st.StorageType,
+		DiskFormat:   st.DiskFormat,
+		Size:         st.Size,
+		State:        st.State,
+		DcIdentifier: st.DcIdentifier,
+		VMIdentifier: st.VmIdentifier,
+		Hostname:     st.Hostname,
+	})
+}
+
+func (s *Server) createStorage(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Storages []govpsie.StorageCreateRequest `json:"storages"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+	if len(req.Storages) == 0 {
+		writeError(w, http.StatusBadRequest, "storages is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	created := make([]map[string]string, 0, len(req.Storages))
+	for _, sr := range req.Storages {
+		if sr.Name == "" || sr.DcIdentifier == "" || sr.Size <= 0 {
+			writeError(w, http.StatusBadRequest, "name, dcIdentifier and a positive size are required")
+			return
+		}
+
+		s.nextID++
+		st := govpsie.Storage{
+			ID:           s.nextID,
+			Name:         sr.Name,
+			Description:  sr.Description,
+			StorageType:  sr.StorageType,
+			DiskFormat:   sr.DiskFormat,
+			IsAutomatic:  govpsie.NewFlag(sr.IsAutomatic != 0),
+			Size:         sr.Size,
+			DcIdentifier: sr.DcIdentifier,
+			CreatedOn:    now(),
+		}
+		st.Identifier = s.newIdentifier()
+		s.storages.add(st)
+		created = append(created, map[string]string{"storageIdentifier": st.Identifier})
+	}
+
+	writeData(w, created)
+}
+
+func (s *Server) deleteStorage(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		StorageIdentifier string `json:"storageIdentifier"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.storages.remove(req.StorageIdentifier) {
+		writeError(w, http.StatusNotFound, "storage not found")
+		return
+	}
+
+	writeData(w, nil)
+}
+
+// Snapshots
+
+// AddSnapshot stores snapshot, assigning an identifier if it has none, and
+// returns the stored copy.
+func (s *Server) AddSnapshot(snapshot govpsie.Snapshot) govpsie.Snapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if snapshot.Identifier == "" {
+		snapshot.Identifier = s.newIdentifier()
+	}
+	s.snapshots.add(snapshot)
+	return snapshot
+}
+
+// Snapshots returns the stored snapshots.
+func (s *Server) Snapshots() []govpsie.Snapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.snapshots.list()
+}
+
+func (s *Server) listSnapshots(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshots := s.snapshots.list()
+	if vm := r.PathValue("vm"); vm != "" {
+		filtered := snapshots[:0]
+		for _, sn := range snapshots {
+			if sn.VmIdentifier == vm {
+				filtered = append(filtered, sn)
+			}
+		}
+		snapshots = filtered
+	}
+
+	writeList(w, r, snapshots)
+}
+
+func (s *Server) getSnapshot(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sn, ok := s.snapshots.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "snapshot not found")
+		return
+	}
+
+	writeData(w, map[string]any{"backup": sn})
+}
+
+func (s *Server) createSnapshot(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Name         string `json:"name"`
+		VMIdentifier string `json:"vmIdentifier"`
+		Note         string `json:"note"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	vm, ok := s.servers.get(req.VMIdentifier)
+	if !ok {
+		writeError(w, http.StatusNotFound, "server not found")
+		return
+	}
+
+	sn := govpsie.Snapshot{
+		Name:         req.Name,
+		Note:         req.Note,
+		Identifier:   s.newIdentifier(),
+		Hostname:     vm.Hostname,
+		VmIdentifier: vm.Identifier,
+		DcIdentifier: vm.DcIdentifier,
+		IsSnapshot:   govpsie.NewFlag(true),
+		State:        "completed",
+		CreatedOn:    now(),
+	}
+	s.snapshots.add(sn)
+
+	writeData(w, map[string]string{"identifier": sn.Identifier})
+}
+
+func (s *Server) deleteSnapshot(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		SnapshotIdentifier string `json:"snapshotIdentifier"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.snapshots.remove(req.SnapshotIdentifier) {
+		writeError(w, http.StatusNotFound, "snapshot not found")
+		return
+	}
+
+	writeData(w, nil)
+}
+
+// VPCs
+
+// AddVPC stores vpc, assigning an ID if it has none, and returns the stored
+// copy.
+func (s *Server) AddVPC(vpc govpsie.VPC) govpsie.VPC {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if vpc.ID == 0 {
+		s.nextID++
+		vpc.ID = s.nextID
+	}
+	s.vpcs.add(vpc)
+	return vpc
+}
+
+// VPCs returns the stored VPCs.
+func (s *Server) VPCs() []govpsie.VPC {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.vpcs.list()
+}
+
+func (s *Server) listVPCs(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeList(w, r, s.vpcs.list())
+}
+
+func (s *Server) getVPC(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	vpc, ok := s.vpcs.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "vpc not found")
+		return
+	}
+
+	writeData(w, vpc)
+}
+
+func (s *Server) createVPC(w http.ResponseWriter, r *http.Request) {
+	var req govpsie.CreateVpcReq
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Name == "" || req.DcIdentifier == "" {
+		writeError(w, http.StatusBadRequest, "name and dcIdentifier are required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	vpc := govpsie.VPC{
+		ID:           s.nextID,
+		Name:         req.Name,
+		Description:  req.Description,
+		NetworkRange: req.NetworkRange,
+		NetworkSize:  req.NetworkSize,
+		DcIdentifier: req.DcIdentifier,
+		CreatedOn:    now(),
+		LastUpdated:  now(),
+	}
+	s.vpcs.add(vpc)
+
+	writeData(w, map[string]string{"identifier": strconv.Itoa(vpc.ID)})
+}
+
+func (s *Server) deleteVPC(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.vpcs.remove(r.PathValue("id")) {
+		writeError(w, http.StatusNotFound, "vpc not found")
+		return
+	}
+
+	writeData(w, nil)
+}
+
+func (s *Server) assignVPC(w http.ResponseWriter, r *http.Request) {
+	var req govpsie.AssignServerReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	vpc, ok := s.vpcs.get(strconv.Itoa(req.VpcID))
+	if !ok {
+		writeError(w, http.StatusNotFound, "vpc not found")
+		return
+	}
+	vm, ok := s.servers.get(req.VmIdentifier)
+	if !ok {
+		writeError(w, http.StatusNotFound, "server not found")
+		return
+	}
+
+	if vpc.DcIdentifier != vm.DcIdentifier {
+		writeError(w, http.StatusBadRequest, "vpc and server are in different data centers")
+		return
+	}
+
+	vpc.InterfaceNumber++
+	writeData(w, nil)
+}
+
+// Domains
+
+// AddDomain stores domain, assigning an identifier if it has none, and
+// returns the stored copy.
+func (s *Server) AddDomain(domain govpsie.Domain) govpsie.Domain {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if domain.Identifier == "" {
+		domain.Identifier = s.newIdentifier()
+	}
+	s.domains.add(domain)
+	return domain
+}
+
+// Domains returns the stored domains.
+func (s *Server) Domains() []govpsie.Domain {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.domains.list()
+}
+
+func (s *Server) listDomains(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeList(w, r, s.domains.list())
+}
+
+func (s *Server) createDomain(w http.ResponseWriter, r *http.Request) {
+	var req govpsie.CreateDomainRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Domain == "" {
+		writeError(w, http.StatusBadRequest, "domain is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, d := range s.domains.items {
+		if d.DomainName == req.Domain {
+			writeError(w, http.StatusConflict, "domain already exists")
+			return
+		}
+	}
+
+	domain := govpsie.Domain{
+		DomainName: req.Domain,
+		Identifier: s.newIdentifier(),
+		CreatedOn:  now(),
+	}
+	s.domains.add(domain)
+
+	writeData(w, map[string]string{"identifier": domain.Identifier})
+}
+
+func (s *Server) deleteDomain(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		DomainIdentifier string `json:"domainIdentifier"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.domains.remove(req.DomainIdentifier) {
+		writeError(w, http.StatusNotFound, "domain not found")
+		return
+	}
+
+	writeData(w, nil)
+}
+
+// Load balancers
+
+// AddLB stores lb, assigning an identifier if it has none, and returns the
+// stored copy. Load balancers cannot be created through the fake API.
+func (s *Server) AddLB(lb govpsie.LBDetails) govpsie.LBDetails {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if lb.Identifier == "" {
+		lb.Identifier = s.newIdentifier()
+	}
+	s.lbs.add(lb)
+	return lb
+}
+
+// LBs returns the stored load balancers.
+func (s *Server) LBs() []govpsie.LBDetails {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.lbs.list()
+}
+
+func (s *Server) listLBs(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lbs := make([]govpsie.LB, 0, len(s.lbs.items))
+	for _, lb := range s.lbs.items {
+		lbs = append(lbs, govpsie.LB{
+			LBName:     lb.LBName,
+			Traffic:    lb.Traffic,
+			BoxsizeID:  lb.BoxsizeID,
+			DefaultIP:  lb.DefaultIP,
+			DCName:     lb.DcName,
+			Identifier: lb.Identifier,
+			CreatedBy:  lb.CreatedBy,
+			UserID:     lb.UserID,
+		})
+	}
+
+	writeList(w, r, lbs)
+}
+
+func (s *Server) getLB(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lb, ok := s.lbs.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "load balancer not found")
+		return
+	}
+
+	writeData(w, lb)
+}
+
+func (s *Server) addLBRule(w http.ResponseWriter, r *http.Request) {
+	var req govpsie.AddRuleReq
+	if !decode(w, r, &req) {
+		return
+	}
+	frontPort, frontErr := strconv.Atoi(req.FrontPort)
+	backPort, backErr := strconv.Atoi(req.BackPort)
+	if frontErr != nil || backErr != nil {
+		writeError(w, http.StatusBadRequest, "frontPort and backPort must be numbers")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lb, ok := s.lbs.get(req.LbId)
+	if !ok {
+		writeError(w, http.StatusNotFound, "load balancer not found")
+		return
+	}
+	for _, rule := range lb.Rules {
+		if rule.FrontPort == frontPort {
+			writeError(w, http.StatusConflict, "front port already in use")
+			return
+		}
+	}
+
+	lb.Rules = append(lb.Rules, govpsie.LBRuleDetail{
+		Scheme:    req.Scheme,
+		FrontPort: frontPort,
+		BackPort:  backPort,
+		CreatedOn: now(),
+		RuleID:    s.newIdentifier(),
+	})
+	writeData(w, nil)
+}
+
+func (s *Server) updateLBRule(w http.ResponseWriter, r *http.Request) {
+	var req govpsie.RuleUpdateReq
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rule := s.lbRule(req.RuleID)
+	if rule == nil {
+		writeError(w, http.StatusNotFound, "rule not found")
+		return
+	}
+
+	rule.Scheme, rule.FrontPort, rule.BackPort = req.Scheme, req.FrontPort, req.BackPort
+	rule.Backends = nil
+	for _, backend := range req.Backends {
+		ip := backend.Ip
+		if vm, ok := s.servers.get(backend.VmIdentifier); ok && ip == "" {
+			ip = vm.DefaultIP
+		}
+		rule.Backends = append(rule.Backends, govpsie.LBBackendsDetail{
+			IP:           ip,
+			Identifier:   s.newIdentifier(),
+			VMIdentifier: backend.VmIdentifier,
+			CreatedOn:    now(),
+		})
+	}
+	writeData(w, nil)
+}
+
+func (s *Server) deleteLBRule(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		RuleID string `json:"ruleId"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.lbs.items {
+		lb := &s.lbs.items[i]
+		for j, rule := range lb.Rules {
+			if rule.RuleID == req.RuleID {
+				lb.Rules = append(lb.Rules[:j], lb.Rules[j+1:]...)
+				writeData(w, nil)
+				return
+			}
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "rule not found")
+}
+
+// lbRule returns the load balancer rule with the given identifier, or nil.
+// Callers must hold s.mu.
+func (s *Server) lbRule(id string) *govpsie.LBRuleDetail {
+	for i := range s.lbs.items {
+		for j := range s.lbs.items[i].Rules {
+			if s.lbs.items[i].Rules[j].RuleID == id {
+				return &s.lbs.items[i].Rules[j]
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+// Package govpsietest provides an in-process fake of the VPSie API for tests.
+//
+// A Server keeps servers, firewall groups, storages, snapshots, VPCs, domains
+// and load balancers in memory and answers with the same JSON shapes as the
+// real API, so a goVPSie.Client pointed at it behaves as it would in
+// production. Faults such as latency, error statuses and malformed bodies can
+// be injected per route.
+//
+// A Recorder captures exchanges with the real API as cassettes and replays
+// them, for tests that need the exact shapes the API returns.
+package govpsietest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	govpsie "github.com/ahmedabdelkader99/goVPSie"
+)
+
+// Token is the access token issued by the fake login endpoint.
+const Token = "govpsietest-token"
+
+// Server is a fake VPSie API listening on a local address.
+type Server struct {
+	// URL is the base URL of the fake API.
+	URL string
+
+	srv *httptest.Server
+	mux *http.ServeMux
+
+	mu             sync.Mutex
+	nextID         int
+	token          string
+	faults         []*Fault
+	pendingPolls   int
+	servers        collection[govpsie.VmData]
+	pending        []pendingCreation
+	firewallGroups collection[govpsie.FirewallGroupDetailData]
+	storages       collection[govpsie.Storage]
+	snapshots      collection[govpsie.Snapshot]
+	vpcs           collection[govpsie.VPC]
+	domains        collection[govpsie.Domain]
+	lbs            collection[govpsie.LBDetails]
+}
+
+// NewServer starts a fake API. Callers should Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		mux:            http.NewServeMux(),
+		servers:        collection[govpsie.VmData]{id: func(v *govpsie.VmData) string { return v.Identifier }},
+		firewallGroups: collection[govpsie.FirewallGroupDetailData]{id: func(g *govpsie.FirewallGroupDetailData) string { return g.Group.Identifier }},
+		storages:       collection[govpsie.Storage]{id: func(st *govpsie.Storage) string { return st.Identifier }},
+		snapshots:      collection[govpsie.Snapshot]{id: func(sn *govpsie.Snapshot) string { return sn.Identifier }},
+		vpcs:           collection[govpsie.VPC]{id: func(v *govpsie.VPC) string { return strconv.Itoa(v.ID) }},
+		domains:        collection[govpsie.Domain]{id: func(d *govpsie.Domain) string { return d.Identifier }},
+		lbs:            collection[govpsie.LBDetails]{id: func(lb *govpsie.LBDetails) string { return lb.Identifier }},
+	}
+
+	s.routes()
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns a client for the fake API authenticated with Token. opts are
+// applied after the defaults; Client panics if one of them is invalid.
+func (s *Server) Client(opts ...govpsie.ClientOption) *govpsie.Client {
+	defaults := []govpsie.ClientOption{
+		govpsie.WithHTTPClient(s.srv.Client()),
+		govpsie.WithBaseURL(s.URL),
+		govpsie.WithAuthToken(Token),
+	}
+
+	client, err := govpsie.NewClientWithOptions(append(defaults, opts...)...)
+	if err != nil {
+		panic("govpsietest: " + err.Error())
+	}
+
+	return client
+}
+
+// RequireToken makes every request other than login fail with 401 unless it
+// carries token in the Vpsie-Auth header. An empty token accepts anything,
+// which is the default.
+func (s *Server) RequireToken(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = token
+}
+
+// SetPendingPolls sets how many calls to the pending servers endpoint report
+// a new server as pending before its creation completes. The default is zero:
+// servers are created at once and their creation returns no process ID.
+func (s *Server) SetPendingPolls(polls int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pendingPolls = polls
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.applyFaults(w, r) {
+		return
+	}
+
+	s.mu.Lock()
+	token := s.token
+	s.mu.Unlock()
+
+	if token != "" && r.URL.Path != loginPath && r.Header.Get("Vpsie-Auth") != token {
+		writeError(w, http.StatusUnauthorized, "invalid token")
+		return
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// newIdentifier returns a fresh identifier shaped like the API's UUIDs.
+// Callers must hold s.mu.
+func (s *Server) newIdentifier() string {
+	s.nextID++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", s.nextID)
+}
+
+func now() govpsie.Timestamp {
+	return govpsie.Timestamp{Time: time.Now().UTC().Truncate(time.Second)}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeData(w http.ResponseWriter, data any) {
+	writeJSON(w, http.StatusOK, map[string]any{"error": false, "data": data})
+}
+
+func writeList[T any](w http.ResponseWriter, r *http.Request, items []T) {
+	writeJSON(w, http.StatusOK, map[string]any{"error": false, "data": page(r, items), "total": len(items)})
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, govpsie.ErrorRsp{Error: true, Code: status, Message: message})
+}
+
+// decode reads the JSON request body into v, answering 400 on failure.
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return false
+	}
+
+	return true
+}
+
+// page applies the offset and limit query parameters used by List methods.
+// The offset is a page index, as sent by goVPSie.ListOptions.
+func page[T any](r *http.Request, items []T) []T {
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if limit <= 0 {
+		return items
+	}
+
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	start := min(max(offset, 0)*limit, len(items))
+	return items[start:min(start+limit, len(items))]
+}
+
+// collection is an ordered set of resources keyed by identifier.
+type collection[T any] struct {
+	id    func(*T) string
+	items []T
+}
+
+func (c *collection[T]) add(item T) {
+	c.items = append(c.items, item)
+}
+
+func (c *collection[T]) get(id string) (*T, bool) {
+	for i := range c.items {
+		if strings.EqualFold(c.id(&c.items[i]), id) {
+			return &c.items[i], true
+		}
+	}
+
+	return nil, false
+}
+
+func (c *collection[T]) remove(id string) bool {
+	for i := range c.items {
+		if strings.EqualFold(c.id(&c.items[i]), id) {
+			c.items = append(c.items[:i], c.items[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *collection[T]) list() []T {
+	return append([]T(nil), c.items...)
+}
@@ -0,0 +1,172 @@
+package govpsietest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	govpsie "github.com/ahmedabdelkader99/goVPSie"
+	"github.com/ahmedabdelkader99/goVPSie/govpsietest"
+)
+
+func TestServerLifecycle(t *testing.T) {
+	fake := govpsietest.NewServer()
+	defer fake.Close()
+	fake.SetPendingPolls(2)
+
+	client := fake.Client()
+	ctx := context.Background()
+
+	result, err := client.Server.CreateServerWithResult(ctx, &govpsie.CreateServerRequest{
+		ResourceIdentifier: "plan",
+		OsIdentifier:       "os",
+		DcIdentifier:       "dc",
+		Hostname:           "web-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var polls int
+	opts := &govpsie.WaitOptions{PollInterval: time.Millisecond, OnProgress: func(govpsie.WaitProgress) { polls++ }}
+	vm, err := client.Server.WaitForCreation(ctx, result, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vm.Identifier != result.Identifier || vm.Hostname != "web-1" {
+		t.Errorf("unexpected server %+v", vm)
+	}
+	if polls != 3 {
+		t.Errorf("creation finished after %d polls", polls)
+	}
+
+	if err := client.Server.StopServer(ctx, result.Identifier); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Server.WaitForState(ctx, result.Identifier, "stopped", opts); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.Snapshot.Create(ctx, "before-upgrade", result.Identifier, ""); err != nil {
+		t.Fatal(err)
+	}
+	snapshots, err := client.Snapshot.ListByVm(ctx, nil, result.Identifier)
+	if err != nil || len(snapshots) != 1 {
+		t.Fatalf("unexpected snapshots %v: %v", snapshots, err)
+	}
+
+	if err := client.Server.DeleteServer(ctx, result.Identifier, "", "", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Server.GetServerByIdentifier(ctx, result.Identifier); !govpsie.IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
+
+func TestWaitForCreation(t *testing.T) {
+	fake := govpsietest.NewServer()
+	defer fake.Close()
+
+	client := fake.Client()
+	ctx := context.Background()
+	opts := &govpsie.WaitOptions{PollInterval: time.Millisecond, Timeout: 50 * time.Millisecond}
+	create := func() *govpsie.CreateResult {
+		t.Helper()
+		result, err := client.Server.CreateServerWithResult(ctx, &govpsie.CreateServerRequest{
+			ResourceIdentifier: "plan",
+			OsIdentifier:       "os",
+			DcIdentifier:       "dc",
+			Hostname:           "web-1",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return result
+	}
+
+	// A server of the same name created before the call is not the new one,
+	// and a process never seen pending may not be listed yet.
+	old := fake.AddServer(govpsie.VmData{Hostname: "web-1", CreatedOn: govpsie.Timestamp{Time: time.Now().Add(-time.Hour)}})
+	fake.AddFault(govpsietest.Fault{Method: http.MethodPost, PathPrefix: "/apps/v2/vm", Body: `{"error":false,"data":{"processId":"p-1"}}`, Times: 1})
+	result := create()
+	var timeout *govpsie.WaitTimeoutError
+	if _, err := client.Server.WaitForCreation(ctx, result, opts); !errors.As(err, &timeout) {
+		t.Fatalf("expected a timeout, got %v", err)
+	}
+	created := fake.AddServer(govpsie.VmData{Hostname: "web-1", CreatedOn: govpsie.Timestamp{Time: time.Now()}})
+	if vm, err := client.Server.WaitForCreation(ctx, result, opts); err != nil || vm.Identifier != created.Identifier {
+		t.Fatalf("WaitForCreation = %+v, %v; want %s, not %s", vm, err, created.Identifier, old.Identifier)
+	}
+
+	// A creation over before the first poll is found by its identifier.
+	result = create()
+	if vm, err := client.Server.WaitForCreation(ctx, result, opts); err != nil || vm.Identifier != result.Identifier {
+		t.Fatalf("WaitForCreation = %+v, %v", vm, err)
+	}
+
+	// The server disappears while its creation is pending, as when the
+	// creation fails.
+	fake.SetPendingPolls(2)
+	result = create()
+	opts.OnProgress = func(p govpsie.WaitProgress) {
+		if p.Attempt == 1 {
+			_ = client.Server.DeleteServer(ctx, result.Identifier, "", "", "")
+		}
+	}
+	if _, err := client.Server.WaitForCreation(ctx, result, opts); err == nil || !strings.Contains(err.Error(), "without a server") {
+		t.Errorf("expected a failed creation, got %v", err)
+	}
+}
+
+func TestFaults(t *testing.T) {
+	fake := govpsietest.NewServer()
+	defer fake.Close()
+
+	for range 3 {
+		fake.AddDomain(govpsie.Domain{DomainName: "example.com"})
+	}
+
+	client := fake.Client(govpsie.WithRetryPolicy(govpsie.RetryPolicy{
+		MaxAttempts:       2,
+		RetryableStatuses: []int{http.StatusServiceUnavailable},
+		RetryableMethods:  []string{http.MethodGet},
+	}))
+	ctx := context.Background()
+
+	// A single 503 is absorbed by the retry policy.
+	fake.AddFault(govpsietest.Fault{PathPrefix: "/apps/v2/domains", Status: http.StatusServiceUnavailable, Times: 1})
+	domains, err := client.Domain.ListDomains(ctx, &govpsie.ListOptions{PerPage: 2})
+	if err != nil || len(domains) != 2 {
+		t.Fatalf("unexpected domains %v: %v", domains, err)
+	}
+
+	fake.AddFault(govpsietest.Fault{Method: http.MethodGet, Status: http.StatusConflict, Times: 1})
+	if _, err := client.Domain.ListDomains(ctx, nil); !govpsie.IsConflict(err) {
+		t.Errorf("expected conflict, got %v", err)
+	}
+
+	// Only the fault answering a request uses up its Times.
+	fake.AddFault(govpsietest.Fault{Method: http.MethodGet, Status: http.StatusConflict, Times: 1})
+	fake.AddFault(govpsietest.Fault{Method: http.MethodGet, Status: http.StatusNotFound, Times: 1})
+	if _, err := client.Domain.ListDomains(ctx, nil); !govpsie.IsConflict(err) {
+		t.Errorf("expected conflict, got %v", err)
+	}
+	if _, err := client.Domain.ListDomains(ctx, nil); !govpsie.IsNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	fake.AddFault(govpsietest.Fault{Body: `{"error":false,"data":[`, Times: 1})
+	if _, err := client.Domain.ListDomains(ctx, nil); err == nil {
+		t.Error("expected a decoding error")
+	}
+
+	fake.AddFault(govpsietest.Fault{Latency: time.Second})
+	ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	if _, err := client.Domain.ListDomains(ctx, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected a deadline, got %v", err)
+	}
+}
@@ -0,0 +1,158 @@
+package goVPSie
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultIdempotencyHeader = "Idempotency-Key"
+	defaultMaxClockSkew      = time.Minute
+)
+
+// IdempotencyOptions makes the create calls of Server.CreateServer, LB.CreateLB,
+// Bucket.Create and K8s.Create safe to retry. See Client.SetIdempotency.
+type IdempotencyOptions struct {
+	// Header carries the idempotency key of each create call. Defaults to
+	// "Idempotency-Key".
+	Header string
+
+	// Dedupe looks, before every retry of a create call, for a resource with
+	// the same name (and project, for servers) created since the call began,
+	// and returns it instead of creating a second one. Resources without a
+	// creation time never match. Use it when the API does not honor the
+	// idempotency header.
+	Dedupe bool
+
+	// HeaderHonored declares that the API answers a repeated idempotency key
+	// with the outcome of the first call instead of creating the resource
+	// again. The VPSie API does not document this, so leave it unset unless
+	// the endpoints used are known to honor Header.
+	HeaderHonored bool
+
+	// MaxClockSkew is how much earlier than the start of the call a resource
+	// found by Dedupe may claim to have been created, to absorb differences
+	// between the local and the API clock. Defaults to one minute.
+	MaxClockSkew time.Duration
+}
+
+// SetIdempotency enables idempotency keys on create calls, or disables them
+// if opts is nil. Each call gets a random key, or the one set on its context
+// by WithIdempotencyKey, and sends it on every attempt. With Dedupe or
+// HeaderHonored set, create calls are retried under the retry policy even
+// though POST is not among its RetryableMethods; otherwise a failed create
+// call is never retried, since a retry after a lost response could create a
+// second resource.
+func (c *Client) SetIdempotency(opts *IdempotencyOptions) {
+	if opts != nil {
+		o := *opts
+		if o.Header == "" {
+			o.Header = defaultIdempotencyHeader
+		}
+		if o.MaxClockSkew <= 0 {
+			o.MaxClockSkew = defaultMaxClockSkew
+		}
+		opts = &o
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.idempotency = opts
+}
+
+type idempotencyKeyContextKey struct{}
+
+// WithIdempotencyKey makes the create call made with ctx send key instead of
+// a generated one. Reuse the key when repeating a call whose outcome is
+// unknown, so that the API can recognize the repeat.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// newIdempotencyKey returns a random version 4 UUID.
+func newIdempotencyKey() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// existingFunc returns the identifier of a resource made by an earlier attempt
+// of a create call, looking at resources created since the given time, or ""
+// if there is none.
+type existingFunc func(ctx context.Context, since time.Time) (string, error)
+
+// createCall is the state Do keeps for a create call carrying an idempotency
+// key.
+type createCall struct {
+	existing existingFunc
+	since    time.Time
+
+	// retryable allows the call to be retried: it is deduplicated, or the API
+	// honors its key.
+	retryable bool
+}
+
+type createContextKey struct{}
+
+// idempotent sets an idempotency key on the create request req and returns
+// the context to pass to Do with it. With Dedupe on, Do calls existing before
+// every retry. Without idempotency options, ctx is returned unchanged.
+func (c *Client) idempotent(ctx context.Context, req *http.Request, existing existingFunc) context.Context {
+	c.mu.RLock()
+	opts := c.idempotency
+	c.mu.RUnlock()
+
+	if opts == nil {
+		return ctx
+	}
+
+	key, _ := ctx.Value(idempotencyKeyContextKey{}).(string)
+	if key == "" {
+		key = newIdempotencyKey()
+	}
+	req.Header.Set(opts.Header, key)
+
+	call := &createCall{since: time.Now().Add(-opts.MaxClockSkew), retryable: opts.Dedupe || opts.HeaderHonored}
+	if opts.Dedupe {
+		call.existing = existing
+	}
+	return context.WithValue(ctx, createContextKey{}, call)
+}
+
+func createCallFrom(ctx context.Context) *createCall {
+	call, _ := ctx.Value(createContextKey{}).(*createCall)
+	return call
+}
+
+// lookup runs the dedupe check of call. The requests it makes are not part of
+// the create call.
+func (call *createCall) lookup(ctx context.Context) (string, error) {
+	if call.existing == nil {
+		return "", nil
+	}
+	return call.existing(context.WithValue(ctx, createContextKey{}, (*createCall)(nil)), call.since)
+}
+
+// createdBody is the response Do decodes when dedupe finds the resource a
+// failed attempt created, shaped like the answer of the create endpoints.
+func createdBody(identifier string) []byte {
+	body, _ := json.Marshal(map[string]any{
+		"error": false,
+		"data":  map[string]string{"identifier": identifier},
+	})
+	return body
+}
+
+// createdSince reports whether a resource created at t may come from a call
+// that began at since. A resource without a creation time may predate the
+// call, so it never matches.
+func createdSince(t Timestamp, since time.Time) bool {
+	return !t.IsZero() && !t.Before(since)
+}
@@ -2,7 +2,9 @@ package goVPSie
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"time"
 )
@@ -12,8 +14,10 @@ var imagesPath = "/apps/v2/custom"
 type ImagesService interface {
 	DeleteImage(ctx context.Context, imageIdentifier string) error
 	List(ctx context.Context, options *ListOptions) ([]CustomImage, error)
+	All(ctx context.Context, opts ...IterOption) iter.Seq2[CustomImage, error]
 	CreateImages(ctx context.Context, dcIdentifier, imageName, imageUrl string) error
 	CreateServerByImage(ctx context.Context, createServerReq *CreateServerRequest) error
+	CreateServerByImageWithResult(ctx context.Context, createServerReq *CreateServerRequest) (*CreateResult, error)
 	GetImage(ctx context.Context, imageIdentifier string) (*CustomImage, error)
 }
 
@@ -26,7 +30,7 @@ var _ ImagesService = &imagesServiceHandler{}
 type ListCustomImageRoot struct {
 	Error bool          `json:"error"`
 	Data  []CustomImage `json:"data"`
-	Total int           `json:"total "`
+	Total int           `json:"total"`
 }
 
 type CustomImage struct {
@@ -39,7 +43,7 @@ type CustomImage struct {
 	ChangedName    string    `json:"changed_name"`
 	ImageHash      string    `json:"image_hash"`
 	ImageLabel     string    `json:"image_label"`
-	CreatedOn      time.Time `json:"created_on"`
+	CreatedOn      Timestamp `json:"created_on"`
 	Deleted        int       `json:"deleted"`
 	Identifier     string    `json:"identifier"`
 	Storage        string    `json:"storage"`
@@ -49,7 +53,10 @@ type CustomImage struct {
 }
 
 func (i *imagesServiceHandler) List(ctx context.Context, options *ListOptions) ([]CustomImage, error) {
-	path := fmt.Sprintf("%s/images", imagesPath)
+	path, err := addOptions(fmt.Sprintf("%s/images", imagesPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := i.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -61,9 +68,15 @@ func (i *imagesServiceHandler) List(ctx context.Context, options *ListOptions) (
 		return nil, err
 	}
 
+	options.setMeta(images.Total, len(images.Data))
 	return images.Data, nil
 }
 
+// All iterates over every custom image, fetching pages lazily.
+func (i *imagesServiceHandler) All(ctx context.Context, opts ...IterOption) iter.Seq2[CustomImage, error] {
+	return paginate(ctx, i.List, opts)
+}
+
 func (i *imagesServiceHandler) CreateImages(ctx context.Context, dcIdentifier, imageName, imageUrl string) error {
 	path := fmt.Sprintf("%s/images", imagesPath)
 
@@ -104,17 +117,29 @@ func (i *imagesServiceHandler) DeleteImage(ctx context.Context, imageIdentifier
 }
 
 func (i *imagesServiceHandler) CreateServerByImage(ctx context.Context, createServerReq *CreateServerRequest) error {
+	_, err := i.CreateServerByImageWithResult(ctx, createServerReq)
+	return err
+}
+
+// CreateServerByImageWithResult is like CreateServerByImage but also returns
+// the identifier or process ID of the new server.
+func (i *imagesServiceHandler) CreateServerByImageWithResult(ctx context.Context, createServerReq *CreateServerRequest) (*CreateResult, error) {
 	path := fmt.Sprintf("%s/vm", imagesPath)
+	started := time.Now()
+	createServerReq = withDefaultProject(i.client, createServerReq, func(r *CreateServerRequest) *string { return &r.ProjectID })
 	req, err := i.client.NewRequest(ctx, http.MethodPost, path, createServerReq)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if err = i.client.Do(ctx, req, nil); err != nil {
-		return err
+	var raw json.RawMessage
+	if err = i.client.Do(ctx, req, &raw); err != nil {
+		return nil, err
 	}
 
-	return nil
+	result := newCreateResult(raw)
+	result.started, result.hostname = started, createServerReq.Hostname
+	return result, nil
 }
 
 func (i *imagesServiceHandler) GetImage(ctx context.Context, imageIdentifier string) (*CustomImage, error) {
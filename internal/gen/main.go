@@ -0,0 +1,414 @@
+// Command gen generates code that wraps every service of goVPSie.Client.
+//
+// It reads the service interfaces from the root package, so wrappers stay in
+// step with the API surface without being edited by hand:
+//
+//	go run ./internal/gen -kind otel -out govpsieotel/services_gen.go
+//	go run ./internal/gen -kind mock -out mocks/services_gen.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// rootPackage is the import path of the package whose services are wrapped.
+const rootPackage = "github.com/ahmedabdelkader99/goVPSie"
+
+type service struct {
+	Field     string // Client field holding the service
+	Interface string
+	Methods   []method
+}
+
+type method struct {
+	Name    string
+	Params  []param
+	Results []string
+}
+
+type param struct {
+	Name     string
+	Type     string
+	Variadic bool
+}
+
+// Signature returns the parameter and result lists of m.
+func (m method) Signature() string {
+	params := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		if p.Variadic {
+			params[i] = p.Name + " ..." + p.Type
+		} else {
+			params[i] = p.Name + " " + p.Type
+		}
+	}
+
+	sig := "(" + strings.Join(params, ", ") + ")"
+	switch len(m.Results) {
+	case 0:
+	case 1:
+		sig += " " + m.Results[0]
+	default:
+		sig += " (" + strings.Join(m.Results, ", ") + ")"
+	}
+	return sig
+}
+
+// Args returns the arguments that forward m's parameters to another call.
+func (m method) Args() string {
+	args := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		args[i] = p.Name
+		if p.Variadic {
+			args[i] += "..."
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+// Iter reports whether m returns a lazy iterator rather than a result.
+func (m method) Iter() bool {
+	return len(m.Results) == 1 && strings.HasPrefix(m.Results[0], "iter.Seq2[")
+}
+
+// ReturnsError reports whether the last result of m is an error.
+func (m method) ReturnsError() bool {
+	return len(m.Results) > 0 && m.Results[len(m.Results)-1] == "error"
+}
+
+// Vars returns names for m's results, with the error named err.
+func (m method) Vars() string {
+	vars := make([]string, len(m.Results))
+	for i := range m.Results {
+		vars[i] = fmt.Sprintf("r%d", i)
+	}
+	if m.ReturnsError() {
+		vars[len(vars)-1] = "err"
+	}
+	return strings.Join(vars, ", ")
+}
+
+var generators = map[string]*template.Template{
+	"otel": otelTemplate,
+	"mock": mockTemplate,
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gen: ")
+
+	kind := flag.String("kind", "", "kind of code to generate: otel or mock")
+	dir := flag.String("dir", ".", "directory of the goVPSie package")
+	out := flag.String("out", "", "output file")
+	flag.Parse()
+
+	tmpl, ok := generators[*kind]
+	if !ok || *out == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	services, err := load(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, map[string]any{"Package": rootPackage, "Services": services}); err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("formatting generated code: %v\n%s", err, buf.Bytes())
+	}
+
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// load parses the package in dir and returns the services of its Client in
+// field order.
+func load(dir string) ([]service, error) {
+	fset := token.NewFileSet()
+	skipTests := func(fi os.FileInfo) bool { return !strings.HasSuffix(fi.Name(), "_test.go") }
+	pkgs, err := parser.ParseDir(fset, dir, skipTests, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected one package in %s, found %d", filepath.Clean(dir), len(pkgs))
+	}
+
+	var files []*ast.File
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			files = append(files, f)
+		}
+	}
+
+	interfaces := map[string]*ast.InterfaceType{}
+	handlers := map[string]string{}            // interface -> handler type
+	handlerParams := map[string][]*ast.Field{} // handler.Method -> params
+	var client *ast.StructType
+
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						switch t := spec.Type.(type) {
+						case *ast.InterfaceType:
+							interfaces[spec.Name.Name] = t
+						case *ast.StructType:
+							if spec.Name.Name == "Client" {
+								client = t
+							}
+						}
+					case *ast.ValueSpec:
+						if iface, handler, ok := assertion(spec); ok {
+							handlers[iface] = handler
+						}
+					}
+				}
+			case *ast.FuncDecl:
+				if decl.Recv != nil && len(decl.Recv.List) == 1 {
+					if recv, ok := decl.Recv.List[0].Type.(*ast.StarExpr); ok {
+						if ident, ok := recv.X.(*ast.Ident); ok {
+							handlerParams[ident.Name+"."+decl.Name.Name] = decl.Type.Params.List
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if client == nil {
+		return nil, fmt.Errorf("no Client type in %s", dir)
+	}
+
+	var services []service
+	for _, field := range client.Fields.List {
+		ident, ok := field.Type.(*ast.Ident)
+		if !ok || len(field.Names) != 1 {
+			continue
+		}
+		iface, ok := interfaces[ident.Name]
+		if !ok || !strings.HasSuffix(ident.Name, "Service") {
+			continue
+		}
+
+		svc := service{Field: field.Names[0].Name, Interface: ident.Name}
+		for _, m := range iface.Methods.List {
+			fn, ok := m.Type.(*ast.FuncType)
+			if !ok || len(m.Names) != 1 {
+				return nil, fmt.Errorf("%s: embedded interfaces are not supported", ident.Name)
+			}
+
+			name := m.Names[0].Name
+			meth, err := newMethod(name, fn, handlerParams[handlers[ident.Name]+"."+name])
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", ident.Name, name, err)
+			}
+			svc.Methods = append(svc.Methods, meth)
+		}
+
+		services = append(services, svc)
+	}
+
+	return services, nil
+}
+
+// assertion matches "var _ Iface = &handler{}".
+func assertion(spec *ast.ValueSpec) (iface, handler string, ok bool) {
+	if len(spec.Names) != 1 || spec.Names[0].Name != "_" || len(spec.Values) != 1 {
+		return "", "", false
+	}
+	typ, ok := spec.Type.(*ast.Ident)
+	if !ok {
+		return "", "", false
+	}
+	unary, ok := spec.Values[0].(*ast.UnaryExpr)
+	if !ok || unary.Op != token.AND {
+		return "", "", false
+	}
+	lit, ok := unary.X.(*ast.CompositeLit)
+	if !ok {
+		return "", "", false
+	}
+	name, ok := lit.Type.(*ast.Ident)
+	if !ok {
+		return "", "", false
+	}
+	return typ.Name, name.Name, true
+}
+
+// newMethod builds a method from its interface signature, naming unnamed
+// parameters after the handler's.
+func newMethod(name string, fn *ast.FuncType, handler []*ast.Field) (method, error) {
+	var fallback []string
+	for _, field := range handler {
+		for _, n := range field.Names {
+			fallback = append(fallback, n.Name)
+		}
+	}
+
+	m := method{Name: name}
+	for _, field := range fn.Params.List {
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{nil}
+		}
+
+		typ := field.Type
+		variadic := false
+		if ellipsis, ok := typ.(*ast.Ellipsis); ok {
+			typ, variadic = ellipsis.Elt, true
+		}
+		typeString, err := qualify(typ)
+		if err != nil {
+			return m, err
+		}
+
+		for _, n := range names {
+			i := len(m.Params)
+			pname := fmt.Sprintf("p%d", i)
+			switch {
+			case i == 0:
+				pname = "ctx"
+			case n != nil && n.Name != "_":
+				pname = n.Name
+			case i < len(fallback) && fallback[i] != "_":
+				pname = fallback[i]
+			}
+			m.Params = append(m.Params, param{Name: pname, Type: typeString, Variadic: variadic})
+		}
+	}
+
+	if len(m.Params) == 0 || m.Params[0].Type != "context.Context" {
+		return m, fmt.Errorf("first parameter must be a context.Context")
+	}
+
+	if fn.Results != nil {
+		for _, field := range fn.Results.List {
+			typeString, err := qualify(field.Type)
+			if err != nil {
+				return m, err
+			}
+			for range max(len(field.Names), 1) {
+				m.Results = append(m.Results, typeString)
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// qualify renders a type expression as seen from another package, prefixing
+// the root package's exported identifiers with goVPSie.
+func qualify(expr ast.Expr) (string, error) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if unicode.IsUpper(rune(t.Name[0])) {
+			return "goVPSie." + t.Name, nil
+		}
+		return t.Name, nil
+	case *ast.SelectorExpr:
+		pkg, ok := t.X.(*ast.Ident)
+		if !ok {
+			break
+		}
+		return pkg.Name + "." + t.Sel.Name, nil
+	case *ast.StarExpr:
+		elem, err := qualify(t.X)
+		return "*" + elem, err
+	case *ast.ArrayType:
+		if t.Len != nil {
+			break
+		}
+		elem, err := qualify(t.Elt)
+		return "[]" + elem, err
+	case *ast.MapType:
+		key, err := qualify(t.Key)
+		if err != nil {
+			return "", err
+		}
+		value, err := qualify(t.Value)
+		return "map[" + key + "]" + value, err
+	case *ast.InterfaceType:
+		if len(t.Methods.List) == 0 {
+			return "any", nil
+		}
+	case *ast.IndexExpr:
+		return qualifyGeneric(t.X, []ast.Expr{t.Index})
+	case *ast.IndexListExpr:
+		return qualifyGeneric(t.X, t.Indices)
+	}
+
+	return "", fmt.Errorf("unsupported type expression %T", expr)
+}
+
+func qualifyGeneric(x ast.Expr, indices []ast.Expr) (string, error) {
+	base, err := qualify(x)
+	if err != nil {
+		return "", err
+	}
+
+	args := make([]string, len(indices))
+	for i, index := range indices {
+		if args[i], err = qualify(index); err != nil {
+			return "", err
+		}
+	}
+	return base + "[" + strings.Join(args, ", ") + "]", nil
+}
+
+// imports returns the standard library packages referenced by the services'
+// signatures, for the generated import block.
+func imports(services []service) []string {
+	seen := map[string]bool{}
+	for _, svc := range services {
+		for _, m := range svc.Methods {
+			var types []string
+			for _, p := range m.Params {
+				types = append(types, p.Type)
+			}
+			types = append(types, m.Results...)
+
+			for _, t := range types {
+				for _, pkg := range []string{"context", "iter", "time", "net/http", "encoding/json"} {
+					if strings.Contains(t, filepath.Base(pkg)+".") {
+						seen[pkg] = true
+					}
+				}
+			}
+		}
+	}
+
+	var pkgs []string
+	for pkg := range seen {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs)
+	return pkgs
+}
+
+var funcs = template.FuncMap{
+	"imports": imports,
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"strings"
+	"text/template"
+)
+
+// recordedArgs returns m's arguments after the context, as recorded by a fake.
+func recordedArgs(m method) string {
+	var args []string
+	for _, p := range m.Params[1:] {
+		args = append(args, p.Name)
+	}
+
+	if len(args) == 0 {
+		return ""
+	}
+	return ", " + strings.Join(args, ", ")
+}
+
+// unstubbed returns the results of a fake method that has no stub.
+func unstubbed(svc service, m method) string {
+	notStubbed := `notStubbed("` + svc.Interface + `", "` + m.Name + `")`
+	if m.Iter() {
+		elem := strings.TrimSuffix(strings.TrimPrefix(m.Results[0], "iter.Seq2["), ", error]")
+		return "failed[" + elem + "](" + notStubbed + ")"
+	}
+
+	results := make([]string, len(m.Results))
+	for i, r := range m.Results {
+		results[i] = "zero[" + r + "]()"
+	}
+	if m.ReturnsError() {
+		results[len(results)-1] = notStubbed
+	}
+	return strings.Join(results, ", ")
+}
+
+var mockTemplate = template.Must(template.New("mock").Funcs(funcs).Funcs(template.FuncMap{
+	"args":      recordedArgs,
+	"unstubbed": unstubbed,
+}).Parse(`// Code generated by internal/gen; DO NOT EDIT.
+
+package mocks
+
+import (
+{{- range imports .Services}}
+	"{{.}}"
+{{- end}}
+
+	goVPSie "{{.Package}}"
+)
+
+// Services holds a fake for every service of a goVPSie.Client.
+type Services struct {
+{{- range .Services}}
+	{{.Field}} *{{.Interface}}
+{{- end}}
+}
+
+func newServices() *Services {
+	return &Services{
+{{- range .Services}}
+		{{.Field}}: &{{.Interface}}{},
+{{- end}}
+	}
+}
+
+// install replaces the services of c with the fakes in s.
+func (s *Services) install(c *goVPSie.Client) {
+{{- range .Services}}
+	c.{{.Field}} = s.{{.Field}}
+{{- end}}
+}
+{{range $svc := .Services}}
+// {{.Interface}} is a fake goVPSie.{{.Interface}}. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type {{.Interface}} struct {
+	recorder
+{{range .Methods}}
+	{{.Name}}Func func{{.Signature}}
+{{- end}}
+}
+
+var _ goVPSie.{{.Interface}} = &{{.Interface}}{}
+{{range .Methods}}
+func (f *{{$svc.Interface}}) {{.Name}}{{.Signature}} {
+	f.record("{{.Name}}"{{args .}})
+	if f.{{.Name}}Func == nil {
+{{- if .Results}}
+		return {{unstubbed $svc .}}
+{{- else}}
+		return
+{{- end}}
+	}
+	{{if .Results}}return {{end}}f.{{.Name}}Func({{.Args}})
+}
+{{end}}
+{{- end}}`))
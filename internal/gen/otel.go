@@ -0,0 +1,184 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+// spanAttributes returns the attribute expressions recorded for m's
+// parameters that identify a resource. Their keys come from resources, so
+// that a resource is recorded under the same key whatever the parameter is
+// called: vpsie.server.identifier, vpsie.project.id and so on.
+func spanAttributes(svc service, m method) (string, error) {
+	var attrs, keys []string
+	for _, p := range m.Params[1:] {
+		if p.Variadic || !identifies(p.Name) {
+			continue
+		}
+
+		var fn, suffix string
+		switch p.Type {
+		case "string":
+			fn, suffix = "String", ".identifier"
+		case "int":
+			fn, suffix = "Int", ".id"
+		case "int64":
+			fn, suffix = "Int64", ".id"
+		case "[]string":
+			fn, suffix = "StringSlice", ".identifiers"
+		default:
+			continue
+		}
+
+		key := cmp.Or(
+			resources[svc.Interface+"."+m.Name+"."+p.Name],
+			resources[svc.Interface+"."+p.Name],
+			resources[p.Name],
+		)
+		if key == "" {
+			return "", fmt.Errorf("%s.%s: no attribute key for parameter %s", svc.Interface, m.Name, p.Name)
+		}
+		if !strings.Contains(key, ".") {
+			key += suffix
+		}
+		if slices.Contains(keys, key) {
+			return "", fmt.Errorf("%s.%s: attribute key %s used twice", svc.Interface, m.Name, key)
+		}
+		keys = append(keys, key)
+		attrs = append(attrs, fmt.Sprintf("attribute.%s(%q, %s)", fn, "vpsie."+key, p.Name))
+	}
+
+	if len(attrs) == 0 {
+		return "", nil
+	}
+	return ", " + strings.Join(attrs, ", "), nil
+}
+
+// resources names the resource each identifying parameter refers to. Entries
+// keyed by Interface.Method.param take precedence over Interface.param, which
+// take precedence over the parameter name alone. A value holding a dot is the
+// whole attribute key rather than a resource name.
+var resources = map[string]string{
+	"vmIdentifier":          "server",
+	"vmIdentifer":           "server",
+	"vmId":                  "server",
+	"vmsIdentifiers":        "server",
+	"identifierId":          "server",
+	"projectIdentifier":     "project",
+	"projectId":             "project",
+	"dcIdentifier":          "datacenter",
+	"domainIdentifier":      "domain",
+	"storageIdentifier":     "storage",
+	"snapshotIdentifier":    "snapshot",
+	"snapshotIdentifiers":   "snapshot",
+	"backupIdentifier":      "backup",
+	"buckupIdentifier":      "backup",
+	"sshKeyIdentifier":      "ssh_key",
+	"scriptIdentifier":      "script",
+	"scriptId":              "script",
+	"imageIdentifier":       "image",
+	"fwGroupId":             "firewall_group",
+	"bucketId":              "bucket",
+	"accessTokenIdentifier": "access_token",
+	"couponIdentifier":      "coupon",
+	"ruleIdentifier":        "monitoring_rule",
+	"processId":             "process",
+	"lbID":                  "load_balancer",
+	"ruleID":                "lb_rule",
+	"lbBackendID":           "lb_backend",
+	"privateIpId":           "private_ip",
+	"ipId":                  "gateway_ip",
+	"vpcId":                 "vpc",
+
+	// Generic names stand for the resource of their service.
+	"BackupsService.identifier":  "backup",
+	"BackupsService.identifer":   "backup",
+	"BucketService.id":           "bucket",
+	"FipService.id":              "floating_ip",
+	"GatewayService.id":          "gateway",
+	"K8sService.identifier":      "k8s",
+	"ProjectsService.id":         "project",
+	"ProjectsService.identifer":  "project",
+	"ServerService.identifiers":  "server",
+	"SnapshotService.identifier": "snapshot",
+	"StorageService.identifier":  "storage",
+	"VPCService.id":              "vpc",
+
+	// Names shared by different resources across services.
+	"FirewallGroupService.groupId": "firewall_group",
+	"FirewallService.groupId":      "firewall_group",
+	"K8sService.groupId":           "k8s_group",
+	"ProfilesService.groupId":      "permission_group",
+	"LBsService.domainId":          "lb_domain",
+	"LBsService.domainID":          "lb_domain",
+	"LBsService.newDomainId":       "lb_domain.new_identifier",
+
+	// Policies are addressed by both an ID and an identifier.
+	"BackupsService.policyId":                         "backup_policy.id",
+	"BackupsService.GetBackupPolicy.identifier":       "backup_policy",
+	"BackupsService.DeleteBackupPolicy.identifier":    "backup_policy",
+	"SnapshotService.policyId":                        "snapshot_policy.id",
+	"SnapshotService.GetSnapShotPolicy.identifier":    "snapshot_policy",
+	"SnapshotService.DeleteSnapShotPolicy.identifier": "snapshot_policy",
+
+	// Parameters named after another resource than the one they hold.
+	"ProjectsService.MoveVms.projectIdentifier":     "server",
+	"ProjectsService.AssignToVms.projectIdentifier": "server",
+}
+
+func identifies(name string) bool {
+	lower := strings.ToLower(name)
+	return strings.Contains(lower, "identifier") || strings.Contains(lower, "identifer") ||
+		strings.HasSuffix(name, "Id") || strings.HasSuffix(name, "ID") || name == "id"
+}
+
+var otelTemplate = template.Must(template.New("otel").Funcs(funcs).Funcs(template.FuncMap{
+	"attrs": spanAttributes,
+}).Parse(`// Code generated by internal/gen; DO NOT EDIT.
+
+package govpsieotel
+
+import (
+{{- range imports .Services}}
+	"{{.}}"
+{{- end}}
+
+	goVPSie "{{.Package}}"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// wrapServices replaces every service of c with a traced wrapper.
+func wrapServices(c *goVPSie.Client, inst *instrumentation) {
+{{- range .Services}}
+	c.{{.Field}} = &traced{{.Interface}}{next: c.{{.Field}}, inst: inst}
+{{- end}}
+}
+{{range $svc := .Services}}
+type traced{{.Interface}} struct {
+	next goVPSie.{{.Interface}}
+	inst *instrumentation
+}
+{{range .Methods}}
+func (w *traced{{$svc.Interface}}) {{.Name}}{{.Signature}} {
+{{- if .Iter}}
+	return seq(ctx, w.inst, "{{$svc.Interface}}.{{.Name}}", func(ctx context.Context) {{index .Results 0}} {
+		return w.next.{{.Name}}({{.Args}})
+	}{{attrs $svc .}})
+{{- else}}
+	ctx, span := w.inst.start(ctx, "{{$svc.Interface}}.{{.Name}}"{{attrs $svc .}})
+{{- if .Results}}
+	{{.Vars}} := w.next.{{.Name}}({{.Args}})
+{{- else}}
+	w.next.{{.Name}}({{.Args}})
+{{- end}}
+	w.inst.end(span, {{if .ReturnsError}}err{{else}}nil{{end}})
+{{- if .Results}}
+	return {{.Vars}}
+{{- end}}
+{{- end}}
+}
+{{end}}
+{{- end}}`))
@@ -3,6 +3,7 @@ package goVPSie
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -10,8 +11,11 @@ var ipsPath = "/apps/v2/ips"
 
 type IPsService interface {
 	ListPrivateIPs(ctx context.Context, options *ListOptions) ([]IP, error)
+	PrivateIPsAll(ctx context.Context, opts ...IterOption) iter.Seq2[IP, error]
 	ListPublicIPs(ctx context.Context, options *ListOptions) ([]IP, error)
+	PublicIPsAll(ctx context.Context, opts ...IterOption) iter.Seq2[IP, error]
 	ListAllIPs(ctx context.Context, options *ListOptions) ([]IP, error)
+	All(ctx context.Context, opts ...IterOption) iter.Seq2[IP, error]
 	DeleteIP(ctx context.Context, ip, vmIdentifier string) error
 	CreateIps(ctx context.Context, ipType, vmIdentifier string) error
 }
@@ -29,27 +33,30 @@ type ListIPsRoot struct {
 }
 
 type IP struct {
-	ID            int    `json:"id"`
-	DcName        string `json:"dcName"`
-	DcIdentifier  string `json:"dcIdentifier"`
-	RangeID       int    `json:"range_id"`
-	IP            string `json:"ip"`
-	IPVersion     string `json:"ip_version"`
-	IsPrimary     int    `json:"is_primary"`
-	Hostname      string `json:"hostname"`
-	BoxID         int    `json:"box_id"`
-	BoxIdentifier string `json:"box_identifier"`
-	FullName      string `json:"fullName"`
-	Category      string `json:"category"`
-	UserID        int    `json:"user_id"`
-	OwnerID       int    `json:"owner_id"`
-	CreatedBy     string `json:"created_by"`
-	Type          string `json:"type"`
-	UpdatedAt     string `json:"updated_at"`
+	ID            int       `json:"id"`
+	DcName        string    `json:"dcName"`
+	DcIdentifier  string    `json:"dcIdentifier"`
+	RangeID       int       `json:"range_id"`
+	IP            string    `json:"ip"`
+	IPVersion     string    `json:"ip_version"`
+	IsPrimary     Flag      `json:"is_primary"`
+	Hostname      string    `json:"hostname"`
+	BoxID         int       `json:"box_id"`
+	BoxIdentifier string    `json:"box_identifier"`
+	FullName      string    `json:"fullName"`
+	Category      string    `json:"category"`
+	UserID        int       `json:"user_id"`
+	OwnerID       int       `json:"owner_id"`
+	CreatedBy     string    `json:"created_by"`
+	Type          string    `json:"type"`
+	UpdatedAt     Timestamp `json:"updated_at"`
 }
 
 func (i *iPsServiceHandler) ListPrivateIPs(ctx context.Context, options *ListOptions) ([]IP, error) {
-	path := fmt.Sprintf("%s/private", ipsPath)
+	path, err := addOptions(fmt.Sprintf("%s/private", ipsPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := i.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -61,11 +68,20 @@ func (i *iPsServiceHandler) ListPrivateIPs(ctx context.Context, options *ListOpt
 		return nil, err
 	}
 
+	options.setMeta(ips.Total, len(ips.Data))
 	return ips.Data, nil
 }
 
+// PrivateIPsAll iterates over every private IP, fetching pages lazily.
+func (i *iPsServiceHandler) PrivateIPsAll(ctx context.Context, opts ...IterOption) iter.Seq2[IP, error] {
+	return paginate(ctx, i.ListPrivateIPs, opts)
+}
+
 func (i *iPsServiceHandler) ListPublicIPs(ctx context.Context, options *ListOptions) ([]IP, error) {
-	path := fmt.Sprintf("%s/public?offset=%d&limit%d", ipsPath, options.Page, options.PerPage)
+	path, err := addOptions(fmt.Sprintf("%s/public", ipsPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := i.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -75,11 +91,20 @@ func (i *iPsServiceHandler) ListPublicIPs(ctx context.Context, options *ListOpti
 	if err = i.client.Do(ctx, req, ips); err != nil {
 		return nil, err
 	}
+	options.setMeta(ips.Total, len(ips.Data))
 	return ips.Data, nil
 }
 
+// PublicIPsAll iterates over every public IP, fetching pages lazily.
+func (i *iPsServiceHandler) PublicIPsAll(ctx context.Context, opts ...IterOption) iter.Seq2[IP, error] {
+	return paginate(ctx, i.ListPublicIPs, opts)
+}
+
 func (i *iPsServiceHandler) ListAllIPs(ctx context.Context, options *ListOptions) ([]IP, error) {
-	path := fmt.Sprintf("%s?offset=%d&limit%d", ipsPath, options.Page, options.PerPage)
+	path, err := addOptions(ipsPath, options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := i.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -90,9 +115,15 @@ func (i *iPsServiceHandler) ListAllIPs(ctx context.Context, options *ListOptions
 		return nil, err
 
 	}
+	options.setMeta(ips.Total, len(ips.Data))
 	return ips.Data, nil
 }
 
+// All iterates over every IP, fetching pages lazily.
+func (i *iPsServiceHandler) All(ctx context.Context, opts ...IterOption) iter.Seq2[IP, error] {
+	return paginate(ctx, i.ListAllIPs, opts)
+}
+
 func (i *iPsServiceHandler) DeleteIP(ctx context.Context, ip, vmIdentifier string) error {
 	path := fmt.Sprintf("%s/delete", ipsPath)
 
@@ -0,0 +1,101 @@
+package goVPSie
+
+import (
+	"context"
+	"iter"
+)
+
+const defaultIterPageSize = 50
+
+// IterOption configures the iterators returned by the All methods.
+type IterOption func(*iterConfig)
+
+type iterConfig struct {
+	perPage  int
+	prefetch bool
+}
+
+// WithPageSize sets how many items each page request asks for.
+func WithPageSize(perPage int) IterOption {
+	return func(c *iterConfig) {
+		if perPage > 0 {
+			c.perPage = perPage
+		}
+	}
+}
+
+// WithPrefetch fetches the next page in the background while the current
+// one is being consumed.
+func WithPrefetch() IterOption {
+	return func(c *iterConfig) {
+		c.prefetch = true
+	}
+}
+
+type page[T any] struct {
+	items []T
+	meta  *Meta
+	err   error
+}
+
+// paginate turns a List method into an iterator over every item of every
+// page. Pages are fetched lazily; breaking out of the loop stops paging and
+// cancels a pending prefetch. A failed page is yielded once as an error and
+// ends the iteration.
+func paginate[T any](ctx context.Context, list func(context.Context, *ListOptions) ([]T, error), opts []IterOption) iter.Seq2[T, error] {
+	cfg := iterConfig{perPage: defaultIterPageSize}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return func(yield func(T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		fetch := func(pageNum int) <-chan page[T] {
+			ch := make(chan page[T], 1)
+			load := func() {
+				options := &ListOptions{Page: pageNum, PerPage: cfg.perPage}
+				items, err := list(ctx, options)
+				ch <- page[T]{items: items, meta: options.Meta, err: err}
+			}
+
+			if cfg.prefetch {
+				go load()
+			} else {
+				load()
+			}
+
+			return ch
+		}
+
+		next := fetch(0)
+		for pageNum := 0; ; pageNum++ {
+			current := <-next
+			if current.err != nil {
+				var zero T
+				yield(zero, current.err)
+				return
+			}
+
+			hasNext := current.meta != nil && current.meta.HasNext && len(current.items) > 0
+			if hasNext && cfg.prefetch {
+				next = fetch(pageNum + 1)
+			}
+
+			for _, item := range current.items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if !hasNext {
+				return
+			}
+
+			if !cfg.prefetch {
+				next = fetch(pageNum + 1)
+			}
+		}
+	}
+}
@@ -3,6 +3,7 @@ package goVPSie
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"time"
 )
@@ -11,6 +12,7 @@ var k8sPath = "/apps/v2/k8s"
 
 type K8sService interface {
 	List(ctx context.Context, options *ListOptions) ([]ListK8s, error)
+	All(ctx context.Context, opts ...IterOption) iter.Seq2[ListK8s, error]
 	Delete(ctx context.Context, identifier, reason, note string) error
 	Create(ctx context.Context, createReq *CreateK8sReq) error
 	Get(ctx context.Context, identifier string) (*K8s, error)
@@ -42,48 +44,48 @@ type GetK8sRoot struct {
 }
 
 type K8s struct {
-	ClusterName string  `json:"cluster_name"`
-	Identifier  string  `json:"identifier"`
-	Count       int     `json:"count"`
-	Nodes       []Node  `json:"nodes"`
-	CreatedOn   string  `json:"created_on"`
-	UpdatedOn   string  `json:"updated_on"`
-	CreatedBy   string  `json:"created_by"`
-	NickName    string  `json:"nickname"`
-	Cpu         int     `json:"cpu"`
-	Ram         int     `json:"ram"`
-	Traffic     int     `json:"traffic"`
-	Color       string  `json:"color"`
-	Price       float64 `json:"price"`
+	ClusterName string    `json:"cluster_name"`
+	Identifier  string    `json:"identifier"`
+	Count       int       `json:"count"`
+	Nodes       []Node    `json:"nodes"`
+	CreatedOn   Timestamp `json:"created_on"`
+	UpdatedOn   Timestamp `json:"updated_on"`
+	CreatedBy   string    `json:"created_by"`
+	NickName    string    `json:"nickname"`
+	Cpu         int       `json:"cpu"`
+	Ram         int       `json:"ram"`
+	Traffic     int       `json:"traffic"`
+	Color       string    `json:"color"`
+	Price       Decimal   `json:"price"`
 }
 
 type ListK8s struct {
-	ClusterName  string  `json:"cluster_name"`
-	Identifier   string  `json:"identifier"`
-	Count        int     `json:"count"`
-	CreatedOn    string  `json:"created_on"`
-	UpdatedOn    string  `json:"updated_on"`
-	CreatedBy    string  `json:"created_by"`
-	NickName     string  `json:"nickname"`
-	Cpu          int     `json:"cpu"`
-	Ram          int     `json:"ram"`
-	Traffic      int     `json:"traffic"`
-	Color        string  `json:"color"`
-	Price        float64 `json:"price"`
-	ManagerCount int     `json:"managerCount"`
-	SlaveCount   int     `json:"slaveCount"`
+	ClusterName  string    `json:"cluster_name"`
+	Identifier   string    `json:"identifier"`
+	Count        int       `json:"count"`
+	CreatedOn    Timestamp `json:"created_on"`
+	UpdatedOn    Timestamp `json:"updated_on"`
+	CreatedBy    string    `json:"created_by"`
+	NickName     string    `json:"nickname"`
+	Cpu          int       `json:"cpu"`
+	Ram          int       `json:"ram"`
+	Traffic      int       `json:"traffic"`
+	Color        string    `json:"color"`
+	Price        Decimal   `json:"price"`
+	ManagerCount int       `json:"managerCount"`
+	SlaveCount   int       `json:"slaveCount"`
 }
 
 type Node struct {
-	Id           int    `json:"id"`
-	UserId       int    `json:"user_id"`
-	HostName     string `json:"hostname"`
-	DefaultIP    string `json:"default_ip"`
-	PrivateIP    string `json:"private_ip"`
-	NodeType     int    `json:"node_type"`
-	NodeId       int    `json:"node_id"`
-	DatacenterId int    `json:"datacenter_id"`
-	CreatedOn    string `json:"created_on"`
+	Id           int       `json:"id"`
+	UserId       int       `json:"user_id"`
+	HostName     string    `json:"hostname"`
+	DefaultIP    string    `json:"default_ip"`
+	PrivateIP    string    `json:"private_ip"`
+	NodeType     int       `json:"node_type"`
+	NodeId       int       `json:"node_id"`
+	DatacenterId int       `json:"datacenter_id"`
+	CreatedOn    Timestamp `json:"created_on"`
 }
 
 type CreateK8sReq struct {
@@ -97,6 +99,18 @@ type CreateK8sReq struct {
 	ProjectIdentifier  string `json:"projectIdentifier"`
 }
 
+// Validate reports the invalid fields of r.
+func (r CreateK8sReq) Validate() error {
+	var errs fieldErrors
+	errs.check(isLabel(r.ClusterName), "clusterName", "must be a valid DNS label, got %q", r.ClusterName)
+	errs.required("dcIdentifier", r.DcIdentifier)
+	errs.required("resourceIdentifier", r.ResourceIdentifier)
+	errs.required("projectIdentifier", r.ProjectIdentifier)
+	errs.check(r.NodesCountMaster >= 1, "nodesCountMaster", "must be at least 1, got %d", r.NodesCountMaster)
+	errs.check(r.NodesCountSlave >= 0, "nodesCountSlave", "must not be negative, got %d", r.NodesCountSlave)
+	return errs.err()
+}
+
 type K8sGroup struct {
 	ID           int64     `json:"id"`
 	GroupName    string    `json:"group_name"`
@@ -108,11 +122,11 @@ type K8sGroup struct {
 	Ssd          int64     `json:"ssd"`
 	Traffic      int64     `json:"traffic"`
 	Notes        string    `json:"notes,omitempty"`
-	CreatedOn    time.Time `json:"created_on"`
-	LastUpdated  time.Time `json:"last_updated"`
-	DroppedOn    time.Time `json:"dropped_on,omitempty"`
-	IsActive     int64     `json:"is_active"`
-	IsDeleted    int64     `json:"is_deleted"`
+	CreatedOn    Timestamp `json:"created_on"`
+	LastUpdated  Timestamp `json:"last_updated"`
+	DroppedOn    Timestamp `json:"dropped_on,omitempty"`
+	IsActive     Flag      `json:"is_active"`
+	IsDeleted    Flag      `json:"is_deleted"`
 	Identifier   string    `json:"identifier"`
 	ProjectID    string    `json:"project_id"`
 	ClusterID    int64     `json:"cluster_id"`
@@ -131,8 +145,20 @@ type CreateK8sGroupReq struct {
 	KubeSizeID        int    `json:"KubeSizeID"`
 }
 
+// Validate reports the invalid fields of r.
+func (r CreateK8sGroupReq) Validate() error {
+	var errs fieldErrors
+	errs.required("clusterIdentifier", r.ClusterIdentifier)
+	errs.check(isLabel(r.GroupName), "groupName", "must be a valid DNS label, got %q", r.GroupName)
+	errs.check(r.KubeSizeID > 0, "KubeSizeID", "is required")
+	return errs.err()
+}
+
 func (s *k8sServiceHandler) List(ctx context.Context, options *ListOptions) ([]ListK8s, error) {
-	path := fmt.Sprintf("%s/cluster/all", k8sPath)
+	path, err := addOptions(fmt.Sprintf("%s/cluster/all", k8sPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -144,9 +170,15 @@ func (s *k8sServiceHandler) List(ctx context.Context, options *ListOptions) ([]L
 		return nil, err
 	}
 
+	options.setMeta(0, len(root.Data))
 	return root.Data, nil
 }
 
+// All iterates over every kubernetes cluster, fetching pages lazily.
+func (s *k8sServiceHandler) All(ctx context.Context, opts ...IterOption) iter.Seq2[ListK8s, error] {
+	return paginate(ctx, s.List, opts)
+}
+
 func (s *k8sServiceHandler) Delete(ctx context.Context, identifier, reason, note string) error {
 	path := fmt.Sprintf("%s/cluster/byId/%s", k8sPath, identifier)
 
@@ -176,14 +208,31 @@ func (s *k8sServiceHandler) Delete(ctx context.Context, identifier, reason, note
 func (s *k8sServiceHandler) Create(ctx context.Context, createReq *CreateK8sReq) error {
 	path := fmt.Sprintf("%s/create/cluster", k8sPath)
 
+	createReq = withDefaultProject(s.client, createReq, func(r *CreateK8sReq) *string { return &r.ProjectIdentifier })
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, createReq)
 	if err != nil {
 		return err
 	}
+	ctx = s.client.idempotent(ctx, req, s.existingCluster(createReq))
 
 	return s.client.Do(ctx, req, nil)
 }
 
+// existingCluster finds a kubernetes cluster named like createReq.
+func (s *k8sServiceHandler) existingCluster(createReq *CreateK8sReq) existingFunc {
+	return func(ctx context.Context, since time.Time) (string, error) {
+		for cluster, err := range s.All(ctx) {
+			if err != nil {
+				return "", err
+			}
+			if cluster.ClusterName == createReq.ClusterName && createdSince(cluster.CreatedOn, since) {
+				return cluster.Identifier, nil
+			}
+		}
+		return "", nil
+	}
+}
+
 func (s *k8sServiceHandler) Get(ctx context.Context, identifier string) (*K8s, error) {
 	path := fmt.Sprintf("%s/cluster/byId/%s", k8sPath, identifier)
 
@@ -3,8 +3,10 @@ package goVPSie
 import (
 	"context"
 	"fmt"
+	"iter"
 	"log"
 	"net/http"
+	"net/netip"
 	"time"
 )
 
@@ -12,7 +14,9 @@ var lbPath = "/api/v1/lb"
 
 type LBsService interface {
 	ListLBs(ctx context.Context, options *ListOptions) ([]LB, error)
+	LBsAll(ctx context.Context, opts ...IterOption) iter.Seq2[LB, error]
 	ListLBDataCenters(ctx context.Context, options *ListOptions) ([]LBDataCenter, error)
+	LBDataCentersAll(ctx context.Context, opts ...IterOption) iter.Seq2[LBDataCenter, error]
 	ListOffers(ctx context.Context, dcIdentifier string) ([]LBOffers, error)
 	GetLB(ctx context.Context, lbID string) (*LBDetails, error)
 	CreateLB(ctx context.Context, createLBReq *CreateLBReq) error
@@ -69,7 +73,7 @@ type LBRuleDetail struct {
 	Scheme    string             `json:"scheme"`
 	FrontPort int                `json:"frontPort"`
 	BackPort  int                `json:"backPort"`
-	CreatedOn time.Time          `json:"created_on"`
+	CreatedOn Timestamp          `json:"created_on"`
 	RuleID    string             `json:"ruleId"`
 	Domains   []LBDomainsDetail  `json:"domains,omitempty"`
 	Backends  []LBBackendsDetail `json:"backends,omitempty"`
@@ -79,7 +83,7 @@ type LBBackendsDetail struct {
 	IP           string    `json:"ip"`
 	Identifier   string    `json:"identifier"`
 	VMIdentifier string    `json:"vmIdentifier,omitempty"`
-	CreatedOn    time.Time `json:"created_on"`
+	CreatedOn    Timestamp `json:"created_on"`
 }
 
 type LBDomainsDetail struct {
@@ -91,7 +95,7 @@ type LBDomainsDetail struct {
 	HealthCheckPath string             `json:"healthCheckPath"`
 	CookieCheck     int                `json:"cookieCheck"`
 	CookieName      string             `json:"cookieName"`
-	CreatedOn       time.Time          `json:"created_on"`
+	CreatedOn       Timestamp          `json:"created_on"`
 	BackPort        int                `json:"backPort"`
 	DomainID        string             `json:"domainId"`
 	CheckInterval   int                `json:"checkInterval"`
@@ -102,20 +106,20 @@ type LBDomainsDetail struct {
 }
 
 type LB struct {
-	Cpu        int    `json:"cpu"`
-	Ssd        int    `json:"ssd"`
-	Ram        int    `json:"ram"`
-	LBName     string `json:"lbName"`
-	Traffic    int    `json:"traffic"`
-	BoxsizeID  int    `json:"boxsize_id"`
-	DefaultIP  string `json:"default_ip"`
-	DCName     string `json:"dc_name"`
-	Identifier string `json:"identifier"`
-	CreatedOn  string `json:"created_on"`
-	UpdatedAt  string `json:"updated_at"`
-	Package    string `json:"package"`
-	CreatedBy  string `json:"created_by"`
-	UserID     int    `json:"user_id"`
+	Cpu        int       `json:"cpu"`
+	Ssd        int       `json:"ssd"`
+	Ram        int       `json:"ram"`
+	LBName     string    `json:"lbName"`
+	Traffic    int       `json:"traffic"`
+	BoxsizeID  int       `json:"boxsize_id"`
+	DefaultIP  string    `json:"default_ip"`
+	DCName     string    `json:"dc_name"`
+	Identifier string    `json:"identifier"`
+	CreatedOn  Timestamp `json:"created_on"`
+	UpdatedAt  Timestamp `json:"updated_at"`
+	Package    string    `json:"package"`
+	CreatedBy  string    `json:"created_by"`
+	UserID     int       `json:"user_id"`
 }
 
 type CreateLBReq struct {
@@ -135,6 +139,36 @@ type CreateLBReq struct {
 	VpcID              int    `json:"vpcId,omitempty"`
 }
 
+// Validate reports the invalid fields of r and its rules. A load balancer
+// needs at least one rule.
+func (r CreateLBReq) Validate() error {
+	var errs fieldErrors
+	errs.required("lbName", r.LBName)
+	errs.required("algorithm", r.Algorithm)
+	errs.required("resourceIdentifier", r.ResourceIdentifier)
+	errs.required("dcIdentifier", r.DcIdentifier)
+	errs.flag("redirectHTTP", int64(r.RedirectHTTP))
+	validateHealthCheck(&errs, r.CheckInterval, r.FastInterval, r.Rise, r.Fall)
+
+	errs.check(len(r.Rule) > 0, "rules", "at least one rule is required")
+	for i, rule := range r.Rule {
+		errs.nested(fmt.Sprintf("rules[%d]", i), rule.Validate())
+	}
+	return errs.err()
+}
+
+// validateHealthCheck checks the health check settings of a load balancer or
+// domain, which default on the API side when zero.
+func validateHealthCheck(errs *fieldErrors, checkInterval, fastInterval, rise, fall int) {
+	errs.check(checkInterval >= 0, "checkInterval", "must not be negative, got %d", checkInterval)
+	errs.check(fastInterval >= 0, "fastInterval", "must not be negative, got %d", fastInterval)
+	errs.check(rise >= 0, "rise", "must not be negative, got %d", rise)
+	errs.check(fall >= 0, "fall", "must not be negative, got %d", fall)
+}
+
+// lbSchemes lists the protocols load balancer rules forward.
+var lbSchemes = []string{"http", "https", "tcp"}
+
 type AddRuleReq struct {
 	Scheme    string     `json:"scheme"`
 	FrontPort string     `json:"frontPort"`
@@ -142,6 +176,20 @@ type AddRuleReq struct {
 	LbId      string     `json:"lbId"`
 	Domains   []LBDomain `json:"domains"`
 }
+
+// Validate reports the invalid fields of r and its domains.
+func (r AddRuleReq) Validate() error {
+	var errs fieldErrors
+	errs.required("lbId", r.LbId)
+	errs.check(isOneOf(r.Scheme, lbSchemes...), "scheme", "must be http, https or tcp, got %q", r.Scheme)
+	errs.check(isPortString(r.FrontPort), "frontPort", "must be a port between 1 and 65535, got %q", r.FrontPort)
+	errs.check(isPortString(r.BackPort), "backPort", "must be a port between 1 and 65535, got %q", r.BackPort)
+	for i, domain := range r.Domains {
+		errs.nested(fmt.Sprintf("domains[%d]", i), domain.Validate())
+	}
+	return errs.err()
+}
+
 type Rule struct {
 	Scheme     string     `json:"scheme"`
 	FrontPort  string     `json:"frontPort"`
@@ -150,6 +198,27 @@ type Rule struct {
 	BackPort   string     `json:"backPort"`
 	DomainName string     `json:"domainName"`
 }
+
+// Validate reports the invalid fields of r. A rule either forwards to
+// backends itself or routes by domain.
+func (r Rule) Validate() error {
+	var errs fieldErrors
+	errs.check(isOneOf(r.Scheme, lbSchemes...), "scheme", "must be http, https or tcp, got %q", r.Scheme)
+	errs.check(isPortString(r.FrontPort), "frontPort", "must be a port between 1 and 65535, got %q", r.FrontPort)
+
+	if len(r.Domains) == 0 {
+		errs.check(isPortString(r.BackPort), "backPort", "must be a port between 1 and 65535, got %q", r.BackPort)
+		errs.check(len(r.Backends) > 0, "backends", "at least one backend is required without domains")
+	}
+	for i, domain := range r.Domains {
+		errs.nested(fmt.Sprintf("domains[%d]", i), domain.Validate())
+	}
+	for i, backend := range r.Backends {
+		errs.nested(fmt.Sprintf("backends[%d]", i), backend.Validate())
+	}
+	return errs.err()
+}
+
 type LBDomain struct {
 	DomainID      string    `json:"domainId"`
 	Backends      []Backend `json:"backends"`
@@ -157,20 +226,47 @@ type LBDomain struct {
 	BackendScheme string    `json:"backendScheme"`
 	DomainName    string    `json:"domainName"`
 }
+
+// Validate reports the invalid fields of d and its backends.
+func (d LBDomain) Validate() error {
+	var errs fieldErrors
+	errs.check(isDomainName(d.DomainName), "domainName", "must be a valid domain name, got %q", d.DomainName)
+	errs.check(isPortString(d.BackPort), "backPort", "must be a port between 1 and 65535, got %q", d.BackPort)
+	if d.BackendScheme != "" {
+		errs.check(isOneOf(d.BackendScheme, lbSchemes...), "backendScheme", "must be http, https or tcp, got %q", d.BackendScheme)
+	}
+	errs.check(len(d.Backends) > 0, "backends", "at least one backend is required")
+	for i, backend := range d.Backends {
+		errs.nested(fmt.Sprintf("backends[%d]", i), backend.Validate())
+	}
+	return errs.err()
+}
+
 type Backend struct {
 	Ip           string `json:"ip"`
 	VmIdentifier string `json:"vmIdentifier"`
 	Type         string `json:"type,omitempty"`
 }
 
+// Validate reports whether b names a server or a valid IP address.
+func (b Backend) Validate() error {
+	var errs fieldErrors
+	if b.Ip != "" {
+		_, err := netip.ParseAddr(b.Ip)
+		errs.check(err == nil, "ip", "must be an IP address, got %q", b.Ip)
+	}
+	errs.check(b.Ip != "" || b.VmIdentifier != "", "vmIdentifier", "is required without ip")
+	return errs.err()
+}
+
 type LBDataCenter struct {
 	DcName     string `json:"dc_name"`
 	DcImage    string `json:"dc_image"`
 	State      string `json:"state"`
 	Country    string `json:"country"`
 	Identifier string `json:"identifier"`
-	IsActive   int    `json:"is_active"`
-	IsDeleted  int    `json:"is_deleted"`
+	IsActive   Flag   `json:"is_active"`
+	IsDeleted  Flag   `json:"is_deleted"`
 }
 
 type RuleUpdateReq struct {
@@ -181,18 +277,31 @@ type RuleUpdateReq struct {
 	FrontPort int       `json:"frontPort"`
 }
 
+// Validate reports the invalid fields of r and its backends.
+func (r RuleUpdateReq) Validate() error {
+	var errs fieldErrors
+	errs.required("ruleId", r.RuleID)
+	errs.check(isOneOf(r.Scheme, lbSchemes...), "scheme", "must be http, https or tcp, got %q", r.Scheme)
+	errs.check(isPort(r.FrontPort), "frontPort", "must be a port between 1 and 65535, got %d", r.FrontPort)
+	errs.check(isPort(r.BackPort), "backPort", "must be a port between 1 and 65535, got %d", r.BackPort)
+	for i, backend := range r.Backends {
+		errs.nested(fmt.Sprintf("backends[%d]", i), backend.Validate())
+	}
+	return errs.err()
+}
+
 type LBOffers struct {
-	Cpu         int    `json:"cpu"`
-	Ram         int    `json:"ram"`
-	Ssd         int    `json:"ssd"`
-	Traffic     int    `json:"traffic"`
-	Price       string `json:"price"`
-	NickName    string `json:"nickname"`
-	Identifier  string `json:"identifier"`
-	Color       string `json:"color"`
-	NetSpeed    int    `json:"net_speed"`
-	Category    string `json:"category"`
-	Description string `json:"description"`
+	Cpu         int     `json:"cpu"`
+	Ram         int     `json:"ram"`
+	Ssd         int     `json:"ssd"`
+	Traffic     int     `json:"traffic"`
+	Price       Decimal `json:"price"`
+	NickName    string  `json:"nickname"`
+	Identifier  string  `json:"identifier"`
+	Color       string  `json:"color"`
+	NetSpeed    int     `json:"net_speed"`
+	Category    string  `json:"category"`
+	Description string  `json:"description"`
 }
 
 type DomainAddReq struct {
@@ -207,6 +316,20 @@ type DomainAddReq struct {
 	Backends     []Backend `json:"backends"`
 }
 
+// Validate reports the invalid fields of r and its backends.
+func (r DomainAddReq) Validate() error {
+	var errs fieldErrors
+	errs.required("ruleId", r.RuleID)
+	errs.check(isDomainName(r.DomainName), "domainName", "must be a valid domain name, got %q", r.DomainName)
+	errs.check(isPort(r.BackPort), "backPort", "must be a port between 1 and 65535, got %d", r.BackPort)
+	errs.flag("redirectHTTP", int64(r.RedirectHTTP))
+	errs.check(len(r.Backends) > 0, "backends", "at least one backend is required")
+	for i, backend := range r.Backends {
+		errs.nested(fmt.Sprintf("backends[%d]", i), backend.Validate())
+	}
+	return errs.err()
+}
+
 type DomainUpdateReq struct {
 	DomainID      string `json:"domainId"`
 	Subdomain     string `json:"subdomain"`
@@ -221,6 +344,16 @@ type DomainUpdateReq struct {
 	Fall          int    `json:"fall"`
 }
 
+// Validate reports the invalid fields of r.
+func (r DomainUpdateReq) Validate() error {
+	var errs fieldErrors
+	errs.required("domainId", r.DomainID)
+	errs.check(isPort(r.BackPort), "backPort", "must be a port between 1 and 65535, got %d", r.BackPort)
+	errs.flag("redirectHTTP", int64(r.RedirectHTTP))
+	validateHealthCheck(&errs, r.CheckInterval, r.FastInterval, r.Rise, r.Fall)
+	return errs.err()
+}
+
 type ListOffersRoot struct {
 	Error bool       `json:"error"`
 	Data  []LBOffers `json:"Data"`
@@ -258,7 +391,10 @@ type PendingLBRoot struct {
 }
 
 func (l *lbsServiceHandler) ListLBs(ctx context.Context, options *ListOptions) ([]LB, error) {
-	path := fmt.Sprintf("%s/all?sortField=created_on&sortDirection=DESC", lbPath)
+	path, err := addOptions(fmt.Sprintf("%s/all?sortField=created_on&sortDirection=DESC", lbPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := l.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -270,9 +406,15 @@ func (l *lbsServiceHandler) ListLBs(ctx context.Context, options *ListOptions) (
 		return nil, err
 	}
 
+	options.setMeta(listLbsRoot.Total, len(listLbsRoot.Data))
 	return listLbsRoot.Data, nil
 }
 
+// LBsAll iterates over every load balancer, fetching pages lazily.
+func (l *lbsServiceHandler) LBsAll(ctx context.Context, opts ...IterOption) iter.Seq2[LB, error] {
+	return paginate(ctx, l.ListLBs, opts)
+}
+
 func (l *lbsServiceHandler) GetLB(ctx context.Context, lbID string) (*LBDetails, error) {
 	path := fmt.Sprintf("%s/%s", lbPath, lbID)
 
@@ -290,7 +432,10 @@ func (l *lbsServiceHandler) GetLB(ctx context.Context, lbID string) (*LBDetails,
 }
 
 func (l *lbsServiceHandler) ListLBDataCenters(ctx context.Context, options *ListOptions) ([]LBDataCenter, error) {
-	path := fmt.Sprintf("%s/datacenter", lbPath)
+	path, err := addOptions(fmt.Sprintf("%s/datacenter", lbPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := l.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -302,9 +447,15 @@ func (l *lbsServiceHandler) ListLBDataCenters(ctx context.Context, options *List
 		return nil, err
 	}
 
+	options.setMeta(lbDataCenters.Total, len(lbDataCenters.Data))
 	return lbDataCenters.Data, nil
 }
 
+// LBDataCentersAll iterates over every load balancer data center, fetching pages lazily.
+func (l *lbsServiceHandler) LBDataCentersAll(ctx context.Context, opts ...IterOption) iter.Seq2[LBDataCenter, error] {
+	return paginate(ctx, l.ListLBDataCenters, opts)
+}
+
 func (l *lbsServiceHandler) CreateLB(ctx context.Context, createLBReq *CreateLBReq) error {
 	path := fmt.Sprintf("%s/create", lbPath)
 
@@ -313,10 +464,26 @@ func (l *lbsServiceHandler) CreateLB(ctx context.Context, createLBReq *CreateLBR
 	if err != nil {
 		return err
 	}
+	ctx = l.client.idempotent(ctx, req, l.existingLB(createLBReq))
 
 	return l.client.Do(ctx, req, nil)
 }
 
+// existingLB finds a load balancer named like createLBReq.
+func (l *lbsServiceHandler) existingLB(createLBReq *CreateLBReq) existingFunc {
+	return func(ctx context.Context, since time.Time) (string, error) {
+		for lb, err := range l.LBsAll(ctx) {
+			if err != nil {
+				return "", err
+			}
+			if lb.LBName == createLBReq.LBName && createdSince(lb.CreatedOn, since) {
+				return lb.Identifier, nil
+			}
+		}
+		return "", nil
+	}
+}
+
 func (l *lbsServiceHandler) DeleteLB(ctx context.Context, lbID, reason, note string) error {
 	path := fmt.Sprintf("%s/%s", lbPath, lbID)
 
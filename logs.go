@@ -3,6 +3,7 @@ package goVPSie
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -10,9 +11,13 @@ var logsPath = "/apps/v2/logs"
 
 type LogsService interface {
 	ListActivityLogs(ctx context.Context, options *ListOptions) ([]ActivityLog, error)
+	ActivityLogsAll(ctx context.Context, opts ...IterOption) iter.Seq2[ActivityLog, error]
 	ListBillingLogs(ctx context.Context, options *ListOptions) ([]BillingLog, error)
+	BillingLogsAll(ctx context.Context, opts ...IterOption) iter.Seq2[BillingLog, error]
 	ListAuditLogs(ctx context.Context, options *ListOptions) ([]AuditLog, error)
+	AuditLogsAll(ctx context.Context, opts ...IterOption) iter.Seq2[AuditLog, error]
 	ListVPSieLogs(ctx context.Context, options *ListOptions) ([]VmLog, error)
+	VPSieLogsAll(ctx context.Context, opts ...IterOption) iter.Seq2[VmLog, error]
 }
 
 type logsServiceHandler struct {
@@ -20,56 +25,56 @@ type logsServiceHandler struct {
 }
 
 type AuditLog struct {
-	ID          int    `json:"id"`
-	UserID      int    `json:"user_id"`
-	UserAgent   string `json:"user_agent"`
-	IPAddress   string `json:"ip_address"`
-	Country     string `json:"country"`
-	City        string `json:"city"`
-	Action      string `json:"action"`
-	Description string `json:"description"`
-	CreatedOn   string `json:"created_on"`
-	State       string `json:"state"`
+	ID          int       `json:"id"`
+	UserID      int       `json:"user_id"`
+	UserAgent   string    `json:"user_agent"`
+	IPAddress   string    `json:"ip_address"`
+	Country     string    `json:"country"`
+	City        string    `json:"city"`
+	Action      string    `json:"action"`
+	Description string    `json:"description"`
+	CreatedOn   Timestamp `json:"created_on"`
+	State       string    `json:"state"`
 }
 
 type VmLog struct {
-	ID          int    `json:"id"`
-	UserID      int    `json:"user_id"`
-	EntityID    int    `json:"entity_id"`
-	EntityType  string `json:"entity_type"`
-	Action      string `json:"action"`
-	Description string `json:"description"`
-	CreatedOn   string `json:"created_on"`
-	UserAgent   string `json:"user_agent"`
-	IPAddress   string `json:"ip_address"`
-	Country     string `json:"country"`
-	BoxID       int    `json:"box_id"`
+	ID          int       `json:"id"`
+	UserID      int       `json:"user_id"`
+	EntityID    int       `json:"entity_id"`
+	EntityType  string    `json:"entity_type"`
+	Action      string    `json:"action"`
+	Description string    `json:"description"`
+	CreatedOn   Timestamp `json:"created_on"`
+	UserAgent   string    `json:"user_agent"`
+	IPAddress   string    `json:"ip_address"`
+	Country     string    `json:"country"`
+	BoxID       int       `json:"box_id"`
 }
 
 type BillingLog struct {
-	ID                    int    `json:"id"`
-	UserID                int    `json:"user_id"`
-	TransactionID         string `json:"transaction_id"`
-	TransactionSerialized string `json:"transaction_serialized"`
-	Message               string `json:"message"`
-	Amount                int    `json:"amount"`
-	CreatedOn             string `json:"created_on"`
-	PpAccount             string `json:"pp_account"`
-	PpFullname            string `json:"pp_full_name"`
-	PpCountryCode         string `json:"pp_country_code"`
-	TransactionOriginIP   string `json:"transaction_origin_ip"`
-	RiskScore             string `json:"risk_score"`
-	Purchaselogscol       string `json:"purchaselogscol"`
-	MaxmindResponse       string `json:"maxmind_response"`
+	ID                    int       `json:"id"`
+	UserID                int       `json:"user_id"`
+	TransactionID         string    `json:"transaction_id"`
+	TransactionSerialized string    `json:"transaction_serialized"`
+	Message               string    `json:"message"`
+	Amount                Decimal   `json:"amount"`
+	CreatedOn             Timestamp `json:"created_on"`
+	PpAccount             string    `json:"pp_account"`
+	PpFullname            string    `json:"pp_full_name"`
+	PpCountryCode         string    `json:"pp_country_code"`
+	TransactionOriginIP   string    `json:"transaction_origin_ip"`
+	RiskScore             string    `json:"risk_score"`
+	Purchaselogscol       string    `json:"purchaselogscol"`
+	MaxmindResponse       string    `json:"maxmind_response"`
 }
 
 type ActivityLog struct {
-	UserID      int    `json:"user_id"`
-	CreatedBy   string `json:"created_by"`
-	BoxID       int    `json:"box_id"`
-	BackupID    int    `json:"backup_id"`
-	Description string `json:"description"`
-	CreatedOn   string `json:"created_on"`
+	UserID      int       `json:"user_id"`
+	CreatedBy   string    `json:"created_by"`
+	BoxID       int       `json:"box_id"`
+	BackupID    int       `json:"backup_id"`
+	Description string    `json:"description"`
+	CreatedOn   Timestamp `json:"created_on"`
 }
 
 type ListActivityLogsRoot struct {
@@ -99,7 +104,10 @@ type ListVmLogsRoot struct {
 var _ LogsService = &logsServiceHandler{}
 
 func (l *logsServiceHandler) ListActivityLogs(ctx context.Context, options *ListOptions) ([]ActivityLog, error) {
-	path := fmt.Sprintf("%s/activity?offset=%d&limit%d", logsPath, options.Page, options.PerPage)
+	path, err := addOptions(fmt.Sprintf("%s/activity", logsPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := l.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -111,11 +119,20 @@ func (l *logsServiceHandler) ListActivityLogs(ctx context.Context, options *List
 		return nil, err
 	}
 
+	options.setMeta(root.Total, len(root.Data))
 	return root.Data, nil
 }
 
+// ActivityLogsAll iterates over every activity log, fetching pages lazily.
+func (l *logsServiceHandler) ActivityLogsAll(ctx context.Context, opts ...IterOption) iter.Seq2[ActivityLog, error] {
+	return paginate(ctx, l.ListActivityLogs, opts)
+}
+
 func (l *logsServiceHandler) ListBillingLogs(ctx context.Context, options *ListOptions) ([]BillingLog, error) {
-	path := fmt.Sprintf("%s/billing?offset=%d&limit%d", logsPath, options.Page, options.PerPage)
+	path, err := addOptions(fmt.Sprintf("%s/billing", logsPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := l.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -127,11 +144,20 @@ func (l *logsServiceHandler) ListBillingLogs(ctx context.Context, options *ListO
 		return nil, err
 	}
 
+	options.setMeta(root.Total, len(root.Data))
 	return root.Data, nil
 }
 
+// BillingLogsAll iterates over every billing log, fetching pages lazily.
+func (l *logsServiceHandler) BillingLogsAll(ctx context.Context, opts ...IterOption) iter.Seq2[BillingLog, error] {
+	return paginate(ctx, l.ListBillingLogs, opts)
+}
+
 func (l *logsServiceHandler) ListAuditLogs(ctx context.Context, options *ListOptions) ([]AuditLog, error) {
-	path := fmt.Sprintf("%s/audit?offset=%d&limit%d", logsPath, options.Page, options.PerPage)
+	path, err := addOptions(fmt.Sprintf("%s/audit", logsPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := l.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -143,10 +169,20 @@ func (l *logsServiceHandler) ListAuditLogs(ctx context.Context, options *ListOpt
 		return nil, err
 	}
 
+	options.setMeta(root.Total, len(root.Data))
 	return root.Data, nil
 }
+
+// AuditLogsAll iterates over every audit log, fetching pages lazily.
+func (l *logsServiceHandler) AuditLogsAll(ctx context.Context, opts ...IterOption) iter.Seq2[AuditLog, error] {
+	return paginate(ctx, l.ListAuditLogs, opts)
+}
+
 func (l *logsServiceHandler) ListVPSieLogs(ctx context.Context, options *ListOptions) ([]VmLog, error) {
-	path := fmt.Sprintf("%s/vm?offset=%d&limit%d", logsPath, options.Page, options.PerPage)
+	path, err := addOptions(fmt.Sprintf("%s/vm", logsPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := l.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -158,5 +194,11 @@ func (l *logsServiceHandler) ListVPSieLogs(ctx context.Context, options *ListOpt
 		return nil, err
 	}
 
+	options.setMeta(root.Total, len(root.Data))
 	return root.Data, nil
 }
+
+// VPSieLogsAll iterates over every server log, fetching pages lazily.
+func (l *logsServiceHandler) VPSieLogsAll(ctx context.Context, opts ...IterOption) iter.Seq2[VmLog, error] {
+	return paginate(ctx, l.ListVPSieLogs, opts)
+}
@@ -0,0 +1,184 @@
+package goVPSie
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// Middleware wraps the transport that carries every attempt of a request,
+// retries included. It can inspect or rewrite requests and responses, or
+// answer without calling next at all.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc adapts a function to http.RoundTripper.
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Use appends middleware to the chain. The first middleware added is the
+// outermost one and sees each request first.
+func (c *Client) Use(middleware ...Middleware) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.middleware = append(c.middleware[:len(c.middleware):len(c.middleware)], middleware...)
+	c.chained = chainMiddleware(c.client, c.middleware)
+}
+
+// chainMiddleware returns a copy of httpClient whose transport runs through
+// middleware.
+func chainMiddleware(httpClient *http.Client, middleware []Middleware) *http.Client {
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	for i := len(middleware) - 1; i >= 0; i-- {
+		transport = middleware[i](transport)
+	}
+
+	chained := *httpClient
+	chained.Transport = transport
+	return &chained
+}
+
+type attemptContextKey struct{}
+
+// Attempt returns the attempt number, starting at 1, of the request carrying
+// ctx. It is meant for middleware; outside of a request it returns 0.
+func Attempt(ctx context.Context) int {
+	attempt, _ := ctx.Value(attemptContextKey{}).(int)
+	return attempt
+}
+
+func withAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptContextKey{}, attempt)
+}
+
+const defaultMaxLoggedBody = 4 << 10
+
+// LogOptions configures LoggingMiddleware.
+type LogOptions struct {
+	// Level of the request records. Defaults to slog.LevelDebug.
+	Level slog.Leveler
+
+	// Bodies adds the request and response bodies to the records, with secret
+	// fields redacted, truncated to MaxBodyBytes (4 KiB by default). At most
+	// MaxBodyBytes+1 bytes of a response are read ahead; longer responses are
+	// logged by their size only, since their secrets cannot be found.
+	Bodies       bool
+	MaxBodyBytes int
+}
+
+// LoggingMiddleware logs every attempt to logger with its method, path,
+// status, latency, attempt number and the request ID returned by the API.
+// Failed attempts and answers with a 4xx or 5xx status are logged at warning
+// level.
+func LoggingMiddleware(logger *slog.Logger, opts *LogOptions) Middleware {
+	var o LogOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Level == nil {
+		o.Level = slog.LevelDebug
+	}
+	if o.MaxBodyBytes <= 0 {
+		o.MaxBodyBytes = defaultMaxLoggedBody
+	}
+
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+			level := o.Level.Level()
+			if !logger.Enabled(ctx, level) && !logger.Enabled(ctx, slog.LevelWarn) {
+				return next.RoundTrip(req)
+			}
+
+			attrs := []slog.Attr{
+				slog.String("method", req.Method),
+				slog.String("path", req.URL.Path),
+				slog.Int("attempt", Attempt(ctx)),
+			}
+			if o.Bodies {
+				if body := requestBody(req); len(body) > 0 {
+					attrs = append(attrs, slog.String("request_body", logBody(body, o.MaxBodyBytes)))
+				}
+			}
+
+			start := time.Now()
+			res, err := next.RoundTrip(req)
+			attrs = append(attrs, slog.Duration("latency", time.Since(start)))
+
+			if err != nil {
+				attrs = append(attrs, slog.Any("error", err))
+				logger.LogAttrs(ctx, slog.LevelWarn, "vpsie request failed", attrs...)
+				return nil, err
+			}
+
+			attrs = append(attrs, slog.Int("status", res.StatusCode))
+			if id := requestID(res.Header); id != "" {
+				attrs = append(attrs, slog.String("request_id", id))
+			}
+			if o.Bodies {
+				// Read no more than is logged and hand the rest of the body
+				// on unread, so that the response size limit of Do holds.
+				body, readErr := io.ReadAll(io.LimitReader(res.Body, int64(o.MaxBodyBytes)+1))
+				if readErr != nil {
+					res.Body.Close()
+					return nil, readErr
+				}
+				res.Body = struct {
+					io.Reader
+					io.Closer
+				}{io.MultiReader(bytes.NewReader(body), res.Body), res.Body}
+
+				switch {
+				case len(body) > o.MaxBodyBytes:
+					// A truncated body cannot be redacted reliably.
+					attrs = append(attrs, slog.String("response_body", fmt.Sprintf("(more than %d bytes)", o.MaxBodyBytes)))
+				case len(body) > 0:
+					attrs = append(attrs, slog.String("response_body", logBody(body, o.MaxBodyBytes)))
+				}
+			}
+
+			if res.StatusCode >= http.StatusBadRequest {
+				level = slog.LevelWarn
+			}
+			logger.LogAttrs(ctx, level, "vpsie request", attrs...)
+
+			return res, nil
+		})
+	}
+}
+
+// requestBody returns a copy of the body of req without consuming it.
+func requestBody(req *http.Request) []byte {
+	if req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+
+	data, _ := io.ReadAll(body)
+	return data
+}
+
+func logBody(body []byte, limit int) string {
+	body = RedactJSON(bytes.TrimSpace(body))
+	if len(body) > limit {
+		return string(body[:limit]) + "..."
+	}
+
+	return string(body)
+}
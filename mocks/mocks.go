@@ -0,0 +1,123 @@
+// Package mocks provides programmable fakes of every goVPSie service.
+//
+// Each fake records the calls made to it and runs a per-method stub, so code
+// that takes a *goVPSie.Client can be unit tested without any HTTP:
+//
+//	client, fakes := mocks.NewClient()
+//	fakes.Server.GetServerByIdentifierFunc = func(ctx context.Context, id string) (*goVPSie.VmData, error) {
+//		return &goVPSie.VmData{Identifier: id, Hostname: "web"}, nil
+//	}
+//	// ... exercise code using client ...
+//	calls := fakes.Server.CallsTo("GetServerByIdentifier")
+//
+// Methods without a stub fail with a *NotStubbedError. The fakes are
+// generated from the service interfaces and are safe for concurrent use as
+// long as stubs are set before the calls start.
+package mocks
+
+//go:generate go run ../internal/gen -dir .. -kind mock -out services_gen.go
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"sync"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+)
+
+// ErrUnexpectedRequest is returned for any HTTP request made through a client
+// from NewClient.
+var ErrUnexpectedRequest = errors.New("mocks: unexpected HTTP request")
+
+// NewClient returns a client whose services are the fakes in the returned
+// Services. The client never reaches the network: a request that bypasses the
+// fakes, e.g. through Client.Do, fails with ErrUnexpectedRequest.
+func NewClient() (*goVPSie.Client, *Services) {
+	client := goVPSie.NewClient(&http.Client{
+		Transport: goVPSie.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return nil, fmt.Errorf("%w: %s %s", ErrUnexpectedRequest, req.Method, req.URL.Path)
+		}),
+	})
+
+	services := newServices()
+	services.install(client)
+	return client, services
+}
+
+// NotStubbedError is returned by a fake method whose Func field is nil.
+type NotStubbedError struct {
+	Service string
+	Method  string
+}
+
+func (e *NotStubbedError) Error() string {
+	return fmt.Sprintf("mocks: %s.%s is not stubbed", e.Service, e.Method)
+}
+
+func notStubbed(service, method string) error {
+	return &NotStubbedError{Service: service, Method: method}
+}
+
+// Call is a call recorded by a fake.
+type Call struct {
+	Method string
+
+	// Args holds the arguments after the context, in order.
+	Args []any
+}
+
+type recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *recorder) record(method string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns every call made to the fake, in order.
+func (r *recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the calls made to method, in order.
+func (r *recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var calls []Call
+	for _, call := range r.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the recorded calls. Stubs are kept.
+func (r *recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = nil
+}
+
+func zero[T any]() T {
+	var v T
+	return v
+}
+
+// failed returns an iterator that yields err once.
+func failed[T any](err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		yield(zero[T](), err)
+	}
+}
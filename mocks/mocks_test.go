@@ -0,0 +1,56 @@
+package mocks_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+	"github.com/ahmedabdelkader99/goVPSie/mocks"
+)
+
+func TestNewClient(t *testing.T) {
+	client, fakes := mocks.NewClient()
+	ctx := context.Background()
+
+	fakes.Server.GetServerByIdentifierFunc = func(ctx context.Context, identifierId string) (*goVPSie.VmData, error) {
+		return &goVPSie.VmData{Identifier: identifierId, Hostname: "web"}, nil
+	}
+
+	vm, err := client.Server.GetServerByIdentifier(ctx, "vm-1")
+	if err != nil || vm.Hostname != "web" {
+		t.Fatalf("GetServerByIdentifier = %+v, %v", vm, err)
+	}
+
+	var notStubbed *mocks.NotStubbedError
+	if err := client.Server.StartServer(ctx, "vm-1"); !errors.As(err, &notStubbed) || notStubbed.Method != "StartServer" {
+		t.Errorf("StartServer error = %v, want NotStubbedError", err)
+	}
+	for _, err := range client.Storage.All(ctx) {
+		if !errors.As(err, &notStubbed) {
+			t.Errorf("Storage.All error = %v, want NotStubbedError", err)
+		}
+	}
+
+	calls := fakes.Server.Calls()
+	if len(calls) != 2 || calls[0].Method != "GetServerByIdentifier" || calls[0].Args[0] != "vm-1" {
+		t.Errorf("Calls() = %+v", calls)
+	}
+	if got := fakes.Server.CallsTo("StartServer"); len(got) != 1 {
+		t.Errorf("CallsTo(StartServer) = %+v", got)
+	}
+
+	fakes.Server.Reset()
+	if got := fakes.Server.Calls(); len(got) != 0 {
+		t.Errorf("Calls() after Reset = %+v", got)
+	}
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "apps/v2/vm", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Do(ctx, req, nil); !errors.Is(err, mocks.ErrUnexpectedRequest) {
+		t.Errorf("Do error = %v, want ErrUnexpectedRequest", err)
+	}
+}
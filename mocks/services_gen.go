@@ -0,0 +1,2854 @@
+// Code generated by internal/gen; DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"iter"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+)
+
+// Services holds a fake for every service of a goVPSie.Client.
+type Services struct {
+	Account       *AccountService
+	Project       *ProjectsService
+	Server        *ServerService
+	Image         *ImagesService
+	SShKey        *SshkeysService
+	Profile       *ProfilesService
+	Backup        *BackupsService
+	IP            *IPsService
+	Domain        *DomainService
+	Fip           *FipService
+	FirewallGroup *FirewallGroupService
+	Firewall      *FirewallService
+	Storage       *StorageService
+	Snapshot      *SnapshotService
+	Logs          *LogsService
+	DataCenter    *DataCenterService
+	LB            *LBsService
+	Scripts       *ScriptsService
+	Pending       *PendingService
+	Gateway       *GatewayService
+	VPC           *VPCService
+	Bucket        *BucketService
+	K8s           *K8sService
+	AccessToken   *AccessTokenService
+	Billing       *BillingService
+	Monitoring    *MonitoringService
+}
+
+func newServices() *Services {
+	return &Services{
+		Account:       &AccountService{},
+		Project:       &ProjectsService{},
+		Server:        &ServerService{},
+		Image:         &ImagesService{},
+		SShKey:        &SshkeysService{},
+		Profile:       &ProfilesService{},
+		Backup:        &BackupsService{},
+		IP:            &IPsService{},
+		Domain:        &DomainService{},
+		Fip:           &FipService{},
+		FirewallGroup: &FirewallGroupService{},
+		Firewall:      &FirewallService{},
+		Storage:       &StorageService{},
+		Snapshot:      &SnapshotService{},
+		Logs:          &LogsService{},
+		DataCenter:    &DataCenterService{},
+		LB:            &LBsService{},
+		Scripts:       &ScriptsService{},
+		Pending:       &PendingService{},
+		Gateway:       &GatewayService{},
+		VPC:           &VPCService{},
+		Bucket:        &BucketService{},
+		K8s:           &K8sService{},
+		AccessToken:   &AccessTokenService{},
+		Billing:       &BillingService{},
+		Monitoring:    &MonitoringService{},
+	}
+}
+
+// install replaces the services of c with the fakes in s.
+func (s *Services) install(c *goVPSie.Client) {
+	c.Account = s.Account
+	c.Project = s.Project
+	c.Server = s.Server
+	c.Image = s.Image
+	c.SShKey = s.SShKey
+	c.Profile = s.Profile
+	c.Backup = s.Backup
+	c.IP = s.IP
+	c.Domain = s.Domain
+	c.Fip = s.Fip
+	c.FirewallGroup = s.FirewallGroup
+	c.Firewall = s.Firewall
+	c.Storage = s.Storage
+	c.Snapshot = s.Snapshot
+	c.Logs = s.Logs
+	c.DataCenter = s.DataCenter
+	c.LB = s.LB
+	c.Scripts = s.Scripts
+	c.Pending = s.Pending
+	c.Gateway = s.Gateway
+	c.VPC = s.VPC
+	c.Bucket = s.Bucket
+	c.K8s = s.K8s
+	c.AccessToken = s.AccessToken
+	c.Billing = s.Billing
+	c.Monitoring = s.Monitoring
+}
+
+// AccountService is a fake goVPSie.AccountService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type AccountService struct {
+	recorder
+
+	LoginFunc        func(ctx context.Context, loginCredentials *goVPSie.LoginReq) (*goVPSie.Token, error)
+	RefreshTokenFunc func(ctx context.Context, refreshToken string) (*goVPSie.Token, error)
+}
+
+var _ goVPSie.AccountService = &AccountService{}
+
+func (f *AccountService) Login(ctx context.Context, loginCredentials *goVPSie.LoginReq) (*goVPSie.Token, error) {
+	f.record("Login", loginCredentials)
+	if f.LoginFunc == nil {
+		return zero[*goVPSie.Token](), notStubbed("AccountService", "Login")
+	}
+	return f.LoginFunc(ctx, loginCredentials)
+}
+
+func (f *AccountService) RefreshToken(ctx context.Context, refreshToken string) (*goVPSie.Token, error) {
+	f.record("RefreshToken", refreshToken)
+	if f.RefreshTokenFunc == nil {
+		return zero[*goVPSie.Token](), notStubbed("AccountService", "RefreshToken")
+	}
+	return f.RefreshTokenFunc(ctx, refreshToken)
+}
+
+// ProjectsService is a fake goVPSie.ProjectsService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type ProjectsService struct {
+	recorder
+
+	ListFunc           func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Project, error)
+	AllFunc            func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Project, error]
+	SetDefaultFunc     func(ctx context.Context, projectIdentifier string) error
+	GetFunc            func(ctx context.Context, identifer string) (*goVPSie.Project, error)
+	CreateFunc         func(ctx context.Context, projectReq *goVPSie.CreateProjectRequest) error
+	ListAnotherVmsFunc func(ctx context.Context, projectId string) ([]goVPSie.VmData, error)
+	MoveVmsFunc        func(ctx context.Context, projectIdentifier string, projectId string) error
+	AssignToVmsFunc    func(ctx context.Context, projectIdentifier string, projectId string) error
+	ListDomainsFunc    func(ctx context.Context, projectIdentifier string) ([]goVPSie.Domain, error)
+	DeleteFunc         func(ctx context.Context, id string) error
+	ListUserLimitsFunc func(ctx context.Context) (*goVPSie.UserLimit, error)
+}
+
+var _ goVPSie.ProjectsService = &ProjectsService{}
+
+func (f *ProjectsService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Project, error) {
+	f.record("List", options)
+	if f.ListFunc == nil {
+		return zero[[]goVPSie.Project](), notStubbed("ProjectsService", "List")
+	}
+	return f.ListFunc(ctx, options)
+}
+
+func (f *ProjectsService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Project, error] {
+	f.record("All", opts)
+	if f.AllFunc == nil {
+		return failed[goVPSie.Project](notStubbed("ProjectsService", "All"))
+	}
+	return f.AllFunc(ctx, opts...)
+}
+
+func (f *ProjectsService) SetDefault(ctx context.Context, projectIdentifier string) error {
+	f.record("SetDefault", projectIdentifier)
+	if f.SetDefaultFunc == nil {
+		return notStubbed("ProjectsService", "SetDefault")
+	}
+	return f.SetDefaultFunc(ctx, projectIdentifier)
+}
+
+func (f *ProjectsService) Get(ctx context.Context, identifer string) (*goVPSie.Project, error) {
+	f.record("Get", identifer)
+	if f.GetFunc == nil {
+		return zero[*goVPSie.Project](), notStubbed("ProjectsService", "Get")
+	}
+	return f.GetFunc(ctx, identifer)
+}
+
+func (f *ProjectsService) Create(ctx context.Context, projectReq *goVPSie.CreateProjectRequest) error {
+	f.record("Create", projectReq)
+	if f.CreateFunc == nil {
+		return notStubbed("ProjectsService", "Create")
+	}
+	return f.CreateFunc(ctx, projectReq)
+}
+
+func (f *ProjectsService) ListAnotherVms(ctx context.Context, projectId string) ([]goVPSie.VmData, error) {
+	f.record("ListAnotherVms", projectId)
+	if f.ListAnotherVmsFunc == nil {
+		return zero[[]goVPSie.VmData](), notStubbed("ProjectsService", "ListAnotherVms")
+	}
+	return f.ListAnotherVmsFunc(ctx, projectId)
+}
+
+func (f *ProjectsService) MoveVms(ctx context.Context, projectIdentifier string, projectId string) error {
+	f.record("MoveVms", projectIdentifier, projectId)
+	if f.MoveVmsFunc == nil {
+		return notStubbed("ProjectsService", "MoveVms")
+	}
+	return f.MoveVmsFunc(ctx, projectIdentifier, projectId)
+}
+
+func (f *ProjectsService) AssignToVms(ctx context.Context, projectIdentifier string, projectId string) error {
+	f.record("AssignToVms", projectIdentifier, projectId)
+	if f.AssignToVmsFunc == nil {
+		return notStubbed("ProjectsService", "AssignToVms")
+	}
+	return f.AssignToVmsFunc(ctx, projectIdentifier, projectId)
+}
+
+func (f *ProjectsService) ListDomains(ctx context.Context, projectIdentifier string) ([]goVPSie.Domain, error) {
+	f.record("ListDomains", projectIdentifier)
+	if f.ListDomainsFunc == nil {
+		return zero[[]goVPSie.Domain](), notStubbed("ProjectsService", "ListDomains")
+	}
+	return f.ListDomainsFunc(ctx, projectIdentifier)
+}
+
+func (f *ProjectsService) Delete(ctx context.Context, id string) error {
+	f.record("Delete", id)
+	if f.DeleteFunc == nil {
+		return notStubbed("ProjectsService", "Delete")
+	}
+	return f.DeleteFunc(ctx, id)
+}
+
+func (f *ProjectsService) ListUserLimits(ctx context.Context) (*goVPSie.UserLimit, error) {
+	f.record("ListUserLimits")
+	if f.ListUserLimitsFunc == nil {
+		return zero[*goVPSie.UserLimit](), notStubbed("ProjectsService", "ListUserLimits")
+	}
+	return f.ListUserLimitsFunc(ctx)
+}
+
+// ServerService is a fake goVPSie.ServerService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type ServerService struct {
+	recorder
+
+	ListServerFunc                  func(ctx context.Context, options *goVPSie.ListOptions, projectId string) ([]goVPSie.VmData, error)
+	AllByProjectFunc                func(ctx context.Context, projectId string, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.VmData, error]
+	ListFunc                        func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.VmData, error)
+	AllFunc                         func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.VmData, error]
+	GetServerByIdentifierFunc       func(ctx context.Context, identifierId string) (*goVPSie.VmData, error)
+	GetServerStatusByIdentifierFunc func(ctx context.Context, identifierId string) (*goVPSie.Status, error)
+	GetServerConsoleFunc            func(ctx context.Context, identifierId string) (*goVPSie.ServerConsole, error)
+	CreateServerFunc                func(ctx context.Context, server *goVPSie.CreateServerRequest) error
+	CreateServerWithResultFunc      func(ctx context.Context, server *goVPSie.CreateServerRequest) (*goVPSie.CreateResult, error)
+	DeleteServerFunc                func(ctx context.Context, identifierId string, password string, reason string, note string) error
+	StartServerFunc                 func(ctx context.Context, identifierId string) error
+	StopServerFunc                  func(ctx context.Context, identifierId string) error
+	RestartServerFunc               func(ctx context.Context, identifierId string) error
+	StartManyFunc                   func(ctx context.Context, identifiers []string, opts *goVPSie.BulkOptions) ([]goVPSie.BulkResult, error)
+	StopManyFunc                    func(ctx context.Context, identifiers []string, opts *goVPSie.BulkOptions) ([]goVPSie.BulkResult, error)
+	RestartManyFunc                 func(ctx context.Context, identifiers []string, opts *goVPSie.BulkOptions) ([]goVPSie.BulkResult, error)
+	ChangePasswordFunc              func(ctx context.Context, identifierId string, newPassword string) error
+	ChangeHostNameFunc              func(ctx context.Context, identifierId string, newHostname string) error
+	AddVPCFunc                      func(ctx context.Context, request *goVPSie.VpcRequest) error
+	MoveVPCFunc                     func(ctx context.Context, request *goVPSie.VpcRequest) error
+	AddTagsFunc                     func(ctx context.Context, identifierId string, tags []string) error
+	ResizeServerFunc                func(ctx context.Context, identifierId string, cpu string, ram string) error
+	AddSshFunc                      func(ctx context.Context, identifierId string, sshKeyIdentifier string) error
+	AddScriptFunc                   func(ctx context.Context, identifierId string, scriptIdentifier string) error
+	LockFunc                        func(ctx context.Context, identifierId string) error
+	UnLockFunc                      func(ctx context.Context, identifierId string) error
+	DoMultiActionsFunc              func(ctx context.Context, vmsIdentifiers []string, actionType string, sshKeyIdentifier string) error
+	EnableIpv6Func                  func(ctx context.Context, identifierId string) error
+	EnableIpv4Func                  func(ctx context.Context, identifierId string) error
+	AddFipFunc                      func(ctx context.Context, identifierId string, dcIdentifier string) error
+	ResumeFunc                      func(ctx context.Context, resumeReq *goVPSie.ResumeReq) error
+	ResetNetworkFunc                func(ctx context.Context, vmIdentifier string) error
+	EditTagFunc                     func(ctx context.Context, tags []string, vmIdentifer string) error
+	ResetAllFirewallsFunc           func(ctx context.Context) error
+	ListVirtualMachinesFunc         func(ctx context.Context) ([]goVPSie.VirtualMachine, error)
+	ListAllNodesOfUserFunc          func(ctx context.Context) ([]goVPSie.VmData, error)
+	CheckAgentStatusFunc            func(ctx context.Context, vmIdentifier string) (bool, error)
+	WaitForStateFunc                func(ctx context.Context, identifierId string, state string, opts *goVPSie.WaitOptions) (*goVPSie.Status, error)
+	WaitForCreationFunc             func(ctx context.Context, result *goVPSie.CreateResult, opts *goVPSie.WaitOptions) (*goVPSie.VmData, error)
+}
+
+var _ goVPSie.ServerService = &ServerService{}
+
+func (f *ServerService) ListServer(ctx context.Context, options *goVPSie.ListOptions, projectId string) ([]goVPSie.VmData, error) {
+	f.record("ListServer", options, projectId)
+	if f.ListServerFunc == nil {
+		return zero[[]goVPSie.VmData](), notStubbed("ServerService", "ListServer")
+	}
+	return f.ListServerFunc(ctx, options, projectId)
+}
+
+func (f *ServerService) AllByProject(ctx context.Context, projectId string, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.VmData, error] {
+	f.record("AllByProject", projectId, opts)
+	if f.AllByProjectFunc == nil {
+		return failed[goVPSie.VmData](notStubbed("ServerService", "AllByProject"))
+	}
+	return f.AllByProjectFunc(ctx, projectId, opts...)
+}
+
+func (f *ServerService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.VmData, error) {
+	f.record("List", options)
+	if f.ListFunc == nil {
+		return zero[[]goVPSie.VmData](), notStubbed("ServerService", "List")
+	}
+	return f.ListFunc(ctx, options)
+}
+
+func (f *ServerService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.VmData, error] {
+	f.record("All", opts)
+	if f.AllFunc == nil {
+		return failed[goVPSie.VmData](notStubbed("ServerService", "All"))
+	}
+	return f.AllFunc(ctx, opts...)
+}
+
+func (f *ServerService) GetServerByIdentifier(ctx context.Context, identifierId string) (*goVPSie.VmData, error) {
+	f.record("GetServerByIdentifier", identifierId)
+	if f.GetServerByIdentifierFunc == nil {
+		return zero[*goVPSie.VmData](), notStubbed("ServerService", "GetServerByIdentifier")
+	}
+	return f.GetServerByIdentifierFunc(ctx, identifierId)
+}
+
+func (f *ServerService) GetServerStatusByIdentifier(ctx context.Context, identifierId string) (*goVPSie.Status, error) {
+	f.record("GetServerStatusByIdentifier", identifierId)
+	if f.GetServerStatusByIdentifierFunc == nil {
+		return zero[*goVPSie.Status](), notStubbed("ServerService", "GetServerStatusByIdentifier")
+	}
+	return f.GetServerStatusByIdentifierFunc(ctx, identifierId)
+}
+
+func (f *ServerService) GetServerConsole(ctx context.Context, identifierId string) (*goVPSie.ServerConsole, error) {
+	f.record("GetServerConsole", identifierId)
+	if f.GetServerConsoleFunc == nil {
+		return zero[*goVPSie.ServerConsole](), notStubbed("ServerService", "GetServerConsole")
+	}
+	return f.GetServerConsoleFunc(ctx, identifierId)
+}
+
+func (f *ServerService) CreateServer(ctx context.Context, server *goVPSie.CreateServerRequest) error {
+	f.record("CreateServer", server)
+	if f.CreateServerFunc == nil {
+		return notStubbed("ServerService", "CreateServer")
+	}
+	return f.CreateServerFunc(ctx, server)
+}
+
+func (f *ServerService) CreateServerWithResult(ctx context.Context, server *goVPSie.CreateServerRequest) (*goVPSie.CreateResult, error) {
+	f.record("CreateServerWithResult", server)
+	if f.CreateServerWithResultFunc == nil {
+		return zero[*goVPSie.CreateResult](), notStubbed("ServerService", "CreateServerWithResult")
+	}
+	return f.CreateServerWithResultFunc(ctx, server)
+}
+
+func (f *ServerService) DeleteServer(ctx context.Context, identifierId string, password string, reason string, note string) error {
+	f.record("DeleteServer", identifierId, password, reason, note)
+	if f.DeleteServerFunc == nil {
+		return notStubbed("ServerService", "DeleteServer")
+	}
+	return f.DeleteServerFunc(ctx, identifierId, password, reason, note)
+}
+
+func (f *ServerService) StartServer(ctx context.Context, identifierId string) error {
+	f.record("StartServer", identifierId)
+	if f.StartServerFunc == nil {
+		return notStubbed("ServerService", "StartServer")
+	}
+	return f.StartServerFunc(ctx, identifierId)
+}
+
+func (f *ServerService) StopServer(ctx context.Context, identifierId string) error {
+	f.record("StopServer", identifierId)
+	if f.StopServerFunc == nil {
+		return notStubbed("ServerService", "StopServer")
+	}
+	return f.StopServerFunc(ctx, identifierId)
+}
+
+func (f *ServerService) RestartServer(ctx context.Context, identifierId string) error {
+	f.record("RestartServer", identifierId)
+	if f.RestartServerFunc == nil {
+		return notStubbed("ServerService", "RestartServer")
+	}
+	return f.RestartServerFunc(ctx, identifierId)
+}
+
+func (f *ServerService) StartMany(ctx context.Context, identifiers []string, opts *goVPSie.BulkOptions) ([]goVPSie.BulkResult, error) {
+	f.record("StartMany", identifiers, opts)
+	if f.StartManyFunc == nil {
+		return zero[[]goVPSie.BulkResult](), notStubbed("ServerService", "StartMany")
+	}
+	return f.StartManyFunc(ctx, identifiers, opts)
+}
+
+func (f *ServerService) StopMany(ctx context.Context, identifiers []string, opts *goVPSie.BulkOptions) ([]goVPSie.BulkResult, error) {
+	f.record("StopMany", identifiers, opts)
+	if f.StopManyFunc == nil {
+		return zero[[]goVPSie.BulkResult](), notStubbed("ServerService", "StopMany")
+	}
+	return f.StopManyFunc(ctx, identifiers, opts)
+}
+
+func (f *ServerService) RestartMany(ctx context.Context, identifiers []string, opts *goVPSie.BulkOptions) ([]goVPSie.BulkResult, error) {
+	f.record("RestartMany", identifiers, opts)
+	if f.RestartManyFunc == nil {
+		return zero[[]goVPSie.BulkResult](), notStubbed("ServerService", "RestartMany")
+	}
+	return f.RestartManyFunc(ctx, identifiers, opts)
+}
+
+func (f *ServerService) ChangePassword(ctx context.Context, identifierId string, newPassword string) error {
+	f.record("ChangePassword", identifierId, newPassword)
+	if f.ChangePasswordFunc == nil {
+		return notStubbed("ServerService", "ChangePassword")
+	}
+	return f.ChangePasswordFunc(ctx, identifierId, newPassword)
+}
+
+func (f *ServerService) ChangeHostName(ctx context.Context, identifierId string, newHostname string) error {
+	f.record("ChangeHostName", identifierId, newHostname)
+	if f.ChangeHostNameFunc == nil {
+		return notStubbed("ServerService", "ChangeHostName")
+	}
+	return f.ChangeHostNameFunc(ctx, identifierId, newHostname)
+}
+
+func (f *ServerService) AddVPC(ctx context.Context, request *goVPSie.VpcRequest) error {
+	f.record("AddVPC", request)
+	if f.AddVPCFunc == nil {
+		return notStubbed("ServerService", "AddVPC")
+	}
+	return f.AddVPCFunc(ctx, request)
+}
+
+func (f *ServerService) MoveVPC(ctx context.Context, request *goVPSie.VpcRequest) error {
+	f.record("MoveVPC", request)
+	if f.MoveVPCFunc == nil {
+		return notStubbed("ServerService", "MoveVPC")
+	}
+	return f.MoveVPCFunc(ctx, request)
+}
+
+func (f *ServerService) AddTags(ctx context.Context, identifierId string, tags []string) error {
+	f.record("AddTags", identifierId, tags)
+	if f.AddTagsFunc == nil {
+		return notStubbed("ServerService", "AddTags")
+	}
+	return f.AddTagsFunc(ctx, identifierId, tags)
+}
+
+func (f *ServerService) ResizeServer(ctx context.Context, identifierId string, cpu string, ram string) error {
+	f.record("ResizeServer", identifierId, cpu, ram)
+	if f.ResizeServerFunc == nil {
+		return notStubbed("ServerService", "ResizeServer")
+	}
+	return f.ResizeServerFunc(ctx, identifierId, cpu, ram)
+}
+
+func (f *ServerService) AddSsh(ctx context.Context, identifierId string, sshKeyIdentifier string) error {
+	f.record("AddSsh", identifierId, sshKeyIdentifier)
+	if f.AddSshFunc == nil {
+		return notStubbed("ServerService", "AddSsh")
+	}
+	return f.AddSshFunc(ctx, identifierId, sshKeyIdentifier)
+}
+
+func (f *ServerService) AddScript(ctx context.Context, identifierId string, scriptIdentifier string) error {
+	f.record("AddScript", identifierId, scriptIdentifier)
+	if f.AddScriptFunc == nil {
+		return notStubbed("ServerService", "AddScript")
+	}
+	return f.AddScriptFunc(ctx, identifierId, scriptIdentifier)
+}
+
+func (f *ServerService) Lock(ctx context.Context, identifierId string) error {
+	f.record("Lock", identifierId)
+	if f.LockFunc == nil {
+		return notStubbed("ServerService", "Lock")
+	}
+	return f.LockFunc(ctx, identifierId)
+}
+
+func (f *ServerService) UnLock(ctx context.Context, identifierId string) error {
+	f.record("UnLock", identifierId)
+	if f.UnLockFunc == nil {
+		return notStubbed("ServerService", "UnLock")
+	}
+	return f.UnLockFunc(ctx, identifierId)
+}
+
+func (f *ServerService) DoMultiActions(ctx context.Context, vmsIdentifiers []string, actionType string, sshKeyIdentifier string) error {
+	f.record("DoMultiActions", vmsIdentifiers, actionType, sshKeyIdentifier)
+	if f.DoMultiActionsFunc == nil {
+		return notStubbed("ServerService", "DoMultiActions")
+	}
+	return f.DoMultiActionsFunc(ctx, vmsIdentifiers, actionType, sshKeyIdentifier)
+}
+
+func (f *ServerService) EnableIpv6(ctx context.Context, identifierId string) error {
+	f.record("EnableIpv6", identifierId)
+	if f.EnableIpv6Func == nil {
+		return notStubbed("ServerService", "EnableIpv6")
+	}
+	return f.EnableIpv6Func(ctx, identifierId)
+}
+
+func (f *ServerService) EnableIpv4(ctx context.Context, identifierId string) error {
+	f.record("EnableIpv4", identifierId)
+	if f.EnableIpv4Func == nil {
+		return notStubbed("ServerService", "EnableIpv4")
+	}
+	return f.EnableIpv4Func(ctx, identifierId)
+}
+
+func (f *ServerService) AddFip(ctx context.Context, identifierId string, dcIdentifier string) error {
+	f.record("AddFip", identifierId, dcIdentifier)
+	if f.AddFipFunc == nil {
+		return notStubbed("ServerService", "AddFip")
+	}
+	return f.AddFipFunc(ctx, identifierId, dcIdentifier)
+}
+
+func (f *ServerService) Resume(ctx context.Context, resumeReq *goVPSie.ResumeReq) error {
+	f.record("Resume", resumeReq)
+	if f.ResumeFunc == nil {
+		return notStubbed("ServerService", "Resume")
+	}
+	return f.ResumeFunc(ctx, resumeReq)
+}
+
+func (f *ServerService) ResetNetwork(ctx context.Context, vmIdentifier string) error {
+	f.record("ResetNetwork", vmIdentifier)
+	if f.ResetNetworkFunc == nil {
+		return notStubbed("ServerService", "ResetNetwork")
+	}
+	return f.ResetNetworkFunc(ctx, vmIdentifier)
+}
+
+func (f *ServerService) EditTag(ctx context.Context, tags []string, vmIdentifer string) error {
+	f.record("EditTag", tags, vmIdentifer)
+	if f.EditTagFunc == nil {
+		return notStubbed("ServerService", "EditTag")
+	}
+	return f.EditTagFunc(ctx, tags, vmIdentifer)
+}
+
+func (f *ServerService) ResetAllFirewalls(ctx context.Context) error {
+	f.record("ResetAllFirewalls")
+	if f.ResetAllFirewallsFunc == nil {
+		return notStubbed("ServerService", "ResetAllFirewalls")
+	}
+	return f.ResetAllFirewallsFunc(ctx)
+}
+
+func (f *ServerService) ListVirtualMachines(ctx context.Context) ([]goVPSie.VirtualMachine, error) {
+	f.record("ListVirtualMachines")
+	if f.ListVirtualMachinesFunc == nil {
+		return zero[[]goVPSie.VirtualMachine](), notStubbed("ServerService", "ListVirtualMachines")
+	}
+	return f.ListVirtualMachinesFunc(ctx)
+}
+
+func (f *ServerService) ListAllNodesOfUser(ctx context.Context) ([]goVPSie.VmData, error) {
+	f.record("ListAllNodesOfUser")
+	if f.ListAllNodesOfUserFunc == nil {
+		return zero[[]goVPSie.VmData](), notStubbed("ServerService", "ListAllNodesOfUser")
+	}
+	return f.ListAllNodesOfUserFunc(ctx)
+}
+
+func (f *ServerService) CheckAgentStatus(ctx context.Context, vmIdentifier string) (bool, error) {
+	f.record("CheckAgentStatus", vmIdentifier)
+	if f.CheckAgentStatusFunc == nil {
+		return zero[bool](), notStubbed("ServerService", "CheckAgentStatus")
+	}
+	return f.CheckAgentStatusFunc(ctx, vmIdentifier)
+}
+
+func (f *ServerService) WaitForState(ctx context.Context, identifierId string, state string, opts *goVPSie.WaitOptions) (*goVPSie.Status, error) {
+	f.record("WaitForState", identifierId, state, opts)
+	if f.WaitForStateFunc == nil {
+		return zero[*goVPSie.Status](), notStubbed("ServerService", "WaitForState")
+	}
+	return f.WaitForStateFunc(ctx, identifierId, state, opts)
+}
+
+func (f *ServerService) WaitForCreation(ctx context.Context, result *goVPSie.CreateResult, opts *goVPSie.WaitOptions) (*goVPSie.VmData, error) {
+	f.record("WaitForCreation", result, opts)
+	if f.WaitForCreationFunc == nil {
+		return zero[*goVPSie.VmData](), notStubbed("ServerService", "WaitForCreation")
+	}
+	return f.WaitForCreationFunc(ctx, result, opts)
+}
+
+// ImagesService is a fake goVPSie.ImagesService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type ImagesService struct {
+	recorder
+
+	DeleteImageFunc                   func(ctx context.Context, imageIdentifier string) error
+	ListFunc                          func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.CustomImage, error)
+	AllFunc                           func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.CustomImage, error]
+	CreateImagesFunc                  func(ctx context.Context, dcIdentifier string, imageName string, imageUrl string) error
+	CreateServerByImageFunc           func(ctx context.Context, createServerReq *goVPSie.CreateServerRequest) error
+	CreateServerByImageWithResultFunc func(ctx context.Context, createServerReq *goVPSie.CreateServerRequest) (*goVPSie.CreateResult, error)
+	GetImageFunc                      func(ctx context.Context, imageIdentifier string) (*goVPSie.CustomImage, error)
+}
+
+var _ goVPSie.ImagesService = &ImagesService{}
+
+func (f *ImagesService) DeleteImage(ctx context.Context, imageIdentifier string) error {
+	f.record("DeleteImage", imageIdentifier)
+	if f.DeleteImageFunc == nil {
+		return notStubbed("ImagesService", "DeleteImage")
+	}
+	return f.DeleteImageFunc(ctx, imageIdentifier)
+}
+
+func (f *ImagesService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.CustomImage, error) {
+	f.record("List", options)
+	if f.ListFunc == nil {
+		return zero[[]goVPSie.CustomImage](), notStubbed("ImagesService", "List")
+	}
+	return f.ListFunc(ctx, options)
+}
+
+func (f *ImagesService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.CustomImage, error] {
+	f.record("All", opts)
+	if f.AllFunc == nil {
+		return failed[goVPSie.CustomImage](notStubbed("ImagesService", "All"))
+	}
+	return f.AllFunc(ctx, opts...)
+}
+
+func (f *ImagesService) CreateImages(ctx context.Context, dcIdentifier string, imageName string, imageUrl string) error {
+	f.record("CreateImages", dcIdentifier, imageName, imageUrl)
+	if f.CreateImagesFunc == nil {
+		return notStubbed("ImagesService", "CreateImages")
+	}
+	return f.CreateImagesFunc(ctx, dcIdentifier, imageName, imageUrl)
+}
+
+func (f *ImagesService) CreateServerByImage(ctx context.Context, createServerReq *goVPSie.CreateServerRequest) error {
+	f.record("CreateServerByImage", createServerReq)
+	if f.CreateServerByImageFunc == nil {
+		return notStubbed("ImagesService", "CreateServerByImage")
+	}
+	return f.CreateServerByImageFunc(ctx, createServerReq)
+}
+
+func (f *ImagesService) CreateServerByImageWithResult(ctx context.Context, createServerReq *goVPSie.CreateServerRequest) (*goVPSie.CreateResult, error) {
+	f.record("CreateServerByImageWithResult", createServerReq)
+	if f.CreateServerByImageWithResultFunc == nil {
+		return zero[*goVPSie.CreateResult](), notStubbed("ImagesService", "CreateServerByImageWithResult")
+	}
+	return f.CreateServerByImageWithResultFunc(ctx, createServerReq)
+}
+
+func (f *ImagesService) GetImage(ctx context.Context, imageIdentifier string) (*goVPSie.CustomImage, error) {
+	f.record("GetImage", imageIdentifier)
+	if f.GetImageFunc == nil {
+		return zero[*goVPSie.CustomImage](), notStubbed("ImagesService", "GetImage")
+	}
+	return f.GetImageFunc(ctx, imageIdentifier)
+}
+
+// SshkeysService is a fake goVPSie.SshkeysService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type SshkeysService struct {
+	recorder
+
+	ListFunc   func(ctx context.Context) ([]goVPSie.SShKey, error)
+	DeleteFunc func(ctx context.Context, sshKeyIdentifier string) error
+	GetFunc    func(ctx context.Context, sshKeyIdentifier string) (*goVPSie.SShKey, error)
+	CreateFunc func(ctx context.Context, privateKey string, name string) error
+}
+
+var _ goVPSie.SshkeysService = &SshkeysService{}
+
+func (f *SshkeysService) List(ctx context.Context) ([]goVPSie.SShKey, error) {
+	f.record("List")
+	if f.ListFunc == nil {
+		return zero[[]goVPSie.SShKey](), notStubbed("SshkeysService", "List")
+	}
+	return f.ListFunc(ctx)
+}
+
+func (f *SshkeysService) Delete(ctx context.Context, sshKeyIdentifier string) error {
+	f.record("Delete", sshKeyIdentifier)
+	if f.DeleteFunc == nil {
+		return notStubbed("SshkeysService", "Delete")
+	}
+	return f.DeleteFunc(ctx, sshKeyIdentifier)
+}
+
+func (f *SshkeysService) Get(ctx context.Context, sshKeyIdentifier string) (*goVPSie.SShKey, error) {
+	f.record("Get", sshKeyIdentifier)
+	if f.GetFunc == nil {
+		return zero[*goVPSie.SShKey](), notStubbed("SshkeysService", "Get")
+	}
+	return f.GetFunc(ctx, sshKeyIdentifier)
+}
+
+func (f *SshkeysService) Create(ctx context.Context, privateKey string, name string) error {
+	f.record("Create", privateKey, name)
+	if f.CreateFunc == nil {
+		return notStubbed("SshkeysService", "Create")
+	}
+	return f.CreateFunc(ctx, privateKey, name)
+}
+
+// ProfilesService is a fake goVPSie.ProfilesService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type ProfilesService struct {
+	recorder
+
+	ListQuickActionOfUserFunc    func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.QuickActions, error)
+	QuickActionsOfUserAllFunc    func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.QuickActions, error]
+	ListQuickActionOfAccountFunc func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.QuickActions, error)
+	QuickActionsOfAccountAllFunc func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.QuickActions, error]
+	SaveQuickActionsFunc         func(ctx context.Context, actions []int) error
+	GetProfileFunc               func(ctx context.Context) (*goVPSie.Profile, error)
+	UpdateProfileFunc            func(ctx context.Context, updateReq goVPSie.UpdateProfileRequest) error
+	GetPermissionGroupsFunc      func(ctx context.Context) ([]goVPSie.PermissionGroup, error)
+	DeletePermissionGroupFunc    func(ctx context.Context, groupId string) error
+	CreatePermissionGroupFunc    func(ctx context.Context, groupName string) error
+	ChangePasswordFunc           func(ctx context.Context, oldPassword string, newPassword string) error
+	UpdateBillingFunc            func(ctx context.Context, billing goVPSie.BillingAddress) error
+	ValidatePhoneFunc            func(ctx context.Context, phone string) error
+	VerifyPhoneFunc              func(ctx context.Context, code string) error
+	EnableTwofaFunc              func(ctx context.Context) error
+	DisableTwofaFunc             func(ctx context.Context) error
+}
+
+var _ goVPSie.ProfilesService = &ProfilesService{}
+
+func (f *ProfilesService) ListQuickActionOfUser(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.QuickActions, error) {
+	f.record("ListQuickActionOfUser", options)
+	if f.ListQuickActionOfUserFunc == nil {
+		return zero[[]goVPSie.QuickActions](), notStubbed("ProfilesService", "ListQuickActionOfUser")
+	}
+	return f.ListQuickActionOfUserFunc(ctx, options)
+}
+
+func (f *ProfilesService) QuickActionsOfUserAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.QuickActions, error] {
+	f.record("QuickActionsOfUserAll", opts)
+	if f.QuickActionsOfUserAllFunc == nil {
+		return failed[goVPSie.QuickActions](notStubbed("ProfilesService", "QuickActionsOfUserAll"))
+	}
+	return f.QuickActionsOfUserAllFunc(ctx, opts...)
+}
+
+func (f *ProfilesService) ListQuickActionOfAccount(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.QuickActions, error) {
+	f.record("ListQuickActionOfAccount", options)
+	if f.ListQuickActionOfAccountFunc == nil {
+		return zero[[]goVPSie.QuickActions](), notStubbed("ProfilesService", "ListQuickActionOfAccount")
+	}
+	return f.ListQuickActionOfAccountFunc(ctx, options)
+}
+
+func (f *ProfilesService) QuickActionsOfAccountAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.QuickActions, error] {
+	f.record("QuickActionsOfAccountAll", opts)
+	if f.QuickActionsOfAccountAllFunc == nil {
+		return failed[goVPSie.QuickActions](notStubbed("ProfilesService", "QuickActionsOfAccountAll"))
+	}
+	return f.QuickActionsOfAccountAllFunc(ctx, opts...)
+}
+
+func (f *ProfilesService) SaveQuickActions(ctx context.Context, actions []int) error {
+	f.record("SaveQuickActions", actions)
+	if f.SaveQuickActionsFunc == nil {
+		return notStubbed("ProfilesService", "SaveQuickActions")
+	}
+	return f.SaveQuickActionsFunc(ctx, actions)
+}
+
+func (f *ProfilesService) GetProfile(ctx context.Context) (*goVPSie.Profile, error) {
+	f.record("GetProfile")
+	if f.GetProfileFunc == nil {
+		return zero[*goVPSie.Profile](), notStubbed("ProfilesService", "GetProfile")
+	}
+	return f.GetProfileFunc(ctx)
+}
+
+func (f *ProfilesService) UpdateProfile(ctx context.Context, updateReq goVPSie.UpdateProfileRequest) error {
+	f.record("UpdateProfile", updateReq)
+	if f.UpdateProfileFunc == nil {
+		return notStubbed("ProfilesService", "UpdateProfile")
+	}
+	return f.UpdateProfileFunc(ctx, updateReq)
+}
+
+func (f *ProfilesService) GetPermissionGroups(ctx context.Context) ([]goVPSie.PermissionGroup, error) {
+	f.record("GetPermissionGroups")
+	if f.GetPermissionGroupsFunc == nil {
+		return zero[[]goVPSie.PermissionGroup](), notStubbed("ProfilesService", "GetPermissionGroups")
+	}
+	return f.GetPermissionGroupsFunc(ctx)
+}
+
+func (f *ProfilesService) DeletePermissionGroup(ctx context.Context, groupId string) error {
+	f.record("DeletePermissionGroup", groupId)
+	if f.DeletePermissionGroupFunc == nil {
+		return notStubbed("ProfilesService", "DeletePermissionGroup")
+	}
+	return f.DeletePermissionGroupFunc(ctx, groupId)
+}
+
+func (f *ProfilesService) CreatePermissionGroup(ctx context.Context, groupName string) error {
+	f.record("CreatePermissionGroup", groupName)
+	if f.CreatePermissionGroupFunc == nil {
+		return notStubbed("ProfilesService", "CreatePermissionGroup")
+	}
+	return f.CreatePermissionGroupFunc(ctx, groupName)
+}
+
+func (f *ProfilesService) ChangePassword(ctx context.Context, oldPassword string, newPassword string) error {
+	f.record("ChangePassword", oldPassword, newPassword)
+	if f.ChangePasswordFunc == nil {
+		return notStubbed("ProfilesService", "ChangePassword")
+	}
+	return f.ChangePasswordFunc(ctx, oldPassword, newPassword)
+}
+
+func (f *ProfilesService) UpdateBilling(ctx context.Context, billing goVPSie.BillingAddress) error {
+	f.record("UpdateBilling", billing)
+	if f.UpdateBillingFunc == nil {
+		return notStubbed("ProfilesService", "UpdateBilling")
+	}
+	return f.UpdateBillingFunc(ctx, billing)
+}
+
+func (f *ProfilesService) ValidatePhone(ctx context.Context, phone string) error {
+	f.record("ValidatePhone", phone)
+	if f.ValidatePhoneFunc == nil {
+		return notStubbed("ProfilesService", "ValidatePhone")
+	}
+	return f.ValidatePhoneFunc(ctx, phone)
+}
+
+func (f *ProfilesService) VerifyPhone(ctx context.Context, code string) error {
+	f.record("VerifyPhone", code)
+	if f.VerifyPhoneFunc == nil {
+		return notStubbed("ProfilesService", "VerifyPhone")
+	}
+	return f.VerifyPhoneFunc(ctx, code)
+}
+
+func (f *ProfilesService) EnableTwofa(ctx context.Context) error {
+	f.record("EnableTwofa")
+	if f.EnableTwofaFunc == nil {
+		return notStubbed("ProfilesService", "EnableTwofa")
+	}
+	return f.EnableTwofaFunc(ctx)
+}
+
+func (f *ProfilesService) DisableTwofa(ctx context.Context) error {
+	f.record("DisableTwofa")
+	if f.DisableTwofaFunc == nil {
+		return notStubbed("ProfilesService", "DisableTwofa")
+	}
+	return f.DisableTwofaFunc(ctx)
+}
+
+// BackupsService is a fake goVPSie.BackupsService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type BackupsService struct {
+	recorder
+
+	ListFunc                           func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Backup, error)
+	AllFunc                            func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Backup, error]
+	DeleteBackupFunc                   func(ctx context.Context, backupIdentifier string, deleteReason string, deleteNote string) error
+	CreateBackupsFunc                  func(ctx context.Context, vmIdentifier string, name string, notes string) error
+	ListByServerFunc                   func(ctx context.Context, options *goVPSie.ListOptions, vmIdentifier string) ([]goVPSie.Backup, error)
+	AllByServerFunc                    func(ctx context.Context, vmIdentifier string, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Backup, error]
+	CreateServerByBackupFunc           func(ctx context.Context, backupIdentifier string) error
+	CreateServerByBackupWithResultFunc func(ctx context.Context, backupIdentifier string) (*goVPSie.CreateResult, error)
+	GetFunc                            func(ctx context.Context, identifer string) (*goVPSie.Backup, error)
+	EnableAutoBackupFunc               func(ctx context.Context, enableAutoReq *goVPSie.EnableAutoBackupReq) error
+	RenameFunc                         func(ctx context.Context, backupIdentifier string, newName string) error
+	GetBackupPolicyFunc                func(ctx context.Context, identifier string) (*goVPSie.BackupPolicy, error)
+	CreateBackupPolicyFunc             func(ctx context.Context, createReq *goVPSie.CreateBackupPolicyReq) error
+	DeleteBackupPolicyFunc             func(ctx context.Context, policyId string, identifier string) error
+	ManageRetainBackupPolicyFunc       func(ctx context.Context, policyId string, keep int) error
+	AttachBackupPolicyFunc             func(ctx context.Context, policyId string, vms []string) error
+	DetachBackupPolicyFunc             func(ctx context.Context, policyId string, vms []string) error
+	ListBackupPoliciesFunc             func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.BackupPolicyListDetail, error)
+	BackupPoliciesAllFunc              func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.BackupPolicyListDetail, error]
+}
+
+var _ goVPSie.BackupsService = &BackupsService{}
+
+func (f *BackupsService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Backup, error) {
+	f.record("List", options)
+	if f.ListFunc == nil {
+		return zero[[]goVPSie.Backup](), notStubbed("BackupsService", "List")
+	}
+	return f.ListFunc(ctx, options)
+}
+
+func (f *BackupsService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Backup, error] {
+	f.record("All", opts)
+	if f.AllFunc == nil {
+		return failed[goVPSie.Backup](notStubbed("BackupsService", "All"))
+	}
+	return f.AllFunc(ctx, opts...)
+}
+
+func (f *BackupsService) DeleteBackup(ctx context.Context, backupIdentifier string, deleteReason string, deleteNote string) error {
+	f.record("DeleteBackup", backupIdentifier, deleteReason, deleteNote)
+	if f.DeleteBackupFunc == nil {
+		return notStubbed("BackupsService", "DeleteBackup")
+	}
+	return f.DeleteBackupFunc(ctx, backupIdentifier, deleteReason, deleteNote)
+}
+
+func (f *BackupsService) CreateBackups(ctx context.Context, vmIdentifier string, name string, notes string) error {
+	f.record("CreateBackups", vmIdentifier, name, notes)
+	if f.CreateBackupsFunc == nil {
+		return notStubbed("BackupsService", "CreateBackups")
+	}
+	return f.CreateBackupsFunc(ctx, vmIdentifier, name, notes)
+}
+
+func (f *BackupsService) ListByServer(ctx context.Context, options *goVPSie.ListOptions, vmIdentifier string) ([]goVPSie.Backup, error) {
+	f.record("ListByServer", options, vmIdentifier)
+	if f.ListByServerFunc == nil {
+		return zero[[]goVPSie.Backup](), notStubbed("BackupsService", "ListByServer")
+	}
+	return f.ListByServerFunc(ctx, options, vmIdentifier)
+}
+
+func (f *BackupsService) AllByServer(ctx context.Context, vmIdentifier string, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Backup, error] {
+	f.record("AllByServer", vmIdentifier, opts)
+	if f.AllByServerFunc == nil {
+		return failed[goVPSie.Backup](notStubbed("BackupsService", "AllByServer"))
+	}
+	return f.AllByServerFunc(ctx, vmIdentifier, opts...)
+}
+
+func (f *BackupsService) CreateServerByBackup(ctx context.Context, backupIdentifier string) error {
+	f.record("CreateServerByBackup", backupIdentifier)
+	if f.CreateServerByBackupFunc == nil {
+		return notStubbed("BackupsService", "CreateServerByBackup")
+	}
+	return f.CreateServerByBackupFunc(ctx, backupIdentifier)
+}
+
+func (f *BackupsService) CreateServerByBackupWithResult(ctx context.Context, backupIdentifier string) (*goVPSie.CreateResult, error) {
+	f.record("CreateServerByBackupWithResult", backupIdentifier)
+	if f.CreateServerByBackupWithResultFunc == nil {
+		return zero[*goVPSie.CreateResult](), notStubbed("BackupsService", "CreateServerByBackupWithResult")
+	}
+	return f.CreateServerByBackupWithResultFunc(ctx, backupIdentifier)
+}
+
+func (f *BackupsService) Get(ctx context.Context, identifer string) (*goVPSie.Backup, error) {
+	f.record("Get", identifer)
+	if f.GetFunc == nil {
+		return zero[*goVPSie.Backup](), notStubbed("BackupsService", "Get")
+	}
+	return f.GetFunc(ctx, identifer)
+}
+
+func (f *BackupsService) EnableAutoBackup(ctx context.Context, enableAutoReq *goVPSie.EnableAutoBackupReq) error {
+	f.record("EnableAutoBackup", enableAutoReq)
+	if f.EnableAutoBackupFunc == nil {
+		return notStubbed("BackupsService", "EnableAutoBackup")
+	}
+	return f.EnableAutoBackupFunc(ctx, enableAutoReq)
+}
+
+func (f *BackupsService) Rename(ctx context.Context, backupIdentifier string, newName string) error {
+	f.record("Rename", backupIdentifier, newName)
+	if f.RenameFunc == nil {
+		return notStubbed("BackupsService", "Rename")
+	}
+	return f.RenameFunc(ctx, backupIdentifier, newName)
+}
+
+func (f *BackupsService) GetBackupPolicy(ctx context.Context, identifier string) (*goVPSie.BackupPolicy, error) {
+	f.record("GetBackupPolicy", identifier)
+	if f.GetBackupPolicyFunc == nil {
+		return zero[*goVPSie.BackupPolicy](), notStubbed("BackupsService", "GetBackupPolicy")
+	}
+	return f.GetBackupPolicyFunc(ctx, identifier)
+}
+
+func (f *BackupsService) CreateBackupPolicy(ctx context.Context, createReq *goVPSie.CreateBackupPolicyReq) error {
+	f.record("CreateBackupPolicy", createReq)
+	if f.CreateBackupPolicyFunc == nil {
+		return notStubbed("BackupsService", "CreateBackupPolicy")
+	}
+	return f.CreateBackupPolicyFunc(ctx, createReq)
+}
+
+func (f *BackupsService) DeleteBackupPolicy(ctx context.Context, policyId string, identifier string) error {
+	f.record("DeleteBackupPolicy", policyId, identifier)
+	if f.DeleteBackupPolicyFunc == nil {
+		return notStubbed("BackupsService", "DeleteBackupPolicy")
+	}
+	return f.DeleteBackupPolicyFunc(ctx, policyId, identifier)
+}
+
+func (f *BackupsService) ManageRetainBackupPolicy(ctx context.Context, policyId string, keep int) error {
+	f.record("ManageRetainBackupPolicy", policyId, keep)
+	if f.ManageRetainBackupPolicyFunc == nil {
+		return notStubbed("BackupsService", "ManageRetainBackupPolicy")
+	}
+	return f.ManageRetainBackupPolicyFunc(ctx, policyId, keep)
+}
+
+func (f *BackupsService) AttachBackupPolicy(ctx context.Context, policyId string, vms []string) error {
+	f.record("AttachBackupPolicy", policyId, vms)
+	if f.AttachBackupPolicyFunc == nil {
+		return notStubbed("BackupsService", "AttachBackupPolicy")
+	}
+	return f.AttachBackupPolicyFunc(ctx, policyId, vms)
+}
+
+func (f *BackupsService) DetachBackupPolicy(ctx context.Context, policyId string, vms []string) error {
+	f.record("DetachBackupPolicy", policyId, vms)
+	if f.DetachBackupPolicyFunc == nil {
+		return notStubbed("BackupsService", "DetachBackupPolicy")
+	}
+	return f.DetachBackupPolicyFunc(ctx, policyId, vms)
+}
+
+func (f *BackupsService) ListBackupPolicies(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.BackupPolicyListDetail, error) {
+	f.record("ListBackupPolicies", options)
+	if f.ListBackupPoliciesFunc == nil {
+		return zero[[]goVPSie.BackupPolicyListDetail](), notStubbed("BackupsService", "ListBackupPolicies")
+	}
+	return f.ListBackupPoliciesFunc(ctx, options)
+}
+
+func (f *BackupsService) BackupPoliciesAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.BackupPolicyListDetail, error] {
+	f.record("BackupPoliciesAll", opts)
+	if f.BackupPoliciesAllFunc == nil {
+		return failed[goVPSie.BackupPolicyListDetail](notStubbed("BackupsService", "BackupPoliciesAll"))
+	}
+	return f.BackupPoliciesAllFunc(ctx, opts...)
+}
+
+// IPsService is a fake goVPSie.IPsService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type IPsService struct {
+	recorder
+
+	ListPrivateIPsFunc func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.IP, error)
+	PrivateIPsAllFunc  func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.IP, error]
+	ListPublicIPsFunc  func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.IP, error)
+	PublicIPsAllFunc   func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.IP, error]
+	ListAllIPsFunc     func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.IP, error)
+	AllFunc            func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.IP, error]
+	DeleteIPFunc       func(ctx context.Context, ip string, vmIdentifier string) error
+	CreateIpsFunc      func(ctx context.Context, ipType string, vmIdentifier string) error
+}
+
+var _ goVPSie.IPsService = &IPsService{}
+
+func (f *IPsService) ListPrivateIPs(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.IP, error) {
+	f.record("ListPrivateIPs", options)
+	if f.ListPrivateIPsFunc == nil {
+		return zero[[]goVPSie.IP](), notStubbed("IPsService", "ListPrivateIPs")
+	}
+	return f.ListPrivateIPsFunc(ctx, options)
+}
+
+func (f *IPsService) PrivateIPsAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.IP, error] {
+	f.record("PrivateIPsAll", opts)
+	if f.PrivateIPsAllFunc == nil {
+		return failed[goVPSie.IP](notStubbed("IPsService", "PrivateIPsAll"))
+	}
+	return f.PrivateIPsAllFunc(ctx, opts...)
+}
+
+func (f *IPsService) ListPublicIPs(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.IP, error) {
+	f.record("ListPublicIPs", options)
+	if f.ListPublicIPsFunc == nil {
+		return zero[[]goVPSie.IP](), notStubbed("IPsService", "ListPublicIPs")
+	}
+	return f.ListPublicIPsFunc(ctx, options)
+}
+
+func (f *IPsService) PublicIPsAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.IP, error] {
+	f.record("PublicIPsAll", opts)
+	if f.PublicIPsAllFunc == nil {
+		return failed[goVPSie.IP](notStubbed("IPsService", "PublicIPsAll"))
+	}
+	return f.PublicIPsAllFunc(ctx, opts...)
+}
+
+func (f *IPsService) ListAllIPs(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.IP, error) {
+	f.record("ListAllIPs", options)
+	if f.ListAllIPsFunc == nil {
+		return zero[[]goVPSie.IP](), notStubbed("IPsService", "ListAllIPs")
+	}
+	return f.ListAllIPsFunc(ctx, options)
+}
+
+func (f *IPsService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.IP, error] {
+	f.record("All", opts)
+	if f.AllFunc == nil {
+		return failed[goVPSie.IP](notStubbed("IPsService", "All"))
+	}
+	return f.AllFunc(ctx, opts...)
+}
+
+func (f *IPsService) DeleteIP(ctx context.Context, ip string, vmIdentifier string) error {
+	f.record("DeleteIP", ip, vmIdentifier)
+	if f.DeleteIPFunc == nil {
+		return notStubbed("IPsService", "DeleteIP")
+	}
+	return f.DeleteIPFunc(ctx, ip, vmIdentifier)
+}
+
+func (f *IPsService) CreateIps(ctx context.Context, ipType string, vmIdentifier string) error {
+	f.record("CreateIps", ipType, vmIdentifier)
+	if f.CreateIpsFunc == nil {
+		return notStubbed("IPsService", "CreateIps")
+	}
+	return f.CreateIpsFunc(ctx, ipType, vmIdentifier)
+}
+
+// DomainService is a fake goVPSie.DomainService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type DomainService struct {
+	recorder
+
+	ListDomainByProjectFunc   func(ctx context.Context, options *goVPSie.ListOptions, projectIdentifier string) ([]goVPSie.Domain, error)
+	DomainsByProjectAllFunc   func(ctx context.Context, projectIdentifier string, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Domain, error]
+	DnsRecordFunc             func(ctx context.Context, domainIdentifier string, dnsRecord *goVPSie.DnsRecord) error
+	ListDomainsFunc           func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Domain, error)
+	DomainsAllFunc            func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Domain, error]
+	ListAllDomainsFunc        func(ctx context.Context) ([]goVPSie.Domain, error)
+	ListDomainVpsiesFunc      func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.DomainVpsie, error)
+	DomainVpsiesAllFunc       func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.DomainVpsie, error]
+	CreateDomainFunc          func(ctx context.Context, createReq *goVPSie.CreateDomainRequest) error
+	GetDomainByVpsieFunc      func(ctx context.Context, domainIdentifier string) ([]goVPSie.Domain, error)
+	UpdateReverseFunc         func(ctx context.Context, reverseReq *goVPSie.ReverseRequest) error
+	AddReverseFunc            func(ctx context.Context, reverseReq *goVPSie.ReverseRequest) error
+	UpdateDomainFunc          func(ctx context.Context, dnsRecord *goVPSie.DnsRecord, domainIdentifier string, vmIdentifier string) error
+	DeleteReverseFunc         func(ctx context.Context, ip string, vmIdentifier string) error
+	CreateDnsRecordFunc       func(ctx context.Context, createReq goVPSie.CreateDnsRecordReq) error
+	UpdateDnsRecordFunc       func(ctx context.Context, updateReq *goVPSie.UpdateDnsRecordReq) error
+	DeleteDomainFunc          func(ctx context.Context, domainIdentifier string, reason string, note string) error
+	DeleteDnsRecordFunc       func(ctx context.Context, domainIdentifier string, record *goVPSie.Record) error
+	ListReversePTRRecordsFunc func(ctx context.Context) ([]goVPSie.ReversePTR, error)
+}
+
+var _ goVPSie.DomainService = &DomainService{}
+
+func (f *DomainService) ListDomainByProject(ctx context.Context, options *goVPSie.ListOptions, projectIdentifier string) ([]goVPSie.Domain, error) {
+	f.record("ListDomainByProject", options, projectIdentifier)
+	if f.ListDomainByProjectFunc == nil {
+		return zero[[]goVPSie.Domain](), notStubbed("DomainService", "ListDomainByProject")
+	}
+	return f.ListDomainByProjectFunc(ctx, options, projectIdentifier)
+}
+
+func (f *DomainService) DomainsByProjectAll(ctx context.Context, projectIdentifier string, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Domain, error] {
+	f.record("DomainsByProjectAll", projectIdentifier, opts)
+	if f.DomainsByProjectAllFunc == nil {
+		return failed[goVPSie.Domain](notStubbed("DomainService", "DomainsByProjectAll"))
+	}
+	return f.DomainsByProjectAllFunc(ctx, projectIdentifier, opts...)
+}
+
+func (f *DomainService) DnsRecord(ctx context.Context, domainIdentifier string, dnsRecord *goVPSie.DnsRecord) error {
+	f.record("DnsRecord", domainIdentifier, dnsRecord)
+	if f.DnsRecordFunc == nil {
+		return notStubbed("DomainService", "DnsRecord")
+	}
+	return f.DnsRecordFunc(ctx, domainIdentifier, dnsRecord)
+}
+
+func (f *DomainService) ListDomains(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Domain, error) {
+	f.record("ListDomains", options)
+	if f.ListDomainsFunc == nil {
+		return zero[[]goVPSie.Domain](), notStubbed("DomainService", "ListDomains")
+	}
+	return f.ListDomainsFunc(ctx, options)
+}
+
+func (f *DomainService) DomainsAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Domain, error] {
+	f.record("DomainsAll", opts)
+	if f.DomainsAllFunc == nil {
+		return failed[goVPSie.Domain](notStubbed("DomainService", "DomainsAll"))
+	}
+	return f.DomainsAllFunc(ctx, opts...)
+}
+
+func (f *DomainService) ListAllDomains(ctx context.Context) ([]goVPSie.Domain, error) {
+	f.record("ListAllDomains")
+	if f.ListAllDomainsFunc == nil {
+		return zero[[]goVPSie.Domain](), notStubbed("DomainService", "ListAllDomains")
+	}
+	return f.ListAllDomainsFunc(ctx)
+}
+
+func (f *DomainService) ListDomainVpsies(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.DomainVpsie, error) {
+	f.record("ListDomainVpsies", options)
+	if f.ListDomainVpsiesFunc == nil {
+		return zero[[]goVPSie.DomainVpsie](), notStubbed("DomainService", "ListDomainVpsies")
+	}
+	return f.ListDomainVpsiesFunc(ctx, options)
+}
+
+func (f *DomainService) DomainVpsiesAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.DomainVpsie, error] {
+	f.record("DomainVpsiesAll", opts)
+	if f.DomainVpsiesAllFunc == nil {
+		return failed[goVPSie.DomainVpsie](notStubbed("DomainService", "DomainVpsiesAll"))
+	}
+	return f.DomainVpsiesAllFunc(ctx, opts...)
+}
+
+func (f *DomainService) CreateDomain(ctx context.Context, createReq *goVPSie.CreateDomainRequest) error {
+	f.record("CreateDomain", createReq)
+	if f.CreateDomainFunc == nil {
+		return notStubbed("DomainService", "CreateDomain")
+	}
+	return f.CreateDomainFunc(ctx, createReq)
+}
+
+func (f *DomainService) GetDomainByVpsie(ctx context.Context, domainIdentifier string) ([]goVPSie.Domain, error) {
+	f.record("GetDomainByVpsie", domainIdentifier)
+	if f.GetDomainByVpsieFunc == nil {
+		return zero[[]goVPSie.Domain](), notStubbed("DomainService", "GetDomainByVpsie")
+	}
+	return f.GetDomainByVpsieFunc(ctx, domainIdentifier)
+}
+
+func (f *DomainService) UpdateReverse(ctx context.Context, reverseReq *goVPSie.ReverseRequest) error {
+	f.record("UpdateReverse", reverseReq)
+	if f.UpdateReverseFunc == nil {
+		return notStubbed("DomainService", "UpdateReverse")
+	}
+	return f.UpdateReverseFunc(ctx, reverseReq)
+}
+
+func (f *DomainService) AddReverse(ctx context.Context, reverseReq *goVPSie.ReverseRequest) error {
+	f.record("AddReverse", reverseReq)
+	if f.AddReverseFunc == nil {
+		return notStubbed("DomainService", "AddReverse")
+	}
+	return f.AddReverseFunc(ctx, reverseReq)
+}
+
+func (f *DomainService) UpdateDomain(ctx context.Context, dnsRecord *goVPSie.DnsRecord, domainIdentifier string, vmIdentifier string) error {
+	f.record("UpdateDomain", dnsRecord, domainIdentifier, vmIdentifier)
+	if f.UpdateDomainFunc == nil {
+		return notStubbed("DomainService", "UpdateDomain")
+	}
+	return f.UpdateDomainFunc(ctx, dnsRecord, domainIdentifier, vmIdentifier)
+}
+
+func (f *DomainService) DeleteReverse(ctx context.Context, ip string, vmIdentifier string) error {
+	f.record("DeleteReverse", ip, vmIdentifier)
+	if f.DeleteReverseFunc == nil {
+		return notStubbed("DomainService", "DeleteReverse")
+	}
+	return f.DeleteReverseFunc(ctx, ip, vmIdentifier)
+}
+
+func (f *DomainService) CreateDnsRecord(ctx context.Context, createReq goVPSie.CreateDnsRecordReq) error {
+	f.record("CreateDnsRecord", createReq)
+	if f.CreateDnsRecordFunc == nil {
+		return notStubbed("DomainService", "CreateDnsRecord")
+	}
+	return f.CreateDnsRecordFunc(ctx, createReq)
+}
+
+func (f *DomainService) UpdateDnsRecord(ctx context.Context, updateReq *goVPSie.UpdateDnsRecordReq) error {
+	f.record("UpdateDnsRecord", updateReq)
+	if f.UpdateDnsRecordFunc == nil {
+		return notStubbed("DomainService", "UpdateDnsRecord")
+	}
+	return f.UpdateDnsRecordFunc(ctx, updateReq)
+}
+
+func (f *DomainService) DeleteDomain(ctx context.Context, domainIdentifier string, reason string, note string) error {
+	f.record("DeleteDomain", domainIdentifier, reason, note)
+	if f.DeleteDomainFunc == nil {
+		return notStubbed("DomainService", "DeleteDomain")
+	}
+	return f.DeleteDomainFunc(ctx, domainIdentifier, reason, note)
+}
+
+func (f *DomainService) DeleteDnsRecord(ctx context.Context, domainIdentifier string, record *goVPSie.Record) error {
+	f.record("DeleteDnsRecord", domainIdentifier, record)
+	if f.DeleteDnsRecordFunc == nil {
+		return notStubbed("DomainService", "DeleteDnsRecord")
+	}
+	return f.DeleteDnsRecordFunc(ctx, domainIdentifier, record)
+}
+
+func (f *DomainService) ListReversePTRRecords(ctx context.Context) ([]goVPSie.ReversePTR, error) {
+	f.record("ListReversePTRRecords")
+	if f.ListReversePTRRecordsFunc == nil {
+		return zero[[]goVPSie.ReversePTR](), notStubbed("DomainService", "ListReversePTRRecords")
+	}
+	return f.ListReversePTRRecordsFunc(ctx)
+}
+
+// FipService is a fake goVPSie.FipService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type FipService struct {
+	recorder
+
+	AssignFloatingIPFunc   func(ctx context.Context) error
+	UnassignFloatingIPFunc func(ctx context.Context, id string) error
+	CreateFloatingIPFunc   func(ctx context.Context, vmIdentifier string, dcIdentifier string, ipType string) error
+}
+
+var _ goVPSie.FipService = &FipService{}
+
+func (f *FipService) AssignFloatingIP(ctx context.Context) error {
+	f.record("AssignFloatingIP")
+	if f.AssignFloatingIPFunc == nil {
+		return notStubbed("FipService", "AssignFloatingIP")
+	}
+	return f.AssignFloatingIPFunc(ctx)
+}
+
+func (f *FipService) UnassignFloatingIP(ctx context.Context, id string) error {
+	f.record("UnassignFloatingIP", id)
+	if f.UnassignFloatingIPFunc == nil {
+		return notStubbed("FipService", "UnassignFloatingIP")
+	}
+	return f.UnassignFloatingIPFunc(ctx, id)
+}
+
+func (f *FipService) CreateFloatingIP(ctx context.Context, vmIdentifier string, dcIdentifier string, ipType string) error {
+	f.record("CreateFloatingIP", vmIdentifier, dcIdentifier, ipType)
+	if f.CreateFloatingIPFunc == nil {
+		return notStubbed("FipService", "CreateFloatingIP")
+	}
+	return f.CreateFloatingIPFunc(ctx, vmIdentifier, dcIdentifier, ipType)
+}
+
+// FirewallGroupService is a fake goVPSie.FirewallGroupService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type FirewallGroupService struct {
+	recorder
+
+	CreateFunc                      func(ctx context.Context, groupName string, firewallUpdateReq []goVPSie.FirewallUpdateReq) error
+	ListFunc                        func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.FirewallGroupListData, error)
+	AllFunc                         func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.FirewallGroupListData, error]
+	GetFunc                         func(ctx context.Context, fwGroupId string) (*goVPSie.FirewallGroupDetailData, error)
+	DeleteFunc                      func(ctx context.Context, fwGroupId string) error
+	UpdateFunc                      func(ctx context.Context, fwGroupReq *goVPSie.FirewallUpdateReq, fwGroupId string) error
+	AssignToVpsieFunc               func(ctx context.Context, groupId string, vmId string) error
+	DetachFromVpsieFunc             func(ctx context.Context, groupId string, vmId string) error
+	AttachToVpsieFunc               func(ctx context.Context, groupId string, vmId string) error
+	DeleteFirewallGroupOfServerFunc func(ctx context.Context, groupId string, vmId string) error
+	GetFirewallGroupFunc            func(ctx context.Context, fwGroupId string) (*goVPSie.FirewallGroupDetailData, error)
+}
+
+var _ goVPSie.FirewallGroupService = &FirewallGroupService{}
+
+func (f *FirewallGroupService) Create(ctx context.Context, groupName string, firewallUpdateReq []goVPSie.FirewallUpdateReq) error {
+	f.record("Create", groupName, firewallUpdateReq)
+	if f.CreateFunc == nil {
+		return notStubbed("FirewallGroupService", "Create")
+	}
+	return f.CreateFunc(ctx, groupName, firewallUpdateReq)
+}
+
+func (f *FirewallGroupService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.FirewallGroupListData, error) {
+	f.record("List", options)
+	if f.ListFunc == nil {
+		return zero[[]goVPSie.FirewallGroupListData](), notStubbed("FirewallGroupService", "List")
+	}
+	return f.ListFunc(ctx, options)
+}
+
+func (f *FirewallGroupService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.FirewallGroupListData, error] {
+	f.record("All", opts)
+	if f.AllFunc == nil {
+		return failed[goVPSie.FirewallGroupListData](notStubbed("FirewallGroupService", "All"))
+	}
+	return f.AllFunc(ctx, opts...)
+}
+
+func (f *FirewallGroupService) Get(ctx context.Context, fwGroupId string) (*goVPSie.FirewallGroupDetailData, error) {
+	f.record("Get", fwGroupId)
+	if f.GetFunc == nil {
+		return zero[*goVPSie.FirewallGroupDetailData](), notStubbed("FirewallGroupService", "Get")
+	}
+	return f.GetFunc(ctx, fwGroupId)
+}
+
+func (f *FirewallGroupService) Delete(ctx context.Context, fwGroupId string) error {
+	f.record("Delete", fwGroupId)
+	if f.DeleteFunc == nil {
+		return notStubbed("FirewallGroupService", "Delete")
+	}
+	return f.DeleteFunc(ctx, fwGroupId)
+}
+
+func (f *FirewallGroupService) Update(ctx context.Context, fwGroupReq *goVPSie.FirewallUpdateReq, fwGroupId string) error {
+	f.record("Update", fwGroupReq, fwGroupId)
+	if f.UpdateFunc == nil {
+		return notStubbed("FirewallGroupService", "Update")
+	}
+	return f.UpdateFunc(ctx, fwGroupReq, fwGroupId)
+}
+
+func (f *FirewallGroupService) AssignToVpsie(ctx context.Context, groupId string, vmId string) error {
+	f.record("AssignToVpsie", groupId, vmId)
+	if f.AssignToVpsieFunc == nil {
+		return notStubbed("FirewallGroupService", "AssignToVpsie")
+	}
+	return f.AssignToVpsieFunc(ctx, groupId, vmId)
+}
+
+func (f *FirewallGroupService) DetachFromVpsie(ctx context.Context, groupId string, vmId string) error {
+	f.record("DetachFromVpsie", groupId, vmId)
+	if f.DetachFromVpsieFunc == nil {
+		return notStubbed("FirewallGroupService", "DetachFromVpsie")
+	}
+	return f.DetachFromVpsieFunc(ctx, groupId, vmId)
+}
+
+func (f *FirewallGroupService) AttachToVpsie(ctx context.Context, groupId string, vmId string) error {
+	f.record("AttachToVpsie", groupId, vmId)
+	if f.AttachToVpsieFunc == nil {
+		return notStubbed("FirewallGroupService", "AttachToVpsie")
+	}
+	return f.AttachToVpsieFunc(ctx, groupId, vmId)
+}
+
+func (f *FirewallGroupService) DeleteFirewallGroupOfServer(ctx context.Context, groupId string, vmId string) error {
+	f.record("DeleteFirewallGroupOfServer", groupId, vmId)
+	if f.DeleteFirewallGroupOfServerFunc == nil {
+		return notStubbed("FirewallGroupService", "DeleteFirewallGroupOfServer")
+	}
+	return f.DeleteFirewallGroupOfServerFunc(ctx, groupId, vmId)
+}
+
+func (f *FirewallGroupService) GetFirewallGroup(ctx context.Context, fwGroupId string) (*goVPSie.FirewallGroupDetailData, error) {
+	f.record("GetFirewallGroup", fwGroupId)
+	if f.GetFirewallGroupFunc == nil {
+		return zero[*goVPSie.FirewallGroupDetailData](), notStubbed("FirewallGroupService", "GetFirewallGroup")
+	}
+	return f.GetFirewallGroupFunc(ctx, fwGroupId)
+}
+
+// FirewallService is a fake goVPSie.FirewallService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type FirewallService struct {
+	recorder
+
+	ListMacrosFunc    func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Macros, error)
+	MacrosAllFunc     func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Macros, error]
+	RemoveGroupVmFunc func(ctx context.Context, vmId string, groupId string) error
+}
+
+var _ goVPSie.FirewallService = &FirewallService{}
+
+func (f *FirewallService) ListMacros(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Macros, error) {
+	f.record("ListMacros", options)
+	if f.ListMacrosFunc == nil {
+		return zero[[]goVPSie.Macros](), notStubbed("FirewallService", "ListMacros")
+	}
+	return f.ListMacrosFunc(ctx, options)
+}
+
+func (f *FirewallService) MacrosAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Macros, error] {
+	f.record("MacrosAll", opts)
+	if f.MacrosAllFunc == nil {
+		return failed[goVPSie.Macros](notStubbed("FirewallService", "MacrosAll"))
+	}
+	return f.MacrosAllFunc(ctx, opts...)
+}
+
+func (f *FirewallService) RemoveGroupVm(ctx context.Context, vmId string, groupId string) error {
+	f.record("RemoveGroupVm", vmId, groupId)
+	if f.RemoveGroupVmFunc == nil {
+		return notStubbed("FirewallService", "RemoveGroupVm")
+	}
+	return f.RemoveGroupVmFunc(ctx, vmId, groupId)
+}
+
+// StorageService is a fake goVPSie.StorageService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type StorageService struct {
+	recorder
+
+	ListFunc                   func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Storage, error)
+	AllFunc                    func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Storage, error]
+	DeleteFunc                 func(ctx context.Context, storageIdentifier string) error
+	AttachToServerFunc         func(ctx context.Context, storageIdentifier string, vmIdentifier string, vmType string) error
+	DetachToServerFunc         func(ctx context.Context, storageIdentifier string, vmIdentifier string, vmType string) error
+	CreateContainerFunc        func(ctx context.Context, dcIdentifier string) error
+	ListAllFunc                func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Storage, error)
+	CreateFunc                 func(ctx context.Context, createReq *goVPSie.StorageCreateRequest, vmIdentifier string, vmType string) error
+	ListVmsToAttachFunc        func(ctx context.Context) ([]goVPSie.VmToAttach, error)
+	CreateVolumeFunc           func(ctx context.Context, creatReq *goVPSie.StorageCreateRequest) error
+	CreateVolumeWithResultFunc func(ctx context.Context, creatReq *goVPSie.StorageCreateRequest) (*goVPSie.CreateResult, error)
+	CreateStorageFunc          func(ctx context.Context, createReq *goVPSie.StorageCreateRequest) error
+	DetachAllFromServerFunc    func(ctx context.Context, vmIdentifier string, vmType string) error
+	UpdateSizeFunc             func(ctx context.Context, storageIdentifier string, size string) error
+	UpdateNameFunc             func(ctx context.Context, storageIdentifier string, name string) error
+	CreateSnapshotFunc         func(ctx context.Context, storageIdentifier string, name string, storageType string) error
+	ListSnapshotsFunc          func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.StorageSnapShot, error)
+	SnapshotsAllFunc           func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.StorageSnapShot, error]
+	UpdateSnapshotNameFunc     func(ctx context.Context, snapshotIdentifier string, name string) error
+	RollbackSnapshotFunc       func(ctx context.Context, snapshotIdentifier string, snapType string) error
+	CloneSnapshotFunc          func(ctx context.Context, snapshotIdentifier string, snapType string) error
+	DeleteSnapshotFunc         func(ctx context.Context, snapshotIdentifier string) error
+	DeleteAllSnapshotsFunc     func(ctx context.Context, storageIdentifier string) error
+	GetFunc                    func(ctx context.Context, identifier string) (*goVPSie.StorageDetail, error)
+	ListStorageDataCenterFunc  func(ctx context.Context) ([]goVPSie.DataCenter, error)
+}
+
+var _ goVPSie.StorageService = &StorageService{}
+
+func (f *StorageService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Storage, error) {
+	f.record("List", options)
+	if f.ListFunc == nil {
+		return zero[[]goVPSie.Storage](), notStubbed("StorageService", "List")
+	}
+	return f.ListFunc(ctx, options)
+}
+
+func (f *StorageService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Storage, error] {
+	f.record("All", opts)
+	if f.AllFunc == nil {
+		return failed[goVPSie.Storage](notStubbed("StorageService", "All"))
+	}
+	return f.AllFunc(ctx, opts...)
+}
+
+func (f *StorageService) Delete(ctx context.Context, storageIdentifier string) error {
+	f.record("Delete", storageIdentifier)
+	if f.DeleteFunc == nil {
+		return notStubbed("StorageService", "Delete")
+	}
+	return f.DeleteFunc(ctx, storageIdentifier)
+}
+
+func (f *StorageService) AttachToServer(ctx context.Context, storageIdentifier string, vmIdentifier string, vmType string) error {
+	f.record("AttachToServer", storageIdentifier, vmIdentifier, vmType)
+	if f.AttachToServerFunc == nil {
+		return notStubbed("StorageService", "AttachToServer")
+	}
+	return f.AttachToServerFunc(ctx, storageIdentifier, vmIdentifier, vmType)
+}
+
+func (f *StorageService) DetachToServer(ctx context.Context, storageIdentifier string, vmIdentifier string, vmType string) error {
+	f.record("DetachToServer", storageIdentifier, vmIdentifier, vmType)
+	if f.DetachToServerFunc == nil {
+		return notStubbed("StorageService", "DetachToServer")
+	}
+	return f.DetachToServerFunc(ctx, storageIdentifier, vmIdentifier, vmType)
+}
+
+func (f *StorageService) CreateContainer(ctx context.Context, dcIdentifier string) error {
+	f.record("CreateContainer", dcIdentifier)
+	if f.CreateContainerFunc == nil {
+		return notStubbed("StorageService", "CreateContainer")
+	}
+	return f.CreateContainerFunc(ctx, dcIdentifier)
+}
+
+func (f *StorageService) ListAll(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Storage, error) {
+	f.record("ListAll", options)
+	if f.ListAllFunc == nil {
+		return zero[[]goVPSie.Storage](), notStubbed("StorageService", "ListAll")
+	}
+	return f.ListAllFunc(ctx, options)
+}
+
+func (f *StorageService) Create(ctx context.Context, createReq *goVPSie.StorageCreateRequest, vmIdentifier string, vmType string) error {
+	f.record("Create", createReq, vmIdentifier, vmType)
+	if f.CreateFunc == nil {
+		return notStubbed("StorageService", "Create")
+	}
+	return f.CreateFunc(ctx, createReq, vmIdentifier, vmType)
+}
+
+func (f *StorageService) ListVmsToAttach(ctx context.Context) ([]goVPSie.VmToAttach, error) {
+	f.record("ListVmsToAttach")
+	if f.ListVmsToAttachFunc == nil {
+		return zero[[]goVPSie.VmToAttach](), notStubbed("StorageService", "ListVmsToAttach")
+	}
+	return f.ListVmsToAttachFunc(ctx)
+}
+
+func (f *StorageService) CreateVolume(ctx context.Context, creatReq *goVPSie.StorageCreateRequest) error {
+	f.record("CreateVolume", creatReq)
+	if f.CreateVolumeFunc == nil {
+		return notStubbed("StorageService", "CreateVolume")
+	}
+	return f.CreateVolumeFunc(ctx, creatReq)
+}
+
+func (f *StorageService) CreateVolumeWithResult(ctx context.Context, creatReq *goVPSie.StorageCreateRequest) (*goVPSie.CreateResult, error) {
+	f.record("CreateVolumeWithResult", creatReq)
+	if f.CreateVolumeWithResultFunc == nil {
+		return zero[*goVPSie.CreateResult](), notStubbed("StorageService", "CreateVolumeWithResult")
+	}
+	return f.CreateVolumeWithResultFunc(ctx, creatReq)
+}
+
+func (f *StorageService) CreateStorage(ctx context.Context, createReq *goVPSie.StorageCreateRequest) error {
+	f.record("CreateStorage", createReq)
+	if f.CreateStorageFunc == nil {
+		return notStubbed("StorageService", "CreateStorage")
+	}
+	return f.CreateStorageFunc(ctx, createReq)
+}
+
+func (f *StorageService) DetachAllFromServer(ctx context.Context, vmIdentifier string, vmType string) error {
+	f.record("DetachAllFromServer", vmIdentifier, vmType)
+	if f.DetachAllFromServerFunc == nil {
+		return notStubbed("StorageService", "DetachAllFromServer")
+	}
+	return f.DetachAllFromServerFunc(ctx, vmIdentifier, vmType)
+}
+
+func (f *StorageService) UpdateSize(ctx context.Context, storageIdentifier string, size string) error {
+	f.record("UpdateSize", storageIdentifier, size)
+	if f.UpdateSizeFunc == nil {
+		return notStubbed("StorageService", "UpdateSize")
+	}
+	return f.UpdateSizeFunc(ctx, storageIdentifier, size)
+}
+
+func (f *StorageService) UpdateName(ctx context.Context, storageIdentifier string, name string) error {
+	f.record("UpdateName", storageIdentifier, name)
+	if f.UpdateNameFunc == nil {
+		return notStubbed("StorageService", "UpdateName")
+	}
+	return f.UpdateNameFunc(ctx, storageIdentifier, name)
+}
+
+func (f *StorageService) CreateSnapshot(ctx context.Context, storageIdentifier string, name string, storageType string) error {
+	f.record("CreateSnapshot", storageIdentifier, name, storageType)
+	if f.CreateSnapshotFunc == nil {
+		return notStubbed("StorageService", "CreateSnapshot")
+	}
+	return f.CreateSnapshotFunc(ctx, storageIdentifier, name, storageType)
+}
+
+func (f *StorageService) ListSnapshots(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.StorageSnapShot, error) {
+	f.record("ListSnapshots", options)
+	if f.ListSnapshotsFunc == nil {
+		return zero[[]goVPSie.StorageSnapShot](), notStubbed("StorageService", "ListSnapshots")
+	}
+	return f.ListSnapshotsFunc(ctx, options)
+}
+
+func (f *StorageService) SnapshotsAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.StorageSnapShot, error] {
+	f.record("SnapshotsAll", opts)
+	if f.SnapshotsAllFunc == nil {
+		return failed[goVPSie.StorageSnapShot](notStubbed("StorageService", "SnapshotsAll"))
+	}
+	return f.SnapshotsAllFunc(ctx, opts...)
+}
+
+func (f *StorageService) UpdateSnapshotName(ctx context.Context, snapshotIdentifier string, name string) error {
+	f.record("UpdateSnapshotName", snapshotIdentifier, name)
+	if f.UpdateSnapshotNameFunc == nil {
+		return notStubbed("StorageService", "UpdateSnapshotName")
+	}
+	return f.UpdateSnapshotNameFunc(ctx, snapshotIdentifier, name)
+}
+
+func (f *StorageService) RollbackSnapshot(ctx context.Context, snapshotIdentifier string, snapType string) error {
+	f.record("RollbackSnapshot", snapshotIdentifier, snapType)
+	if f.RollbackSnapshotFunc == nil {
+		return notStubbed("StorageService", "RollbackSnapshot")
+	}
+	return f.RollbackSnapshotFunc(ctx, snapshotIdentifier, snapType)
+}
+
+func (f *StorageService) CloneSnapshot(ctx context.Context, snapshotIdentifier string, snapType string) error {
+	f.record("CloneSnapshot", snapshotIdentifier, snapType)
+	if f.CloneSnapshotFunc == nil {
+		return notStubbed("StorageService", "CloneSnapshot")
+	}
+	return f.CloneSnapshotFunc(ctx, snapshotIdentifier, snapType)
+}
+
+func (f *StorageService) DeleteSnapshot(ctx context.Context, snapshotIdentifier string) error {
+	f.record("DeleteSnapshot", snapshotIdentifier)
+	if f.DeleteSnapshotFunc == nil {
+		return notStubbed("StorageService", "DeleteSnapshot")
+	}
+	return f.DeleteSnapshotFunc(ctx, snapshotIdentifier)
+}
+
+func (f *StorageService) DeleteAllSnapshots(ctx context.Context, storageIdentifier string) error {
+	f.record("DeleteAllSnapshots", storageIdentifier)
+	if f.DeleteAllSnapshotsFunc == nil {
+		return notStubbed("StorageService", "DeleteAllSnapshots")
+	}
+	return f.DeleteAllSnapshotsFunc(ctx, storageIdentifier)
+}
+
+func (f *StorageService) Get(ctx context.Context, identifier string) (*goVPSie.StorageDetail, error) {
+	f.record("Get", identifier)
+	if f.GetFunc == nil {
+		return zero[*goVPSie.StorageDetail](), notStubbed("StorageService", "Get")
+	}
+	return f.GetFunc(ctx, identifier)
+}
+
+func (f *StorageService) ListStorageDataCenter(ctx context.Context) ([]goVPSie.DataCenter, error) {
+	f.record("ListStorageDataCenter")
+	if f.ListStorageDataCenterFunc == nil {
+		return zero[[]goVPSie.DataCenter](), notStubbed("StorageService", "ListStorageDataCenter")
+	}
+	return f.ListStorageDataCenterFunc(ctx)
+}
+
+// SnapshotService is a fake goVPSie.SnapshotService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type SnapshotService struct {
+	recorder
+
+	ListFunc                       func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Snapshot, error)
+	AllFunc                        func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Snapshot, error]
+	CreateFunc                     func(ctx context.Context, name string, vmIdentifier string, note string) error
+	ListByVmFunc                   func(ctx context.Context, options *goVPSie.ListOptions, vmIdentifier string) ([]goVPSie.Snapshot, error)
+	AllByVmFunc                    func(ctx context.Context, vmIdentifier string, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Snapshot, error]
+	RollbackFunc                   func(ctx context.Context, snapshotIdentifier string) error
+	EnableAutoFunc                 func(ctx context.Context, enableReq *goVPSie.EnableAutoSnapshotReq) error
+	DeleteFunc                     func(ctx context.Context, snapshotIdentifier string, reason string, note string) error
+	DeleteManyFunc                 func(ctx context.Context, snapshotIdentifiers []string, reason string, note string, opts *goVPSie.BulkOptions) ([]goVPSie.BulkResult, error)
+	UpdateFunc                     func(ctx context.Context, snapshotIdentifier string, newNote string) error
+	GetFunc                        func(ctx context.Context, buckupIdentifier string) (*goVPSie.Snapshot, error)
+	GetSnapShotPolicyFunc          func(ctx context.Context, identifier string) (*goVPSie.SnapShotPolicy, error)
+	CreateSnapShotPolicyFunc       func(ctx context.Context, createReq *goVPSie.CreateSnapShotPolicyReq) error
+	DeleteSnapShotPolicyFunc       func(ctx context.Context, policyId string, identifier string) error
+	ManageRetainSnapShotPolicyFunc func(ctx context.Context, policyId string, keep int64) error
+	AttachSnapShotPolicyFunc       func(ctx context.Context, policyId string, vms []string) error
+	DetachSnapShotPolicyFunc       func(ctx context.Context, policyId string, vms []string) error
+	ListSnapShotPoliciesFunc       func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.SnapShotPolicyListDetail, error)
+	SnapShotPoliciesAllFunc        func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.SnapShotPolicyListDetail, error]
+}
+
+var _ goVPSie.SnapshotService = &SnapshotService{}
+
+func (f *SnapshotService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Snapshot, error) {
+	f.record("List", options)
+	if f.ListFunc == nil {
+		return zero[[]goVPSie.Snapshot](), notStubbed("SnapshotService", "List")
+	}
+	return f.ListFunc(ctx, options)
+}
+
+func (f *SnapshotService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Snapshot, error] {
+	f.record("All", opts)
+	if f.AllFunc == nil {
+		return failed[goVPSie.Snapshot](notStubbed("SnapshotService", "All"))
+	}
+	return f.AllFunc(ctx, opts...)
+}
+
+func (f *SnapshotService) Create(ctx context.Context, name string, vmIdentifier string, note string) error {
+	f.record("Create", name, vmIdentifier, note)
+	if f.CreateFunc == nil {
+		return notStubbed("SnapshotService", "Create")
+	}
+	return f.CreateFunc(ctx, name, vmIdentifier, note)
+}
+
+func (f *SnapshotService) ListByVm(ctx context.Context, options *goVPSie.ListOptions, vmIdentifier string) ([]goVPSie.Snapshot, error) {
+	f.record("ListByVm", options, vmIdentifier)
+	if f.ListByVmFunc == nil {
+		return zero[[]goVPSie.Snapshot](), notStubbed("SnapshotService", "ListByVm")
+	}
+	return f.ListByVmFunc(ctx, options, vmIdentifier)
+}
+
+func (f *SnapshotService) AllByVm(ctx context.Context, vmIdentifier string, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Snapshot, error] {
+	f.record("AllByVm", vmIdentifier, opts)
+	if f.AllByVmFunc == nil {
+		return failed[goVPSie.Snapshot](notStubbed("SnapshotService", "AllByVm"))
+	}
+	return f.AllByVmFunc(ctx, vmIdentifier, opts...)
+}
+
+func (f *SnapshotService) Rollback(ctx context.Context, snapshotIdentifier string) error {
+	f.record("Rollback", snapshotIdentifier)
+	if f.RollbackFunc == nil {
+		return notStubbed("SnapshotService", "Rollback")
+	}
+	return f.RollbackFunc(ctx, snapshotIdentifier)
+}
+
+func (f *SnapshotService) EnableAuto(ctx context.Context, enableReq *goVPSie.EnableAutoSnapshotReq) error {
+	f.record("EnableAuto", enableReq)
+	if f.EnableAutoFunc == nil {
+		return notStubbed("SnapshotService", "EnableAuto")
+	}
+	return f.EnableAutoFunc(ctx, enableReq)
+}
+
+func (f *SnapshotService) Delete(ctx context.Context, snapshotIdentifier string, reason string, note string) error {
+	f.record("Delete", snapshotIdentifier, reason, note)
+	if f.DeleteFunc == nil {
+		return notStubbed("SnapshotService", "Delete")
+	}
+	return f.DeleteFunc(ctx, snapshotIdentifier, reason, note)
+}
+
+func (f *SnapshotService) DeleteMany(ctx context.Context, snapshotIdentifiers []string, reason string, note string, opts *goVPSie.BulkOptions) ([]goVPSie.BulkResult, error) {
+	f.record("DeleteMany", snapshotIdentifiers, reason, note, opts)
+	if f.DeleteManyFunc == nil {
+		return zero[[]goVPSie.BulkResult](), notStubbed("SnapshotService", "DeleteMany")
+	}
+	return f.DeleteManyFunc(ctx, snapshotIdentifiers, reason, note, opts)
+}
+
+func (f *SnapshotService) Update(ctx context.Context, snapshotIdentifier string, newNote string) error {
+	f.record("Update", snapshotIdentifier, newNote)
+	if f.UpdateFunc == nil {
+		return notStubbed("SnapshotService", "Update")
+	}
+	return f.UpdateFunc(ctx, snapshotIdentifier, newNote)
+}
+
+func (f *SnapshotService) Get(ctx context.Context, buckupIdentifier string) (*goVPSie.Snapshot, error) {
+	f.record("Get", buckupIdentifier)
+	if f.GetFunc == nil {
+		return zero[*goVPSie.Snapshot](), notStubbed("SnapshotService", "Get")
+	}
+	return f.GetFunc(ctx, buckupIdentifier)
+}
+
+func (f *SnapshotService) GetSnapShotPolicy(ctx context.Context, identifier string) (*goVPSie.SnapShotPolicy, error) {
+	f.record("GetSnapShotPolicy", identifier)
+	if f.GetSnapShotPolicyFunc == nil {
+		return zero[*goVPSie.SnapShotPolicy](), notStubbed("SnapshotService", "GetSnapShotPolicy")
+	}
+	return f.GetSnapShotPolicyFunc(ctx, identifier)
+}
+
+func (f *SnapshotService) CreateSnapShotPolicy(ctx context.Context, createReq *goVPSie.CreateSnapShotPolicyReq) error {
+	f.record("CreateSnapShotPolicy", createReq)
+	if f.CreateSnapShotPolicyFunc == nil {
+		return notStubbed("SnapshotService", "CreateSnapShotPolicy")
+	}
+	return f.CreateSnapShotPolicyFunc(ctx, createReq)
+}
+
+func (f *SnapshotService) DeleteSnapShotPolicy(ctx context.Context, policyId string, identifier string) error {
+	f.record("DeleteSnapShotPolicy", policyId, identifier)
+	if f.DeleteSnapShotPolicyFunc == nil {
+		return notStubbed("SnapshotService", "DeleteSnapShotPolicy")
+	}
+	return f.DeleteSnapShotPolicyFunc(ctx, policyId, identifier)
+}
+
+func (f *SnapshotService) ManageRetainSnapShotPolicy(ctx context.Context, policyId string, keep int64) error {
+	f.record("ManageRetainSnapShotPolicy", policyId, keep)
+	if f.ManageRetainSnapShotPolicyFunc == nil {
+		return notStubbed("SnapshotService", "ManageRetainSnapShotPolicy")
+	}
+	return f.ManageRetainSnapShotPolicyFunc(ctx, policyId, keep)
+}
+
+func (f *SnapshotService) AttachSnapShotPolicy(ctx context.Context, policyId string, vms []string) error {
+	f.record("AttachSnapShotPolicy", policyId, vms)
+	if f.AttachSnapShotPolicyFunc == nil {
+		return notStubbed("SnapshotService", "AttachSnapShotPolicy")
+	}
+	return f.AttachSnapShotPolicyFunc(ctx, policyId, vms)
+}
+
+func (f *SnapshotService) DetachSnapShotPolicy(ctx context.Context, policyId string, vms []string) error {
+	f.record("DetachSnapShotPolicy", policyId, vms)
+	if f.DetachSnapShotPolicyFunc == nil {
+		return notStubbed("SnapshotService", "DetachSnapShotPolicy")
+	}
+	return f.DetachSnapShotPolicyFunc(ctx, policyId, vms)
+}
+
+func (f *SnapshotService) ListSnapShotPolicies(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.SnapShotPolicyListDetail, error) {
+	f.record("ListSnapShotPolicies", options)
+	if f.ListSnapShotPoliciesFunc == nil {
+		return zero[[]goVPSie.SnapShotPolicyListDetail](), notStubbed("SnapshotService", "ListSnapShotPolicies")
+	}
+	return f.ListSnapShotPoliciesFunc(ctx, options)
+}
+
+func (f *SnapshotService) SnapShotPoliciesAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.SnapShotPolicyListDetail, error] {
+	f.record("SnapShotPoliciesAll", opts)
+	if f.SnapShotPoliciesAllFunc == nil {
+		return failed[goVPSie.SnapShotPolicyListDetail](notStubbed("SnapshotService", "SnapShotPoliciesAll"))
+	}
+	return f.SnapShotPoliciesAllFunc(ctx, opts...)
+}
+
+// LogsService is a fake goVPSie.LogsService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type LogsService struct {
+	recorder
+
+	ListActivityLogsFunc func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.ActivityLog, error)
+	ActivityLogsAllFunc  func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.ActivityLog, error]
+	ListBillingLogsFunc  func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.BillingLog, error)
+	BillingLogsAllFunc   func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.BillingLog, error]
+	ListAuditLogsFunc    func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.AuditLog, error)
+	AuditLogsAllFunc     func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.AuditLog, error]
+	ListVPSieLogsFunc    func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.VmLog, error)
+	VPSieLogsAllFunc     func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.VmLog, error]
+}
+
+var _ goVPSie.LogsService = &LogsService{}
+
+func (f *LogsService) ListActivityLogs(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.ActivityLog, error) {
+	f.record("ListActivityLogs", options)
+	if f.ListActivityLogsFunc == nil {
+		return zero[[]goVPSie.ActivityLog](), notStubbed("LogsService", "ListActivityLogs")
+	}
+	return f.ListActivityLogsFunc(ctx, options)
+}
+
+func (f *LogsService) ActivityLogsAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.ActivityLog, error] {
+	f.record("ActivityLogsAll", opts)
+	if f.ActivityLogsAllFunc == nil {
+		return failed[goVPSie.ActivityLog](notStubbed("LogsService", "ActivityLogsAll"))
+	}
+	return f.ActivityLogsAllFunc(ctx, opts...)
+}
+
+func (f *LogsService) ListBillingLogs(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.BillingLog, error) {
+	f.record("ListBillingLogs", options)
+	if f.ListBillingLogsFunc == nil {
+		return zero[[]goVPSie.BillingLog](), notStubbed("LogsService", "ListBillingLogs")
+	}
+	return f.ListBillingLogsFunc(ctx, options)
+}
+
+func (f *LogsService) BillingLogsAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.BillingLog, error] {
+	f.record("BillingLogsAll", opts)
+	if f.BillingLogsAllFunc == nil {
+		return failed[goVPSie.BillingLog](notStubbed("LogsService", "BillingLogsAll"))
+	}
+	return f.BillingLogsAllFunc(ctx, opts...)
+}
+
+func (f *LogsService) ListAuditLogs(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.AuditLog, error) {
+	f.record("ListAuditLogs", options)
+	if f.ListAuditLogsFunc == nil {
+		return zero[[]goVPSie.AuditLog](), notStubbed("LogsService", "ListAuditLogs")
+	}
+	return f.ListAuditLogsFunc(ctx, options)
+}
+
+func (f *LogsService) AuditLogsAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.AuditLog, error] {
+	f.record("AuditLogsAll", opts)
+	if f.AuditLogsAllFunc == nil {
+		return failed[goVPSie.AuditLog](notStubbed("LogsService", "AuditLogsAll"))
+	}
+	return f.AuditLogsAllFunc(ctx, opts...)
+}
+
+func (f *LogsService) ListVPSieLogs(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.VmLog, error) {
+	f.record("ListVPSieLogs", options)
+	if f.ListVPSieLogsFunc == nil {
+		return zero[[]goVPSie.VmLog](), notStubbed("LogsService", "ListVPSieLogs")
+	}
+	return f.ListVPSieLogsFunc(ctx, options)
+}
+
+func (f *LogsService) VPSieLogsAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.VmLog, error] {
+	f.record("VPSieLogsAll", opts)
+	if f.VPSieLogsAllFunc == nil {
+		return failed[goVPSie.VmLog](notStubbed("LogsService", "VPSieLogsAll"))
+	}
+	return f.VPSieLogsAllFunc(ctx, opts...)
+}
+
+// DataCenterService is a fake goVPSie.DataCenterService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type DataCenterService struct {
+	recorder
+
+	ListFunc func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.DataCenter, error)
+	AllFunc  func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.DataCenter, error]
+}
+
+var _ goVPSie.DataCenterService = &DataCenterService{}
+
+func (f *DataCenterService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.DataCenter, error) {
+	f.record("List", options)
+	if f.ListFunc == nil {
+		return zero[[]goVPSie.DataCenter](), notStubbed("DataCenterService", "List")
+	}
+	return f.ListFunc(ctx, options)
+}
+
+func (f *DataCenterService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.DataCenter, error] {
+	f.record("All", opts)
+	if f.AllFunc == nil {
+		return failed[goVPSie.DataCenter](notStubbed("DataCenterService", "All"))
+	}
+	return f.AllFunc(ctx, opts...)
+}
+
+// LBsService is a fake goVPSie.LBsService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type LBsService struct {
+	recorder
+
+	ListLBsFunc             func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.LB, error)
+	LBsAllFunc              func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.LB, error]
+	ListLBDataCentersFunc   func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.LBDataCenter, error)
+	LBDataCentersAllFunc    func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.LBDataCenter, error]
+	ListOffersFunc          func(ctx context.Context, dcIdentifier string) ([]goVPSie.LBOffers, error)
+	GetLBFunc               func(ctx context.Context, lbID string) (*goVPSie.LBDetails, error)
+	CreateLBFunc            func(ctx context.Context, createLBReq *goVPSie.CreateLBReq) error
+	DeleteLBFunc            func(ctx context.Context, lbID string, reason string, note string) error
+	AddLBRuleFunc           func(ctx context.Context, addRuleReq *goVPSie.AddRuleReq) error
+	DeleteLBRuleFunc        func(ctx context.Context, ruleID string) error
+	AddLBDomainFunc         func(ctx context.Context, domainAddReq *goVPSie.DomainAddReq) error
+	ReplaceDomainFunc       func(ctx context.Context, domainId string, newDomainId string) error
+	UpdateDomainBackendFunc func(ctx context.Context, domainId string, backends []goVPSie.Backend) error
+	UpdateLBDomainFunc      func(ctx context.Context, domainUpdateReq *goVPSie.DomainUpdateReq) error
+	UpdateLBRulesFunc       func(ctx context.Context, ruleUpdateReq *goVPSie.RuleUpdateReq) error
+	DeleteLBDomainFunc      func(ctx context.Context, domainID string) error
+	DeleteLBBackendFunc     func(ctx context.Context, lbBackendID string) error
+	ListPendingLBsFunc      func(ctx context.Context) ([]goVPSie.PendingLB, error)
+}
+
+var _ goVPSie.LBsService = &LBsService{}
+
+func (f *LBsService) ListLBs(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.LB, error) {
+	f.record("ListLBs", options)
+	if f.ListLBsFunc == nil {
+		return zero[[]goVPSie.LB](), notStubbed("LBsService", "ListLBs")
+	}
+	return f.ListLBsFunc(ctx, options)
+}
+
+func (f *LBsService) LBsAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.LB, error] {
+	f.record("LBsAll", opts)
+	if f.LBsAllFunc == nil {
+		return failed[goVPSie.LB](notStubbed("LBsService", "LBsAll"))
+	}
+	return f.LBsAllFunc(ctx, opts...)
+}
+
+func (f *LBsService) ListLBDataCenters(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.LBDataCenter, error) {
+	f.record("ListLBDataCenters", options)
+	if f.ListLBDataCentersFunc == nil {
+		return zero[[]goVPSie.LBDataCenter](), notStubbed("LBsService", "ListLBDataCenters")
+	}
+	return f.ListLBDataCentersFunc(ctx, options)
+}
+
+func (f *LBsService) LBDataCentersAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.LBDataCenter, error] {
+	f.record("LBDataCentersAll", opts)
+	if f.LBDataCentersAllFunc == nil {
+		return failed[goVPSie.LBDataCenter](notStubbed("LBsService", "LBDataCentersAll"))
+	}
+	return f.LBDataCentersAllFunc(ctx, opts...)
+}
+
+func (f *LBsService) ListOffers(ctx context.Context, dcIdentifier string) ([]goVPSie.LBOffers, error) {
+	f.record("ListOffers", dcIdentifier)
+	if f.ListOffersFunc == nil {
+		return zero[[]goVPSie.LBOffers](), notStubbed("LBsService", "ListOffers")
+	}
+	return f.ListOffersFunc(ctx, dcIdentifier)
+}
+
+func (f *LBsService) GetLB(ctx context.Context, lbID string) (*goVPSie.LBDetails, error) {
+	f.record("GetLB", lbID)
+	if f.GetLBFunc == nil {
+		return zero[*goVPSie.LBDetails](), notStubbed("LBsService", "GetLB")
+	}
+	return f.GetLBFunc(ctx, lbID)
+}
+
+func (f *LBsService) CreateLB(ctx context.Context, createLBReq *goVPSie.CreateLBReq) error {
+	f.record("CreateLB", createLBReq)
+	if f.CreateLBFunc == nil {
+		return notStubbed("LBsService", "CreateLB")
+	}
+	return f.CreateLBFunc(ctx, createLBReq)
+}
+
+func (f *LBsService) DeleteLB(ctx context.Context, lbID string, reason string, note string) error {
+	f.record("DeleteLB", lbID, reason, note)
+	if f.DeleteLBFunc == nil {
+		return notStubbed("LBsService", "DeleteLB")
+	}
+	return f.DeleteLBFunc(ctx, lbID, reason, note)
+}
+
+func (f *LBsService) AddLBRule(ctx context.Context, addRuleReq *goVPSie.AddRuleReq) error {
+	f.record("AddLBRule", addRuleReq)
+	if f.AddLBRuleFunc == nil {
+		return notStubbed("LBsService", "AddLBRule")
+	}
+	return f.AddLBRuleFunc(ctx, addRuleReq)
+}
+
+func (f *LBsService) DeleteLBRule(ctx context.Context, ruleID string) error {
+	f.record("DeleteLBRule", ruleID)
+	if f.DeleteLBRuleFunc == nil {
+		return notStubbed("LBsService", "DeleteLBRule")
+	}
+	return f.DeleteLBRuleFunc(ctx, ruleID)
+}
+
+func (f *LBsService) AddLBDomain(ctx context.Context, domainAddReq *goVPSie.DomainAddReq) error {
+	f.record("AddLBDomain", domainAddReq)
+	if f.AddLBDomainFunc == nil {
+		return notStubbed("LBsService", "AddLBDomain")
+	}
+	return f.AddLBDomainFunc(ctx, domainAddReq)
+}
+
+func (f *LBsService) ReplaceDomain(ctx context.Context, domainId string, newDomainId string) error {
+	f.record("ReplaceDomain", domainId, newDomainId)
+	if f.ReplaceDomainFunc == nil {
+		return notStubbed("LBsService", "ReplaceDomain")
+	}
+	return f.ReplaceDomainFunc(ctx, domainId, newDomainId)
+}
+
+func (f *LBsService) UpdateDomainBackend(ctx context.Context, domainId string, backends []goVPSie.Backend) error {
+	f.record("UpdateDomainBackend", domainId, backends)
+	if f.UpdateDomainBackendFunc == nil {
+		return notStubbed("LBsService", "UpdateDomainBackend")
+	}
+	return f.UpdateDomainBackendFunc(ctx, domainId, backends)
+}
+
+func (f *LBsService) UpdateLBDomain(ctx context.Context, domainUpdateReq *goVPSie.DomainUpdateReq) error {
+	f.record("UpdateLBDomain", domainUpdateReq)
+	if f.UpdateLBDomainFunc == nil {
+		return notStubbed("LBsService", "UpdateLBDomain")
+	}
+	return f.UpdateLBDomainFunc(ctx, domainUpdateReq)
+}
+
+func (f *LBsService) UpdateLBRules(ctx context.Context, ruleUpdateReq *goVPSie.RuleUpdateReq) error {
+	f.record("UpdateLBRules", ruleUpdateReq)
+	if f.UpdateLBRulesFunc == nil {
+		return notStubbed("LBsService", "UpdateLBRules")
+	}
+	return f.UpdateLBRulesFunc(ctx, ruleUpdateReq)
+}
+
+func (f *LBsService) DeleteLBDomain(ctx context.Context, domainID string) error {
+	f.record("DeleteLBDomain", domainID)
+	if f.DeleteLBDomainFunc == nil {
+		return notStubbed("LBsService", "DeleteLBDomain")
+	}
+	return f.DeleteLBDomainFunc(ctx, domainID)
+}
+
+func (f *LBsService) DeleteLBBackend(ctx context.Context, lbBackendID string) error {
+	f.record("DeleteLBBackend", lbBackendID)
+	if f.DeleteLBBackendFunc == nil {
+		return notStubbed("LBsService", "DeleteLBBackend")
+	}
+	return f.DeleteLBBackendFunc(ctx, lbBackendID)
+}
+
+func (f *LBsService) ListPendingLBs(ctx context.Context) ([]goVPSie.PendingLB, error) {
+	f.record("ListPendingLBs")
+	if f.ListPendingLBsFunc == nil {
+		return zero[[]goVPSie.PendingLB](), notStubbed("LBsService", "ListPendingLBs")
+	}
+	return f.ListPendingLBsFunc(ctx)
+}
+
+// ScriptsService is a fake goVPSie.ScriptsService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type ScriptsService struct {
+	recorder
+
+	GetScriptsFunc   func(ctx context.Context) ([]goVPSie.Script, error)
+	GetScriptFunc    func(ctx context.Context, scriptId string) (goVPSie.ScriptDetail, error)
+	CreateScriptFunc func(ctx context.Context, createScriptRequest *goVPSie.CreateScriptRequest) error
+	UpdateScriptFunc func(ctx context.Context, scriptUpdateRequest *goVPSie.ScriptUpdateRequest) error
+	DeleteScriptFunc func(ctx context.Context, scriptId string) error
+}
+
+var _ goVPSie.ScriptsService = &ScriptsService{}
+
+func (f *ScriptsService) GetScripts(ctx context.Context) ([]goVPSie.Script, error) {
+	f.record("GetScripts")
+	if f.GetScriptsFunc == nil {
+		return zero[[]goVPSie.Script](), notStubbed("ScriptsService", "GetScripts")
+	}
+	return f.GetScriptsFunc(ctx)
+}
+
+func (f *ScriptsService) GetScript(ctx context.Context, scriptId string) (goVPSie.ScriptDetail, error) {
+	f.record("GetScript", scriptId)
+	if f.GetScriptFunc == nil {
+		return zero[goVPSie.ScriptDetail](), notStubbed("ScriptsService", "GetScript")
+	}
+	return f.GetScriptFunc(ctx, scriptId)
+}
+
+func (f *ScriptsService) CreateScript(ctx context.Context, createScriptRequest *goVPSie.CreateScriptRequest) error {
+	f.record("CreateScript", createScriptRequest)
+	if f.CreateScriptFunc == nil {
+		return notStubbed("ScriptsService", "CreateScript")
+	}
+	return f.CreateScriptFunc(ctx, createScriptRequest)
+}
+
+func (f *ScriptsService) UpdateScript(ctx context.Context, scriptUpdateRequest *goVPSie.ScriptUpdateRequest) error {
+	f.record("UpdateScript", scriptUpdateRequest)
+	if f.UpdateScriptFunc == nil {
+		return notStubbed("ScriptsService", "UpdateScript")
+	}
+	return f.UpdateScriptFunc(ctx, scriptUpdateRequest)
+}
+
+func (f *ScriptsService) DeleteScript(ctx context.Context, scriptId string) error {
+	f.record("DeleteScript", scriptId)
+	if f.DeleteScriptFunc == nil {
+		return notStubbed("ScriptsService", "DeleteScript")
+	}
+	return f.DeleteScriptFunc(ctx, scriptId)
+}
+
+// PendingService is a fake goVPSie.PendingService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type PendingService struct {
+	recorder
+
+	GetPendingVmsFunc func(ctx context.Context) ([]goVPSie.PendingVm, error)
+}
+
+var _ goVPSie.PendingService = &PendingService{}
+
+func (f *PendingService) GetPendingVms(ctx context.Context) ([]goVPSie.PendingVm, error) {
+	f.record("GetPendingVms")
+	if f.GetPendingVmsFunc == nil {
+		return zero[[]goVPSie.PendingVm](), notStubbed("PendingService", "GetPendingVms")
+	}
+	return f.GetPendingVmsFunc(ctx)
+}
+
+// GatewayService is a fake goVPSie.GatewayService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type GatewayService struct {
+	recorder
+
+	ListFunc     func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Gateway, error)
+	AllFunc      func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Gateway, error]
+	DeleteFunc   func(ctx context.Context, ipId int) error
+	CreateFunc   func(ctx context.Context, createReq *goVPSie.CreateGatewayReq) error
+	GetFunc      func(ctx context.Context, id int64) (*goVPSie.Gateway, error)
+	AttachVMFunc func(ctx context.Context, id int64, vms []string, ignoreLegacyVms int64) error
+	DetachVMFunc func(ctx context.Context, id int64, mapping_id []int64) error
+}
+
+var _ goVPSie.GatewayService = &GatewayService{}
+
+func (f *GatewayService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Gateway, error) {
+	f.record("List", options)
+	if f.ListFunc == nil {
+		return zero[[]goVPSie.Gateway](), notStubbed("GatewayService", "List")
+	}
+	return f.ListFunc(ctx, options)
+}
+
+func (f *GatewayService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Gateway, error] {
+	f.record("All", opts)
+	if f.AllFunc == nil {
+		return failed[goVPSie.Gateway](notStubbed("GatewayService", "All"))
+	}
+	return f.AllFunc(ctx, opts...)
+}
+
+func (f *GatewayService) Delete(ctx context.Context, ipId int) error {
+	f.record("Delete", ipId)
+	if f.DeleteFunc == nil {
+		return notStubbed("GatewayService", "Delete")
+	}
+	return f.DeleteFunc(ctx, ipId)
+}
+
+func (f *GatewayService) Create(ctx context.Context, createReq *goVPSie.CreateGatewayReq) error {
+	f.record("Create", createReq)
+	if f.CreateFunc == nil {
+		return notStubbed("GatewayService", "Create")
+	}
+	return f.CreateFunc(ctx, createReq)
+}
+
+func (f *GatewayService) Get(ctx context.Context, id int64) (*goVPSie.Gateway, error) {
+	f.record("Get", id)
+	if f.GetFunc == nil {
+		return zero[*goVPSie.Gateway](), notStubbed("GatewayService", "Get")
+	}
+	return f.GetFunc(ctx, id)
+}
+
+func (f *GatewayService) AttachVM(ctx context.Context, id int64, vms []string, ignoreLegacyVms int64) error {
+	f.record("AttachVM", id, vms, ignoreLegacyVms)
+	if f.AttachVMFunc == nil {
+		return notStubbed("GatewayService", "AttachVM")
+	}
+	return f.AttachVMFunc(ctx, id, vms, ignoreLegacyVms)
+}
+
+func (f *GatewayService) DetachVM(ctx context.Context, id int64, mapping_id []int64) error {
+	f.record("DetachVM", id, mapping_id)
+	if f.DetachVMFunc == nil {
+		return notStubbed("GatewayService", "DetachVM")
+	}
+	return f.DetachVMFunc(ctx, id, mapping_id)
+}
+
+// VPCService is a fake goVPSie.VPCService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type VPCService struct {
+	recorder
+
+	ListFunc             func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.VPC, error)
+	AllFunc              func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.VPC, error]
+	GetFunc              func(ctx context.Context, id string) (*goVPSie.VPC, error)
+	AssignServerFunc     func(ctx context.Context, assignReq *goVPSie.AssignServerReq) error
+	MoveServerFunc       func(ctx context.Context, assignReq *goVPSie.AssignServerReq) error
+	CreateVpcFunc        func(ctx context.Context, createReq *goVPSie.CreateVpcReq) error
+	ReleasePrivateIPFunc func(ctx context.Context, vmIdentifer string, privateIpId int) error
+	DeleteVpcFunc        func(ctx context.Context, vpcId string, reason string, note string) error
+}
+
+var _ goVPSie.VPCService = &VPCService{}
+
+func (f *VPCService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.VPC, error) {
+	f.record("List", options)
+	if f.ListFunc == nil {
+		return zero[[]goVPSie.VPC](), notStubbed("VPCService", "List")
+	}
+	return f.ListFunc(ctx, options)
+}
+
+func (f *VPCService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.VPC, error] {
+	f.record("All", opts)
+	if f.AllFunc == nil {
+		return failed[goVPSie.VPC](notStubbed("VPCService", "All"))
+	}
+	return f.AllFunc(ctx, opts...)
+}
+
+func (f *VPCService) Get(ctx context.Context, id string) (*goVPSie.VPC, error) {
+	f.record("Get", id)
+	if f.GetFunc == nil {
+		return zero[*goVPSie.VPC](), notStubbed("VPCService", "Get")
+	}
+	return f.GetFunc(ctx, id)
+}
+
+func (f *VPCService) AssignServer(ctx context.Context, assignReq *goVPSie.AssignServerReq) error {
+	f.record("AssignServer", assignReq)
+	if f.AssignServerFunc == nil {
+		return notStubbed("VPCService", "AssignServer")
+	}
+	return f.AssignServerFunc(ctx, assignReq)
+}
+
+func (f *VPCService) MoveServer(ctx context.Context, assignReq *goVPSie.AssignServerReq) error {
+	f.record("MoveServer", assignReq)
+	if f.MoveServerFunc == nil {
+		return notStubbed("VPCService", "MoveServer")
+	}
+	return f.MoveServerFunc(ctx, assignReq)
+}
+
+func (f *VPCService) CreateVpc(ctx context.Context, createReq *goVPSie.CreateVpcReq) error {
+	f.record("CreateVpc", createReq)
+	if f.CreateVpcFunc == nil {
+		return notStubbed("VPCService", "CreateVpc")
+	}
+	return f.CreateVpcFunc(ctx, createReq)
+}
+
+func (f *VPCService) ReleasePrivateIP(ctx context.Context, vmIdentifer string, privateIpId int) error {
+	f.record("ReleasePrivateIP", vmIdentifer, privateIpId)
+	if f.ReleasePrivateIPFunc == nil {
+		return notStubbed("VPCService", "ReleasePrivateIP")
+	}
+	return f.ReleasePrivateIPFunc(ctx, vmIdentifer, privateIpId)
+}
+
+func (f *VPCService) DeleteVpc(ctx context.Context, vpcId string, reason string, note string) error {
+	f.record("DeleteVpc", vpcId, reason, note)
+	if f.DeleteVpcFunc == nil {
+		return notStubbed("VPCService", "DeleteVpc")
+	}
+	return f.DeleteVpcFunc(ctx, vpcId, reason, note)
+}
+
+// BucketService is a fake goVPSie.BucketService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type BucketService struct {
+	recorder
+
+	ListFunc                   func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Bucket, error)
+	AllFunc                    func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Bucket, error]
+	GetFunc                    func(ctx context.Context, id string) (*goVPSie.Bucket, error)
+	CreateFunc                 func(ctx context.Context, createReq *goVPSie.CreateBucketReq) error
+	DeleteFunc                 func(ctx context.Context, bucketId string, reason string, note string) error
+	ToggleFileListingFunc      func(ctx context.Context, bucketId string, fileListing bool) (bool, error)
+	CheckFileListingStatusFunc func(ctx context.Context, bucketId string) (bool, error)
+	GenerateKeyFunc            func(ctx context.Context, keyName string) error
+	ListBucketKeysFunc         func(ctx context.Context) ([]goVPSie.BucketKey, error)
+}
+
+var _ goVPSie.BucketService = &BucketService{}
+
+func (f *BucketService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Bucket, error) {
+	f.record("List", options)
+	if f.ListFunc == nil {
+		return zero[[]goVPSie.Bucket](), notStubbed("BucketService", "List")
+	}
+	return f.ListFunc(ctx, options)
+}
+
+func (f *BucketService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Bucket, error] {
+	f.record("All", opts)
+	if f.AllFunc == nil {
+		return failed[goVPSie.Bucket](notStubbed("BucketService", "All"))
+	}
+	return f.AllFunc(ctx, opts...)
+}
+
+func (f *BucketService) Get(ctx context.Context, id string) (*goVPSie.Bucket, error) {
+	f.record("Get", id)
+	if f.GetFunc == nil {
+		return zero[*goVPSie.Bucket](), notStubbed("BucketService", "Get")
+	}
+	return f.GetFunc(ctx, id)
+}
+
+func (f *BucketService) Create(ctx context.Context, createReq *goVPSie.CreateBucketReq) error {
+	f.record("Create", createReq)
+	if f.CreateFunc == nil {
+		return notStubbed("BucketService", "Create")
+	}
+	return f.CreateFunc(ctx, createReq)
+}
+
+func (f *BucketService) Delete(ctx context.Context, bucketId string, reason string, note string) error {
+	f.record("Delete", bucketId, reason, note)
+	if f.DeleteFunc == nil {
+		return notStubbed("BucketService", "Delete")
+	}
+	return f.DeleteFunc(ctx, bucketId, reason, note)
+}
+
+func (f *BucketService) ToggleFileListing(ctx context.Context, bucketId string, fileListing bool) (bool, error) {
+	f.record("ToggleFileListing", bucketId, fileListing)
+	if f.ToggleFileListingFunc == nil {
+		return zero[bool](), notStubbed("BucketService", "ToggleFileListing")
+	}
+	return f.ToggleFileListingFunc(ctx, bucketId, fileListing)
+}
+
+func (f *BucketService) CheckFileListingStatus(ctx context.Context, bucketId string) (bool, error) {
+	f.record("CheckFileListingStatus", bucketId)
+	if f.CheckFileListingStatusFunc == nil {
+		return zero[bool](), notStubbed("BucketService", "CheckFileListingStatus")
+	}
+	return f.CheckFileListingStatusFunc(ctx, bucketId)
+}
+
+func (f *BucketService) GenerateKey(ctx context.Context, keyName string) error {
+	f.record("GenerateKey", keyName)
+	if f.GenerateKeyFunc == nil {
+		return notStubbed("BucketService", "GenerateKey")
+	}
+	return f.GenerateKeyFunc(ctx, keyName)
+}
+
+func (f *BucketService) ListBucketKeys(ctx context.Context) ([]goVPSie.BucketKey, error) {
+	f.record("ListBucketKeys")
+	if f.ListBucketKeysFunc == nil {
+		return zero[[]goVPSie.BucketKey](), notStubbed("BucketService", "ListBucketKeys")
+	}
+	return f.ListBucketKeysFunc(ctx)
+}
+
+// K8sService is a fake goVPSie.K8sService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type K8sService struct {
+	recorder
+
+	ListFunc              func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.ListK8s, error)
+	AllFunc               func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.ListK8s, error]
+	DeleteFunc            func(ctx context.Context, identifier string, reason string, note string) error
+	CreateFunc            func(ctx context.Context, createReq *goVPSie.CreateK8sReq) error
+	GetFunc               func(ctx context.Context, identifier string) (*goVPSie.K8s, error)
+	AddSlaveFunc          func(ctx context.Context, identifier string) error
+	RemoveSlaveFunc       func(ctx context.Context, identifier string) error
+	ListK8sGroupsFunc     func(ctx context.Context, identifier string) ([]goVPSie.K8sGroup, error)
+	AddNodeFunc           func(ctx context.Context, identifier string, nodeType string, groupId int) error
+	RemoveNodeFunc        func(ctx context.Context, identifier string, nodeType string, groupId int) error
+	CreateK8sGroupFunc    func(ctx context.Context, createReq *goVPSie.CreateK8sGroupReq) error
+	DeleteK8sGroupFunc    func(ctx context.Context, groupId string, reason string, note string) error
+	UpgradeK8sVersionFunc func(ctx context.Context, identifier string) error
+	PatchK8sVersionFunc   func(ctx context.Context, identifier string, processId string) error
+}
+
+var _ goVPSie.K8sService = &K8sService{}
+
+func (f *K8sService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.ListK8s, error) {
+	f.record("List", options)
+	if f.ListFunc == nil {
+		return zero[[]goVPSie.ListK8s](), notStubbed("K8sService", "List")
+	}
+	return f.ListFunc(ctx, options)
+}
+
+func (f *K8sService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.ListK8s, error] {
+	f.record("All", opts)
+	if f.AllFunc == nil {
+		return failed[goVPSie.ListK8s](notStubbed("K8sService", "All"))
+	}
+	return f.AllFunc(ctx, opts...)
+}
+
+func (f *K8sService) Delete(ctx context.Context, identifier string, reason string, note string) error {
+	f.record("Delete", identifier, reason, note)
+	if f.DeleteFunc == nil {
+		return notStubbed("K8sService", "Delete")
+	}
+	return f.DeleteFunc(ctx, identifier, reason, note)
+}
+
+func (f *K8sService) Create(ctx context.Context, createReq *goVPSie.CreateK8sReq) error {
+	f.record("Create", createReq)
+	if f.CreateFunc == nil {
+		return notStubbed("K8sService", "Create")
+	}
+	return f.CreateFunc(ctx, createReq)
+}
+
+func (f *K8sService) Get(ctx context.Context, identifier string) (*goVPSie.K8s, error) {
+	f.record("Get", identifier)
+	if f.GetFunc == nil {
+		return zero[*goVPSie.K8s](), notStubbed("K8sService", "Get")
+	}
+	return f.GetFunc(ctx, identifier)
+}
+
+func (f *K8sService) AddSlave(ctx context.Context, identifier string) error {
+	f.record("AddSlave", identifier)
+	if f.AddSlaveFunc == nil {
+		return notStubbed("K8sService", "AddSlave")
+	}
+	return f.AddSlaveFunc(ctx, identifier)
+}
+
+func (f *K8sService) RemoveSlave(ctx context.Context, identifier string) error {
+	f.record("RemoveSlave", identifier)
+	if f.RemoveSlaveFunc == nil {
+		return notStubbed("K8sService", "RemoveSlave")
+	}
+	return f.RemoveSlaveFunc(ctx, identifier)
+}
+
+func (f *K8sService) ListK8sGroups(ctx context.Context, identifier string) ([]goVPSie.K8sGroup, error) {
+	f.record("ListK8sGroups", identifier)
+	if f.ListK8sGroupsFunc == nil {
+		return zero[[]goVPSie.K8sGroup](), notStubbed("K8sService", "ListK8sGroups")
+	}
+	return f.ListK8sGroupsFunc(ctx, identifier)
+}
+
+func (f *K8sService) AddNode(ctx context.Context, identifier string, nodeType string, groupId int) error {
+	f.record("AddNode", identifier, nodeType, groupId)
+	if f.AddNodeFunc == nil {
+		return notStubbed("K8sService", "AddNode")
+	}
+	return f.AddNodeFunc(ctx, identifier, nodeType, groupId)
+}
+
+func (f *K8sService) RemoveNode(ctx context.Context, identifier string, nodeType string, groupId int) error {
+	f.record("RemoveNode", identifier, nodeType, groupId)
+	if f.RemoveNodeFunc == nil {
+		return notStubbed("K8sService", "RemoveNode")
+	}
+	return f.RemoveNodeFunc(ctx, identifier, nodeType, groupId)
+}
+
+func (f *K8sService) CreateK8sGroup(ctx context.Context, createReq *goVPSie.CreateK8sGroupReq) error {
+	f.record("CreateK8sGroup", createReq)
+	if f.CreateK8sGroupFunc == nil {
+		return notStubbed("K8sService", "CreateK8sGroup")
+	}
+	return f.CreateK8sGroupFunc(ctx, createReq)
+}
+
+func (f *K8sService) DeleteK8sGroup(ctx context.Context, groupId string, reason string, note string) error {
+	f.record("DeleteK8sGroup", groupId, reason, note)
+	if f.DeleteK8sGroupFunc == nil {
+		return notStubbed("K8sService", "DeleteK8sGroup")
+	}
+	return f.DeleteK8sGroupFunc(ctx, groupId, reason, note)
+}
+
+func (f *K8sService) UpgradeK8sVersion(ctx context.Context, identifier string) error {
+	f.record("UpgradeK8sVersion", identifier)
+	if f.UpgradeK8sVersionFunc == nil {
+		return notStubbed("K8sService", "UpgradeK8sVersion")
+	}
+	return f.UpgradeK8sVersionFunc(ctx, identifier)
+}
+
+func (f *K8sService) PatchK8sVersion(ctx context.Context, identifier string, processId string) error {
+	f.record("PatchK8sVersion", identifier, processId)
+	if f.PatchK8sVersionFunc == nil {
+		return notStubbed("K8sService", "PatchK8sVersion")
+	}
+	return f.PatchK8sVersionFunc(ctx, identifier, processId)
+}
+
+// AccessTokenService is a fake goVPSie.AccessTokenService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type AccessTokenService struct {
+	recorder
+
+	ListFunc   func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.AccessToken, error)
+	AllFunc    func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.AccessToken, error]
+	CreateFunc func(ctx context.Context, name string, accessToken string, expirationDate string) error
+	DeleteFunc func(ctx context.Context, accessTokenIdentifier string) error
+	UpdateFunc func(ctx context.Context, accessTokenIdentifier string, name string, expirationDate string) error
+}
+
+var _ goVPSie.AccessTokenService = &AccessTokenService{}
+
+func (f *AccessTokenService) List(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.AccessToken, error) {
+	f.record("List", options)
+	if f.ListFunc == nil {
+		return zero[[]goVPSie.AccessToken](), notStubbed("AccessTokenService", "List")
+	}
+	return f.ListFunc(ctx, options)
+}
+
+func (f *AccessTokenService) All(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.AccessToken, error] {
+	f.record("All", opts)
+	if f.AllFunc == nil {
+		return failed[goVPSie.AccessToken](notStubbed("AccessTokenService", "All"))
+	}
+	return f.AllFunc(ctx, opts...)
+}
+
+func (f *AccessTokenService) Create(ctx context.Context, name string, accessToken string, expirationDate string) error {
+	f.record("Create", name, accessToken, expirationDate)
+	if f.CreateFunc == nil {
+		return notStubbed("AccessTokenService", "Create")
+	}
+	return f.CreateFunc(ctx, name, accessToken, expirationDate)
+}
+
+func (f *AccessTokenService) Delete(ctx context.Context, accessTokenIdentifier string) error {
+	f.record("Delete", accessTokenIdentifier)
+	if f.DeleteFunc == nil {
+		return notStubbed("AccessTokenService", "Delete")
+	}
+	return f.DeleteFunc(ctx, accessTokenIdentifier)
+}
+
+func (f *AccessTokenService) Update(ctx context.Context, accessTokenIdentifier string, name string, expirationDate string) error {
+	f.record("Update", accessTokenIdentifier, name, expirationDate)
+	if f.UpdateFunc == nil {
+		return notStubbed("AccessTokenService", "Update")
+	}
+	return f.UpdateFunc(ctx, accessTokenIdentifier, name, expirationDate)
+}
+
+// BillingService is a fake goVPSie.BillingService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type BillingService struct {
+	recorder
+
+	ListInvoicesFunc        func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Invoice, error)
+	InvoicesAllFunc         func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Invoice, error]
+	ListPurchaseLogFunc     func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.PurchaseLog, error)
+	PurchaseLogAllFunc      func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.PurchaseLog, error]
+	ApplyVoucherFunc        func(ctx context.Context, couponIdentifier string) error
+	ListAppliedVouchersFunc func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.AppliedVouchers, error)
+	AppliedVouchersAllFunc  func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.AppliedVouchers, error]
+	ListEstimatedUsagesFunc func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.EstimatedUsages, error)
+	EstimatedUsagesAllFunc  func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.EstimatedUsages, error]
+}
+
+var _ goVPSie.BillingService = &BillingService{}
+
+func (f *BillingService) ListInvoices(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.Invoice, error) {
+	f.record("ListInvoices", options)
+	if f.ListInvoicesFunc == nil {
+		return zero[[]goVPSie.Invoice](), notStubbed("BillingService", "ListInvoices")
+	}
+	return f.ListInvoicesFunc(ctx, options)
+}
+
+func (f *BillingService) InvoicesAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.Invoice, error] {
+	f.record("InvoicesAll", opts)
+	if f.InvoicesAllFunc == nil {
+		return failed[goVPSie.Invoice](notStubbed("BillingService", "InvoicesAll"))
+	}
+	return f.InvoicesAllFunc(ctx, opts...)
+}
+
+func (f *BillingService) ListPurchaseLog(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.PurchaseLog, error) {
+	f.record("ListPurchaseLog", options)
+	if f.ListPurchaseLogFunc == nil {
+		return zero[[]goVPSie.PurchaseLog](), notStubbed("BillingService", "ListPurchaseLog")
+	}
+	return f.ListPurchaseLogFunc(ctx, options)
+}
+
+func (f *BillingService) PurchaseLogAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.PurchaseLog, error] {
+	f.record("PurchaseLogAll", opts)
+	if f.PurchaseLogAllFunc == nil {
+		return failed[goVPSie.PurchaseLog](notStubbed("BillingService", "PurchaseLogAll"))
+	}
+	return f.PurchaseLogAllFunc(ctx, opts...)
+}
+
+func (f *BillingService) ApplyVoucher(ctx context.Context, couponIdentifier string) error {
+	f.record("ApplyVoucher", couponIdentifier)
+	if f.ApplyVoucherFunc == nil {
+		return notStubbed("BillingService", "ApplyVoucher")
+	}
+	return f.ApplyVoucherFunc(ctx, couponIdentifier)
+}
+
+func (f *BillingService) ListAppliedVouchers(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.AppliedVouchers, error) {
+	f.record("ListAppliedVouchers", options)
+	if f.ListAppliedVouchersFunc == nil {
+		return zero[[]goVPSie.AppliedVouchers](), notStubbed("BillingService", "ListAppliedVouchers")
+	}
+	return f.ListAppliedVouchersFunc(ctx, options)
+}
+
+func (f *BillingService) AppliedVouchersAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.AppliedVouchers, error] {
+	f.record("AppliedVouchersAll", opts)
+	if f.AppliedVouchersAllFunc == nil {
+		return failed[goVPSie.AppliedVouchers](notStubbed("BillingService", "AppliedVouchersAll"))
+	}
+	return f.AppliedVouchersAllFunc(ctx, opts...)
+}
+
+func (f *BillingService) ListEstimatedUsages(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.EstimatedUsages, error) {
+	f.record("ListEstimatedUsages", options)
+	if f.ListEstimatedUsagesFunc == nil {
+		return zero[[]goVPSie.EstimatedUsages](), notStubbed("BillingService", "ListEstimatedUsages")
+	}
+	return f.ListEstimatedUsagesFunc(ctx, options)
+}
+
+func (f *BillingService) EstimatedUsagesAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.EstimatedUsages, error] {
+	f.record("EstimatedUsagesAll", opts)
+	if f.EstimatedUsagesAllFunc == nil {
+		return failed[goVPSie.EstimatedUsages](notStubbed("BillingService", "EstimatedUsagesAll"))
+	}
+	return f.EstimatedUsagesAllFunc(ctx, opts...)
+}
+
+// MonitoringService is a fake goVPSie.MonitoringService. Each method records its
+// call and runs the matching Func field; methods without one fail with a
+// *NotStubbedError.
+type MonitoringService struct {
+	recorder
+
+	ListMonitoringRuleFunc         func(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.MonitoringRule, error)
+	MonitoringRulesAllFunc         func(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.MonitoringRule, error]
+	CreateRuleFunc                 func(ctx context.Context, createReq *goVPSie.CreateMonitoringRuleReq) error
+	ToggleMonitoringRuleStatusFunc func(ctx context.Context, status string, ruleIdentifier string) error
+	DeleteMonitoringRuleFunc       func(ctx context.Context, ruleIdentifier string) error
+}
+
+var _ goVPSie.MonitoringService = &MonitoringService{}
+
+func (f *MonitoringService) ListMonitoringRule(ctx context.Context, options *goVPSie.ListOptions) ([]goVPSie.MonitoringRule, error) {
+	f.record("ListMonitoringRule", options)
+	if f.ListMonitoringRuleFunc == nil {
+		return zero[[]goVPSie.MonitoringRule](), notStubbed("MonitoringService", "ListMonitoringRule")
+	}
+	return f.ListMonitoringRuleFunc(ctx, options)
+}
+
+func (f *MonitoringService) MonitoringRulesAll(ctx context.Context, opts ...goVPSie.IterOption) iter.Seq2[goVPSie.MonitoringRule, error] {
+	f.record("MonitoringRulesAll", opts)
+	if f.MonitoringRulesAllFunc == nil {
+		return failed[goVPSie.MonitoringRule](notStubbed("MonitoringService", "MonitoringRulesAll"))
+	}
+	return f.MonitoringRulesAllFunc(ctx, opts...)
+}
+
+func (f *MonitoringService) CreateRule(ctx context.Context, createReq *goVPSie.CreateMonitoringRuleReq) error {
+	f.record("CreateRule", createReq)
+	if f.CreateRuleFunc == nil {
+		return notStubbed("MonitoringService", "CreateRule")
+	}
+	return f.CreateRuleFunc(ctx, createReq)
+}
+
+func (f *MonitoringService) ToggleMonitoringRuleStatus(ctx context.Context, status string, ruleIdentifier string) error {
+	f.record("ToggleMonitoringRuleStatus", status, ruleIdentifier)
+	if f.ToggleMonitoringRuleStatusFunc == nil {
+		return notStubbed("MonitoringService", "ToggleMonitoringRuleStatus")
+	}
+	return f.ToggleMonitoringRuleStatusFunc(ctx, status, ruleIdentifier)
+}
+
+func (f *MonitoringService) DeleteMonitoringRule(ctx context.Context, ruleIdentifier string) error {
+	f.record("DeleteMonitoringRule", ruleIdentifier)
+	if f.DeleteMonitoringRuleFunc == nil {
+		return notStubbed("MonitoringService", "DeleteMonitoringRule")
+	}
+	return f.DeleteMonitoringRuleFunc(ctx, ruleIdentifier)
+}
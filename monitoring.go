@@ -3,6 +3,7 @@ package goVPSie
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -10,6 +11,7 @@ const monitoringPath = "/apps/v2/monitoring"
 
 type MonitoringService interface {
 	ListMonitoringRule(ctx context.Context, options *ListOptions) ([]MonitoringRule, error)
+	MonitoringRulesAll(ctx context.Context, opts ...IterOption) iter.Seq2[MonitoringRule, error]
 	CreateRule(ctx context.Context, createReq *CreateMonitoringRuleReq) error
 	ToggleMonitoringRuleStatus(ctx context.Context, status, ruleIdentifier string) error
 	DeleteMonitoringRule(ctx context.Context, ruleIdentifier string) error
@@ -22,22 +24,22 @@ type monitoringServiceHandler struct {
 var _ MonitoringService = &monitoringServiceHandler{}
 
 type MonitoringRule struct {
-	ID            int    `json:"id"`
-	UserId        int    `json:"user_id"`
-	MetricType    string `json:"metric_type"`
-	RuleName      string `json:"rule_name"`
-	Condition     string `json:"condition"`
-	Email         string `json:"email"`
-	Threshold     int    `json:"threshold"`
-	ThresholdType string `json:"threshold_type"`
-	Period        int    `json:"period"`
-	Status        int    `json:"status"`
-	CreatedOn     string `json:"created_on"`
-	Frequency     int    `json:"frequency"`
-	LastAlertDate string `json:"last_alert_date"`
-	Identifier    string `json:"identifier"`
-	IsDeleted     int    `json:"is_deleted"`
-	CreatedBY     string `json:"created_by"`
+	ID            int       `json:"id"`
+	UserId        int       `json:"user_id"`
+	MetricType    string    `json:"metric_type"`
+	RuleName      string    `json:"rule_name"`
+	Condition     string    `json:"condition"`
+	Email         string    `json:"email"`
+	Threshold     int       `json:"threshold"`
+	ThresholdType string    `json:"threshold_type"`
+	Period        int       `json:"period"`
+	Status        int       `json:"status"`
+	CreatedOn     Timestamp `json:"created_on"`
+	Frequency     int       `json:"frequency"`
+	LastAlertDate Timestamp `json:"last_alert_date"`
+	Identifier    string    `json:"identifier"`
+	IsDeleted     Flag      `json:"is_deleted"`
+	CreatedBY     string    `json:"created_by"`
 }
 
 type ListMonitoringRuleRoot struct {
@@ -65,8 +67,27 @@ type CreateMonitoringRuleReq struct {
 	Tags []string `json:"tags"`
 }
 
+// Validate reports the invalid fields of r.
+func (r CreateMonitoringRuleReq) Validate() error {
+	var errs fieldErrors
+	errs.required("ruleName", r.RuleName)
+	errs.required("metricType", r.MetricType)
+	errs.required("condition", r.Condition)
+	_, err := ParseDecimal(r.Threshold)
+	errs.check(err == nil, "threshold", "must be a number, got %q", r.Threshold)
+	errs.required("period", r.Period)
+	errs.required("frequency", r.Frequency)
+	if r.Actions.Email != "" {
+		errs.check(isEmail(r.Actions.Email), "actions.email", "must be an email address, got %q", r.Actions.Email)
+	}
+	return errs.err()
+}
+
 func (s *monitoringServiceHandler) ListMonitoringRule(ctx context.Context, options *ListOptions) ([]MonitoringRule, error) {
-	path := fmt.Sprintf("%s/rules", monitoringPath)
+	path, err := addOptions(fmt.Sprintf("%s/rules", monitoringPath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -78,9 +99,15 @@ func (s *monitoringServiceHandler) ListMonitoringRule(ctx context.Context, optio
 		return nil, err
 	}
 
+	options.setMeta(root.Total, len(root.Data))
 	return root.Data, nil
 }
 
+// MonitoringRulesAll iterates over every monitoring rule, fetching pages lazily.
+func (s *monitoringServiceHandler) MonitoringRulesAll(ctx context.Context, opts ...IterOption) iter.Seq2[MonitoringRule, error] {
+	return paginate(ctx, s.ListMonitoringRule, opts)
+}
+
 func (s *monitoringServiceHandler) CreateRule(ctx context.Context, createReq *CreateMonitoringRuleReq) error {
 	path := fmt.Sprintf("%s/rules/add", monitoringPath)
 
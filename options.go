@@ -0,0 +1,345 @@
+package goVPSie
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// ClientOption configures a client built by NewClientWithOptions.
+type ClientOption func(*clientOptions) error
+
+type clientOptions struct {
+	httpClient      *http.Client
+	transport       http.RoundTripper
+	timeout         time.Duration
+	baseURL         *url.URL
+	userAgentSuffix string
+	headers         map[string]string
+	tokenSource     oauth2.TokenSource
+	retry           *RetryPolicy
+	rateLimit       *RateLimit
+	logger          *slog.Logger
+	middleware      []Middleware
+	plan            *Plan
+	cache           *CacheOptions
+	maxResponseSize int64
+	strict          func(SchemaDrift)
+	validate        bool
+	idempotency     *IdempotencyOptions
+	defaultProject  string
+}
+
+// NewClientWithOptions builds a client from opts. Every option is validated
+// and the first invalid one is returned as an error. The client is fully
+// configured on return; it needs none of the Set methods and is safe for
+// concurrent use.
+func NewClientWithOptions(opts ...ClientOption) (*Client, error) {
+	o := &clientOptions{}
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+
+	// Work on a copy so that the timeout and transport options never change
+	// an *http.Client shared with other code.
+	httpClient := &http.Client{}
+	if o.httpClient != nil {
+		*httpClient = *o.httpClient
+	}
+	if o.transport != nil {
+		httpClient.Transport = o.transport
+	}
+	if o.timeout > 0 {
+		httpClient.Timeout = o.timeout
+	}
+
+	c := NewClient(httpClient)
+	if o.baseURL != nil {
+		c.baseURL = o.baseURL
+	}
+	if o.userAgentSuffix != "" {
+		c.userAgent += " " + o.userAgentSuffix
+	}
+	c.exportConfig()
+	for k, v := range o.headers {
+		c.headers[k] = v
+	}
+	if o.retry != nil {
+		c.retry = *o.retry
+	}
+	if o.rateLimit != nil {
+		c.limiter = newRateLimiter(*o.rateLimit)
+	}
+	c.tokenSource = o.tokenSource
+	c.logger = o.logger
+	c.plan = o.plan
+	c.strict = o.strict
+	c.validate = o.validate
+	c.defaultProject = o.defaultProject
+	if o.idempotency != nil {
+		c.SetIdempotency(o.idempotency)
+	}
+	if o.maxResponseSize > 0 {
+		c.maxResponseBytes = o.maxResponseSize
+	}
+	if o.cache != nil {
+		c.cache = newResponseCache(*o.cache)
+	}
+	if len(o.middleware) > 0 {
+		c.Use(o.middleware...)
+	}
+
+	return c, nil
+}
+
+// WithHTTPClient sets the HTTP client used to reach the API. It is copied, so
+// later options never modify it.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(o *clientOptions) error {
+		if httpClient == nil {
+			return errors.New("http client must not be nil")
+		}
+		o.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithTransport sets the RoundTripper used for every request.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(o *clientOptions) error {
+		if transport == nil {
+			return errors.New("transport must not be nil")
+		}
+		o.transport = transport
+		return nil
+	}
+}
+
+// WithTimeout bounds every HTTP attempt, including reading the response body.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(o *clientOptions) error {
+		if timeout <= 0 {
+			return fmt.Errorf("timeout must be positive, got %s", timeout)
+		}
+		o.timeout = timeout
+		return nil
+	}
+}
+
+// WithBaseURL overrides the default API endpoint. The URL must be absolute.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(o *clientOptions) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return fmt.Errorf("invalid base URL: %w", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("invalid base URL %q: must be an absolute http or https URL", baseURL)
+		}
+		o.baseURL = u
+		return nil
+	}
+}
+
+// WithUserAgentSuffix appends suffix, e.g. "my-controller/1.2", to the
+// default User-Agent.
+func WithUserAgentSuffix(suffix string) ClientOption {
+	return func(o *clientOptions) error {
+		suffix = strings.TrimSpace(suffix)
+		if suffix == "" || strings.ContainsAny(suffix, "\r\n") {
+			return fmt.Errorf("invalid user agent suffix %q", suffix)
+		}
+		o.userAgentSuffix = suffix
+		return nil
+	}
+}
+
+// WithRequestHeaders adds headers to every request.
+func WithRequestHeaders(headers map[string]string) ClientOption {
+	return func(o *clientOptions) error {
+		if o.headers == nil {
+			o.headers = make(map[string]string, len(headers))
+		}
+		for k, v := range headers {
+			if k == "" || strings.ContainsAny(k+v, "\r\n") {
+				return fmt.Errorf("invalid request header %q", k)
+			}
+			o.headers[k] = v
+		}
+		return nil
+	}
+}
+
+// WithAuthToken authenticates every request with a fixed access token.
+func WithAuthToken(token string) ClientOption {
+	return func(o *clientOptions) error {
+		if token == "" {
+			return errors.New("auth token must not be empty")
+		}
+		o.tokenSource = oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
+		return nil
+	}
+}
+
+// WithTokenSource authenticates every request with tokens from src, such as
+// a LoginTokenSource. See Client.SetTokenSource.
+func WithTokenSource(src oauth2.TokenSource) ClientOption {
+	return func(o *clientOptions) error {
+		if src == nil {
+			return errors.New("token source must not be nil")
+		}
+		o.tokenSource = src
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the retry policy applied by Do.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(o *clientOptions) error {
+		switch {
+		case policy.MaxAttempts < 0:
+			return fmt.Errorf("retry policy: MaxAttempts must not be negative, got %d", policy.MaxAttempts)
+		case policy.BaseBackoff < 0 || policy.MaxBackoff < 0:
+			return errors.New("retry policy: backoff must not be negative")
+		case policy.MaxBackoff > 0 && policy.BaseBackoff > policy.MaxBackoff:
+			return errors.New("retry policy: BaseBackoff exceeds MaxBackoff")
+		case policy.Jitter < 0 || policy.Jitter > 1:
+			return fmt.Errorf("retry policy: Jitter must be between 0 and 1, got %g", policy.Jitter)
+		}
+		o.retry = &policy
+		return nil
+	}
+}
+
+// WithRateLimit enables client-side throttling. See Client.SetRateLimit.
+func WithRateLimit(limit RateLimit) ClientOption {
+	return func(o *clientOptions) error {
+		for method, l := range limit.PerMethod {
+			if l.RequestsPerSecond < 0 || l.Burst < 0 {
+				return fmt.Errorf("rate limit for %s must not be negative", method)
+			}
+		}
+		if limit.RequestsPerSecond < 0 || limit.Burst < 0 {
+			return errors.New("rate limit must not be negative")
+		}
+		o.rateLimit = &limit
+		return nil
+	}
+}
+
+// WithLogger logs retried requests to logger at debug level.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(o *clientOptions) error {
+		if logger == nil {
+			return errors.New("logger must not be nil")
+		}
+		o.logger = logger
+		return nil
+	}
+}
+
+// WithMaxResponseSize limits the size of the response bodies Do decodes.
+// Larger responses fail with a *ResponseTooLargeError. The default is 64 MiB.
+func WithMaxResponseSize(bytes int64) ClientOption {
+	return func(o *clientOptions) error {
+		if bytes <= 0 {
+			return errors.New("max response size must be positive")
+		}
+		o.maxResponseSize = bytes
+		return nil
+	}
+}
+
+// WithStrictDecoding reports schema drift in decoded responses to report.
+// See Client.SetStrictDecoding.
+func WithStrictDecoding(report func(SchemaDrift)) ClientOption {
+	return func(o *clientOptions) error {
+		if report == nil {
+			return errors.New("strict decoding report must not be nil")
+		}
+		o.strict = report
+		return nil
+	}
+}
+
+// WithValidation validates request bodies before they are sent. See
+// Client.SetValidation.
+func WithValidation() ClientOption {
+	return func(o *clientOptions) error {
+		o.validate = true
+		return nil
+	}
+}
+
+// WithDefaultProject sets the project of the resources created without one:
+// servers, including those created from an image, domains, buckets and
+// kubernetes clusters. See Client.DefaultProject.
+func WithDefaultProject(projectID string) ClientOption {
+	return func(o *clientOptions) error {
+		o.defaultProject = projectID
+		return nil
+	}
+}
+
+// WithIdempotency enables idempotency keys on create calls. See
+// Client.SetIdempotency.
+func WithIdempotency(opts IdempotencyOptions) ClientOption {
+	return func(o *clientOptions) error {
+		if opts.MaxClockSkew < 0 {
+			return errors.New("idempotency max clock skew must not be negative")
+		}
+		o.idempotency = &opts
+		return nil
+	}
+}
+
+// WithCache enables the response cache. See Client.SetCache.
+func WithCache(opts CacheOptions) ClientOption {
+	return func(o *clientOptions) error {
+		for prefix, ttl := range opts.TTLs {
+			if ttl < 0 {
+				return fmt.Errorf("cache TTL for %q must not be negative", prefix)
+			}
+		}
+		if opts.MaxEntries < 0 {
+			return errors.New("cache max entries must not be negative")
+		}
+		o.cache = &opts
+		return nil
+	}
+}
+
+// WithDryRun starts the client in dry-run mode. See Client.SetDryRun.
+func WithDryRun(plan *Plan) ClientOption {
+	return func(o *clientOptions) error {
+		if plan == nil {
+			return errors.New("plan must not be nil")
+		}
+		o.plan = plan
+		return nil
+	}
+}
+
+// WithMiddleware wraps the transport with middleware. See Client.Use.
+func WithMiddleware(middleware ...Middleware) ClientOption {
+	return func(o *clientOptions) error {
+		for _, m := range middleware {
+			if m == nil {
+				return errors.New("middleware must not be nil")
+			}
+		}
+		o.middleware = append(o.middleware, middleware...)
+		return nil
+	}
+}
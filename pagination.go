@@ -0,0 +1,73 @@
+package goVPSie
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// Meta describes the page returned by a List method. List methods store it
+// in ListOptions.Meta after every successful call.
+type Meta struct {
+	// Total number of items across all pages, as reported by the API. Zero when
+	// the endpoint does not report a total.
+	Total int
+
+	// Page and PerPage echo the options the page was requested with.
+	Page    int
+	PerPage int
+
+	// HasNext reports whether another page can be requested with Page+1.
+	HasNext bool
+}
+
+// addOptions encodes the pagination options into the query string of path.
+// Page is sent as the API's zero-based offset and PerPage as its limit; zero
+// values are omitted so the API applies its own defaults.
+func addOptions(path string, opt *ListOptions) (string, error) {
+	if opt == nil {
+		return path, nil
+	}
+
+	u, err := url.Parse(path)
+	if err != nil {
+		return path, err
+	}
+
+	q := u.Query()
+	if opt.Page > 0 {
+		q.Set("offset", strconv.Itoa(opt.Page))
+	}
+	if opt.PerPage > 0 {
+		q.Set("limit", strconv.Itoa(opt.PerPage))
+	}
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// setMeta records the pagination state of a fetched page holding count items
+// out of total. A total of zero means the endpoint does not report one, in
+// which case a full page is taken as a sign that more may follow.
+func (o *ListOptions) setMeta(total, count int) {
+	if o == nil {
+		return
+	}
+
+	meta := &Meta{
+		Total:   total,
+		Page:    o.Page,
+		PerPage: o.PerPage,
+	}
+
+	// An endpoint that returns more than PerPage items ignores the limit and
+	// has already returned everything.
+	if o.PerPage > 0 && count > 0 && count <= o.PerPage {
+		if total > 0 {
+			meta.HasNext = o.Page*o.PerPage+count < total
+		} else {
+			meta.HasNext = count >= o.PerPage
+		}
+	}
+
+	o.Meta = meta
+}
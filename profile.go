@@ -3,6 +3,7 @@ package goVPSie
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -10,7 +11,9 @@ var profilePath = "/apps/v2/profile"
 
 type ProfilesService interface {
 	ListQuickActionOfUser(ctx context.Context, options *ListOptions) ([]QuickActions, error)
+	QuickActionsOfUserAll(ctx context.Context, opts ...IterOption) iter.Seq2[QuickActions, error]
 	ListQuickActionOfAccount(ctx context.Context, options *ListOptions) ([]QuickActions, error)
+	QuickActionsOfAccountAll(ctx context.Context, opts ...IterOption) iter.Seq2[QuickActions, error]
 	SaveQuickActions(ctx context.Context, actions []int) error
 	GetProfile(ctx context.Context) (*Profile, error)
 	UpdateProfile(context.Context, UpdateProfileRequest) error
@@ -34,7 +37,7 @@ var _ ProfilesService = &profilesServiceHandler{}
 type ListActionOfUserRoot struct {
 	Error bool           `json:"error"`
 	Data  []QuickActions `json:"data"`
-	Total int            `json:"total "`
+	Total int            `json:"total"`
 }
 
 type QuickActions struct {
@@ -54,11 +57,11 @@ type GetPermissionGroupRoot struct {
 }
 
 type PermissionGroup struct {
-	Id        int    `json:"id"`
-	Name      string `json:"name"`
-	CreatedOn string `json:"create_on"`
-	UserId    int    `json:"user_id"`
-	Perms     string `json:"perms"`
+	Id        int       `json:"id"`
+	Name      string    `json:"name"`
+	CreatedOn Timestamp `json:"create_on"`
+	UserId    int       `json:"user_id"`
+	Perms     string    `json:"perms"`
 }
 
 type BillingAddress struct {
@@ -80,7 +83,7 @@ type Profile struct {
 	FirstName      string  `json:"firstname"`
 	LastName       string  `json:"lastname"`
 	Email          string  `json:"email"`
-	CurrentBalance float64 `json:"current_balance"`
+	CurrentBalance Decimal `json:"current_balance"`
 	Identifier     string  `json:"identifier"`
 	Status         string  `json:"status"`
 	MonthlyCharge  string  `json:"monthly_charge"`
@@ -98,8 +101,20 @@ type UpdateProfileRequest struct {
 	TimeZone  string `json:"timeZone"`
 }
 
+// Validate reports the invalid fields of r.
+func (r UpdateProfileRequest) Validate() error {
+	var errs fieldErrors
+	if r.Email != "" {
+		errs.check(isEmail(r.Email), "email", "must be an email address, got %q", r.Email)
+	}
+	return errs.err()
+}
+
 func (p *profilesServiceHandler) ListQuickActionOfUser(ctx context.Context, options *ListOptions) ([]QuickActions, error) {
-	path := fmt.Sprintf("%s/user/quick/actions", profilePath)
+	path, err := addOptions(fmt.Sprintf("%s/user/quick/actions", profilePath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := p.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -111,11 +126,20 @@ func (p *profilesServiceHandler) ListQuickActionOfUser(ctx context.Context, opti
 		return nil, err
 	}
 
+	options.setMeta(actions.Total, len(actions.Data))
 	return actions.Data, nil
 }
 
+// QuickActionsOfUserAll iterates over every quick action of the user, fetching pages lazily.
+func (p *profilesServiceHandler) QuickActionsOfUserAll(ctx context.Context, opts ...IterOption) iter.Seq2[QuickActions, error] {
+	return paginate(ctx, p.ListQuickActionOfUser, opts)
+}
+
 func (p *profilesServiceHandler) ListQuickActionOfAccount(ctx context.Context, options *ListOptions) ([]QuickActions, error) {
-	path := fmt.Sprintf("%s/quick/actions", profilePath)
+	path, err := addOptions(fmt.Sprintf("%s/quick/actions", profilePath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := p.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -127,9 +151,15 @@ func (p *profilesServiceHandler) ListQuickActionOfAccount(ctx context.Context, o
 		return nil, err
 	}
 
+	options.setMeta(actions.Total, len(actions.Data))
 	return actions.Data, nil
 }
 
+// QuickActionsOfAccountAll iterates over every quick action of the account, fetching pages lazily.
+func (p *profilesServiceHandler) QuickActionsOfAccountAll(ctx context.Context, opts ...IterOption) iter.Seq2[QuickActions, error] {
+	return paginate(ctx, p.ListQuickActionOfAccount, opts)
+}
+
 func (p *profilesServiceHandler) SaveQuickActions(ctx context.Context, actions []int) error {
 	path := fmt.Sprintf("%s/quick/actions/save", profilePath)
 
@@ -3,6 +3,7 @@ package goVPSie
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
@@ -10,6 +11,7 @@ var projectsBasePath = "/apps/v2/projects"
 
 type ProjectsService interface {
 	List(context.Context, *ListOptions) ([]Project, error)
+	All(ctx context.Context, opts ...IterOption) iter.Seq2[Project, error]
 	SetDefault(context.Context, string) error
 	Get(ctx context.Context, identifer string) (*Project, error)
 	Create(context.Context, *CreateProjectRequest) error
@@ -28,14 +30,14 @@ type projectsServiceHandler struct {
 var _ ProjectsService = &projectsServiceHandler{}
 
 type Project struct {
-	ID          uint64 `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	CreatedOn   string `json:"created_on"`
-	UpdatedAt   string `json:"updated_at"`
-	Identifier  string `json:"identifier"`
-	CreatedBy   uint64 `json:"created_by"`
-	IsDefault   int    `json:"is_default"`
+	ID          uint64    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedOn   Timestamp `json:"created_on"`
+	UpdatedAt   Timestamp `json:"updated_at"`
+	Identifier  string    `json:"identifier"`
+	CreatedBy   uint64    `json:"created_by"`
+	IsDefault   Flag      `json:"is_default"`
 }
 
 // CreateProjectRequest represents the request to create a new project.
@@ -44,6 +46,13 @@ type CreateProjectRequest struct {
 	Description string `json:"description"`
 }
 
+// Validate reports whether r has a name.
+func (r CreateProjectRequest) Validate() error {
+	var errs fieldErrors
+	errs.required("name", r.Name)
+	return errs.err()
+}
+
 type ProjectsRoot struct {
 	Error bool `json:"error"`
 	Data  Data `json:"data"`
@@ -74,7 +83,12 @@ type UserLimit struct {
 }
 
 func (p *projectsServiceHandler) List(ctx context.Context, options *ListOptions) ([]Project, error) {
-	req, err := p.client.NewRequest(ctx, http.MethodGet, projectsBasePath, nil)
+	path, err := addOptions(projectsBasePath, options)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := p.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -84,9 +98,15 @@ func (p *projectsServiceHandler) List(ctx context.Context, options *ListOptions)
 		return nil, err
 	}
 
+	options.setMeta(int(projects.Data.Count), len(projects.Data.Rows))
 	return projects.Data.Rows, nil
 }
 
+// All iterates over every project, fetching pages lazily.
+func (p *projectsServiceHandler) All(ctx context.Context, opts ...IterOption) iter.Seq2[Project, error] {
+	return paginate(ctx, p.List, opts)
+}
+
 func (p *projectsServiceHandler) SetDefault(ctx context.Context, projectIdentifier string) error {
 	path := fmt.Sprintf("%s/set/default", projectsBasePath)
 
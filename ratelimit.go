@@ -0,0 +1,187 @@
+package goVPSie
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimit configures the client-side token bucket used by Do. A bucket
+// holds up to Burst tokens and refills at RequestsPerSecond; every attempt,
+// retries included, takes one token.
+type RateLimit struct {
+	RequestsPerSecond float64
+	Burst             int
+
+	// PerMethod gives individual HTTP methods their own bucket, e.g. a tighter
+	// limit for POST. Methods are matched regardless of case. Methods not
+	// listed share the default bucket. Nested PerMethod values are ignored.
+	PerMethod map[string]RateLimit
+}
+
+// Rate is the rate-limit state last reported by the API through the
+// X-RateLimit-* (or RateLimit-*) response headers.
+type Rate struct {
+	// Maximum number of requests allowed in the current window.
+	Limit int
+
+	// Number of requests remaining in the current window.
+	Remaining int
+
+	// Time at which the current window resets.
+	Reset time.Time
+
+	// Time at which the headers were observed. Zero if the API never sent them.
+	Observed time.Time
+}
+
+// SetRateLimit enables client-side throttling for every service sharing this
+// client. A RequestsPerSecond of zero disables it.
+func (c *Client) SetRateLimit(limit RateLimit) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.limiter = newRateLimiter(limit)
+}
+
+// Rate returns the rate-limit state last reported by the API.
+func (c *Client) Rate() Rate {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+
+	return c.rate
+}
+
+type rateLimiter struct {
+	fallback *tokenBucket
+	methods  map[string]*tokenBucket
+}
+
+func newRateLimiter(limit RateLimit) *rateLimiter {
+	l := &rateLimiter{
+		fallback: newTokenBucket(limit),
+		methods:  make(map[string]*tokenBucket),
+	}
+
+	for method, methodLimit := range limit.PerMethod {
+		l.methods[strings.ToUpper(method)] = newTokenBucket(methodLimit)
+	}
+
+	return l
+}
+
+// wait blocks until a token for method is available or ctx is done.
+func (l *rateLimiter) wait(ctx context.Context, method string) error {
+	if l == nil {
+		return nil
+	}
+
+	if bucket, ok := l.methods[strings.ToUpper(method)]; ok {
+		return bucket.wait(ctx)
+	}
+
+	return l.fallback.wait(ctx)
+}
+
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newTokenBucket returns nil, meaning unlimited, when the limit has no rate.
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	if limit.RequestsPerSecond <= 0 {
+		return nil
+	}
+
+	burst := float64(limit.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &tokenBucket{
+		rate:   limit.RequestsPerSecond,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+func (b *tokenBucket) wait(ctx context.Context) error {
+	if b == nil {
+		return ctx.Err()
+	}
+
+	for {
+		b.mu.Lock()
+		now := time.Now()
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		}
+
+		delay := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.mu.Unlock()
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// updateRate records the rate-limit headers of res, if present.
+func (c *Client) updateRate(res *http.Response) {
+	rate, ok := parseRate(res.Header)
+	if !ok {
+		return
+	}
+
+	c.rateMu.Lock()
+	c.rate = rate
+	c.rateMu.Unlock()
+}
+
+func parseRate(h http.Header) (Rate, bool) {
+	get := func(name string) string {
+		if v := h.Get("X-RateLimit-" + name); v != "" {
+			return v
+		}
+		return h.Get("RateLimit-" + name)
+	}
+
+	limit, limitErr := strconv.Atoi(get("Limit"))
+	remaining, remainingErr := strconv.Atoi(get("Remaining"))
+	if limitErr != nil && remainingErr != nil {
+		return Rate{}, false
+	}
+
+	rate := Rate{
+		Limit:     limit,
+		Remaining: remaining,
+		Observed:  time.Now(),
+	}
+
+	// Reset is either a unix timestamp or a number of seconds from now.
+	if reset, err := strconv.ParseInt(get("Reset"), 10, 64); err == nil {
+		if reset > 1e9 {
+			rate.Reset = time.Unix(reset, 0)
+		} else {
+			rate.Reset = rate.Observed.Add(time.Duration(reset) * time.Second)
+		}
+	}
+
+	return rate, true
+}
@@ -0,0 +1,96 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+)
+
+// Result is the outcome of one change.
+type Result struct {
+	Change   *Change
+	Err      error
+	Duration time.Duration
+}
+
+// ApplyError is returned by Apply when any change failed or was skipped.
+type ApplyError struct {
+	// Failed holds the results with an error, in the order of the plan.
+	Failed []Result
+
+	// Total is the number of changes in the plan.
+	Total int
+}
+
+// maxApplyErrorItems bounds how many failures ApplyError.Error lists.
+const maxApplyErrorItems = 3
+
+func (e *ApplyError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d of %d changes failed", len(e.Failed), e.Total)
+	for i, r := range e.Failed {
+		if i == maxApplyErrorItems {
+			fmt.Fprintf(&b, "; and %d more", len(e.Failed)-i)
+			break
+		}
+		fmt.Fprintf(&b, "; %s: %v", r.Change, r.Err)
+	}
+	return b.String()
+}
+
+// Unwrap returns the errors of the failed changes, so that errors.Is and
+// errors.As look through them.
+func (e *ApplyError) Unwrap() []error {
+	errs := make([]error, len(e.Failed))
+	for i, r := range e.Failed {
+		errs[i] = r.Err
+	}
+	return errs
+}
+
+// Apply makes the changes of the plan in order and returns one result per
+// change. A failed change does not stop the others, but the changes that
+// require it fail with an error wrapping goVPSie.ErrSkipped. If any change
+// failed, the error is an *ApplyError listing them.
+//
+// A plan is meant to be applied once; to retry after a failure, make a new
+// plan from the state Apply left.
+func (p *Plan) Apply(ctx context.Context) ([]Result, error) {
+	results := make([]Result, len(p.Changes))
+	failed := make(map[*Change]bool)
+	applyErr := &ApplyError{Total: len(p.Changes)}
+
+	for i, c := range p.Changes {
+		results[i].Change = c
+		if dep := failedDependency(c, failed); dep != nil {
+			results[i].Err = fmt.Errorf("%w: requires %s", goVPSie.ErrSkipped, dep)
+		} else {
+			start := time.Now()
+			results[i].Err = c.apply(ctx, p.state)
+			results[i].Duration = time.Since(start)
+		}
+
+		if results[i].Err != nil {
+			failed[c] = true
+			applyErr.Failed = append(applyErr.Failed, results[i])
+		}
+	}
+
+	if len(applyErr.Failed) > 0 {
+		return results, applyErr
+	}
+	return results, nil
+}
+
+// failedDependency returns the first change c requires that failed, or nil.
+func failedDependency(c *Change, failed map[*Change]bool) *Change {
+	for _, dep := range c.Requires {
+		if failed[dep] {
+			return dep
+		}
+	}
+	return nil
+}
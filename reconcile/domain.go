@@ -0,0 +1,61 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+)
+
+func (p *planner) planDomains() error {
+	for _, want := range p.m.Domains {
+		_, exists := p.live.domains[want.Domain]
+		if want.Absent {
+			if exists {
+				p.add(&Change{Kind: KindDomain, Name: want.Domain, Action: Delete, apply: p.deleteDomain(want.Domain)})
+			}
+			continue
+		}
+
+		if !exists {
+			if err := p.requireProject(KindDomain, want.Domain); err != nil {
+				return err
+			}
+			p.add(&Change{Kind: KindDomain, Name: want.Domain, Action: Create, apply: p.createDomain(want.Domain)})
+		}
+	}
+	return nil
+}
+
+func (p *planner) createDomain(name string) func(context.Context, *state) error {
+	return func(ctx context.Context, st *state) error {
+		if err := p.client.Domain.CreateDomain(ctx, &goVPSie.CreateDomainRequest{
+			ProjectIdentifier: p.project,
+			Domain:            name,
+		}); err != nil {
+			return err
+		}
+
+		// CreateDomain does not return the new domain, so look it up by name.
+		for domain, err := range p.client.Domain.DomainsAll(ctx) {
+			if err != nil {
+				return err
+			}
+			if domain.DomainName == name {
+				st.domains[name] = domain.Identifier
+				return nil
+			}
+		}
+		return fmt.Errorf("created domain %s not found", name)
+	}
+}
+
+func (p *planner) deleteDomain(name string) func(context.Context, *state) error {
+	return func(ctx context.Context, st *state) error {
+		if err := p.client.Domain.DeleteDomain(ctx, st.domains[name], p.opts.Reason, p.opts.Note); err != nil {
+			return err
+		}
+		delete(st.domains, name)
+		return nil
+	}
+}
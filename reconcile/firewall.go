@@ -0,0 +1,224 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+)
+
+func (p *planner) planFirewallGroups() error {
+	for _, want := range p.m.FirewallGroups {
+		have, exists := p.live.groups[want.Name]
+		if want.Absent {
+			if exists {
+				p.add(&Change{Kind: KindFirewallGroup, Name: want.Name, Action: Delete, apply: p.deleteGroup(want.Name)})
+			}
+			continue
+		}
+
+		for _, hostname := range want.Servers {
+			if err := p.checkBackend(KindFirewallGroup, want.Name, hostname); err != nil {
+				return err
+			}
+		}
+
+		wantRules := make([]string, len(want.Rules))
+		for i, rule := range want.Rules {
+			wantRules[i] = rule.String()
+		}
+		requires := p.requires(nil, KindServer, want.Servers...)
+
+		if !exists {
+			p.add(&Change{
+				Kind:     KindFirewallGroup,
+				Name:     want.Name,
+				Action:   Create,
+				Diff:     append(listDiff("rule", nil, wantRules), setDiff("server", nil, want.Servers)...),
+				Requires: requires,
+				apply:    p.createGroup(want),
+			})
+			continue
+		}
+
+		haveRules := make([]string, len(have.Rules))
+		for i, rule := range have.Rules {
+			haveRules[i] = liveRule(rule)
+		}
+		var haveServers []string
+		for _, vm := range have.VmsData {
+			haveServers = append(haveServers, vm.Hostname)
+		}
+		serverDiff := setDiff("server", haveServers, want.Servers)
+
+		// Groups cannot be edited rule by rule, so different rules replace
+		// the group. The new group is attached before the old one is
+		// deleted, so that its servers are never left without a firewall.
+		if ruleDiff := listDiff("rule", haveRules, wantRules); len(ruleDiff) > 0 {
+			createGroup := p.createGroup(want)
+			p.add(&Change{
+				Kind:     KindFirewallGroup,
+				Name:     want.Name,
+				Action:   Replace,
+				Diff:     append(ruleDiff, serverDiff...),
+				Requires: requires,
+				apply: func(ctx context.Context, st *state) error {
+					old := st.groups[want.Name]
+					if err := createGroup(ctx, st); err != nil {
+						return err
+					}
+					if err := p.client.FirewallGroup.Delete(ctx, old); err != nil {
+						return fmt.Errorf("deleting the replaced group %s: %w", old, err)
+					}
+					return nil
+				},
+			})
+			continue
+		}
+
+		// Servers being replaced lose the group with their old identifier.
+		var attach, detach []string
+		for _, hostname := range want.Servers {
+			switch {
+			case !slices.Contains(haveServers, hostname):
+				attach = append(attach, hostname)
+			case p.creating(KindServer, hostname) != nil:
+				attach = append(attach, hostname)
+				serverDiff = append(serverDiff, fmt.Sprintf("+ server: %s (replaced)", hostname))
+			}
+		}
+		for _, vm := range have.VmsData {
+			if !slices.Contains(want.Servers, vm.Hostname) {
+				detach = append(detach, vm.Identifier)
+			}
+		}
+		if len(serverDiff) == 0 {
+			continue
+		}
+
+		p.add(&Change{
+			Kind:     KindFirewallGroup,
+			Name:     want.Name,
+			Action:   Update,
+			Diff:     serverDiff,
+			Requires: requires,
+			apply: func(ctx context.Context, st *state) error {
+				group := st.groups[want.Name]
+				for _, identifier := range detach {
+					if err := p.client.FirewallGroup.DetachFromVpsie(ctx, group, identifier); err != nil {
+						return err
+					}
+				}
+				return p.attachGroup(ctx, st, want.Name, attach)
+			},
+		})
+	}
+	return nil
+}
+
+// checkBackend reports an error if the server with hostname, used by the
+// resource of kind, is neither declared nor live.
+func (p *planner) checkBackend(kind Kind, name, hostname string) error {
+	if _, ok := p.live.servers[hostname]; ok || p.creating(KindServer, hostname) != nil {
+		return nil
+	}
+	return fmt.Errorf("%s %s: server %s is neither declared nor live", kind, name, hostname)
+}
+
+// createGroup returns the function creating the group want and attaching it
+// to its servers. A live group of the same name, which the new one replaces,
+// is left in place.
+func (p *planner) createGroup(want FirewallGroup) func(context.Context, *state) error {
+	return func(ctx context.Context, st *state) error {
+		old := st.groups[want.Name]
+		rules := make([]goVPSie.FirewallUpdateReq, len(want.Rules))
+		for i, rule := range want.Rules {
+			rules[i] = goVPSie.FirewallUpdateReq{
+				Action:  rule.Action,
+				Type:    rule.Type,
+				Dport:   rule.Dport,
+				Proto:   rule.Proto,
+				Source:  rule.Source,
+				Sport:   rule.Sport,
+				Enable:  1,
+				Macro:   rule.Macro,
+				Comment: rule.Comment,
+				Dest:    rule.Dest,
+			}
+			if rule.Disabled {
+				rules[i].Enable = 0
+			}
+		}
+		if err := p.client.FirewallGroup.Create(ctx, want.Name, rules); err != nil {
+			return err
+		}
+
+		// Create does not return the new group, so look it up by name.
+		for group, err := range p.client.FirewallGroup.All(ctx) {
+			if err != nil {
+				return err
+			}
+			if group.GroupName == want.Name && group.Identifier != old {
+				st.groups[want.Name] = group.Identifier
+				return p.attachGroup(ctx, st, want.Name, want.Servers)
+			}
+		}
+		return fmt.Errorf("created firewall group %s not found", want.Name)
+	}
+}
+
+// attachGroup attaches the group called name to the servers with the given
+// hostnames.
+func (p *planner) attachGroup(ctx context.Context, st *state, name string, hostnames []string) error {
+	for _, hostname := range hostnames {
+		if err := p.client.FirewallGroup.AttachToVpsie(ctx, st.groups[name], st.servers[hostname]); err != nil {
+			return fmt.Errorf("attaching %s: %w", hostname, err)
+		}
+	}
+	return nil
+}
+
+func (p *planner) deleteGroup(name string) func(context.Context, *state) error {
+	return func(ctx context.Context, st *state) error {
+		if err := p.client.FirewallGroup.Delete(ctx, st.groups[name]); err != nil {
+			return err
+		}
+		delete(st.groups, name)
+		return nil
+	}
+}
+
+// String describes r on one line, such as "in ACCEPT proto=tcp dport=22".
+func (r FirewallRule) String() string {
+	return ruleLine(r.Type, r.Action, r.Macro, r.Proto, r.Source, r.Sport, r.Dest, r.Dport, r.Comment, !r.Disabled)
+}
+
+// liveRule describes a rule of a live group like FirewallRule.String.
+func liveRule(r goVPSie.FirewallRule) string {
+	return ruleLine(r.Type, r.Action, r.Macro, r.Proto, r.Source, r.Sport, r.Dest, r.Dport, r.Comment, r.Enable.Bool())
+}
+
+func ruleLine(ruleType, action, macro, proto string, source []string, sport string, dest []string, dport, comment string, enabled bool) string {
+	parts := []string{ruleType, strings.ToUpper(action)}
+	for _, field := range [][2]string{
+		{"macro", macro},
+		{"proto", strings.ToLower(proto)},
+		{"source", strings.Join(source, ",")},
+		{"sport", sport},
+		{"dest", strings.Join(dest, ",")},
+		{"dport", dport},
+	} {
+		if field[1] != "" {
+			parts = append(parts, field[0]+"="+field[1])
+		}
+	}
+	if comment != "" {
+		parts = append(parts, fmt.Sprintf("comment=%q", comment))
+	}
+	if !enabled {
+		parts = append(parts, "disabled")
+	}
+	return strings.Join(parts, " ")
+}
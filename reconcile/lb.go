@@ -0,0 +1,148 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+)
+
+func (p *planner) planLBs() error {
+	hostnames := make(map[string]string)
+	for hostname, vm := range p.live.servers {
+		hostnames[vm.Identifier] = hostname
+	}
+	// backendName names a live backend by the hostname of its server, or
+	// by its address if it is not a server the plan knows.
+	backendName := func(b goVPSie.LBBackendsDetail) string {
+		if hostname, ok := hostnames[b.VMIdentifier]; ok {
+			return hostname
+		}
+		if b.IP != "" {
+			return b.IP
+		}
+		return b.VMIdentifier
+	}
+
+	for _, want := range p.m.LoadBalancers {
+		lb := p.live.lbs[want.Name]
+
+		for _, rule := range want.Rules {
+			for _, hostname := range rule.Backends {
+				if err := p.checkBackend(kindLoadBalancer, want.Name, hostname); err != nil {
+					return err
+				}
+			}
+
+			name := fmt.Sprintf("%s:%d", want.Name, rule.FrontPort)
+			requires := p.requires(nil, KindServer, rule.Backends...)
+			i := slices.IndexFunc(lb.Rules, func(r goVPSie.LBRuleDetail) bool { return r.FrontPort == rule.FrontPort })
+			if i < 0 {
+				p.add(&Change{
+					Kind:     KindLBRule,
+					Name:     name,
+					Action:   Create,
+					Diff:     append(attrs("scheme", rule.Scheme, "back_port", rule.BackPort), setDiff("backend", nil, rule.Backends)...),
+					Requires: requires,
+					apply:    p.addRule(want.Name, rule),
+				})
+				continue
+			}
+
+			have := lb.Rules[i]
+			var diff []string
+			if have.Scheme != rule.Scheme {
+				diff = append(diff, changed("scheme", have.Scheme, rule.Scheme))
+			}
+			if have.BackPort != rule.BackPort {
+				diff = append(diff, changed("back_port", have.BackPort, rule.BackPort))
+			}
+			var haveBackends []string
+			for _, backend := range have.Backends {
+				haveBackends = append(haveBackends, backendName(backend))
+			}
+			diff = append(diff, setDiff("backend", haveBackends, rule.Backends)...)
+			// Servers being replaced leave the rule with their old
+			// identifier.
+			for _, hostname := range rule.Backends {
+				if slices.Contains(haveBackends, hostname) && p.creating(KindServer, hostname) != nil {
+					diff = append(diff, fmt.Sprintf("+ backend: %s (replaced)", hostname))
+				}
+			}
+			if len(diff) == 0 {
+				continue
+			}
+
+			p.add(&Change{
+				Kind:     KindLBRule,
+				Name:     name,
+				Action:   Update,
+				Diff:     diff,
+				Requires: requires,
+				apply: func(ctx context.Context, st *state) error {
+					return p.updateRule(ctx, st, have.RuleID, rule)
+				},
+			})
+		}
+
+		for _, have := range lb.Rules {
+			if slices.ContainsFunc(want.Rules, func(r LBRule) bool { return r.FrontPort == have.FrontPort }) {
+				continue
+			}
+			p.add(&Change{
+				Kind:   KindLBRule,
+				Name:   fmt.Sprintf("%s:%d", want.Name, have.FrontPort),
+				Action: Delete,
+				apply: func(ctx context.Context, st *state) error {
+					return p.client.LB.DeleteLBRule(ctx, have.RuleID)
+				},
+			})
+		}
+	}
+	return nil
+}
+
+func (p *planner) addRule(lbName string, rule LBRule) func(context.Context, *state) error {
+	return func(ctx context.Context, st *state) error {
+		if err := p.client.LB.AddLBRule(ctx, &goVPSie.AddRuleReq{
+			Scheme:    rule.Scheme,
+			FrontPort: strconv.Itoa(rule.FrontPort),
+			BackPort:  strconv.Itoa(rule.BackPort),
+			LbId:      st.lbs[lbName],
+		}); err != nil {
+			return err
+		}
+		if len(rule.Backends) == 0 {
+			return nil
+		}
+
+		// Rules are added without backends; find the new one to set them.
+		lb, err := p.client.LB.GetLB(ctx, st.lbs[lbName])
+		if err != nil {
+			return err
+		}
+		i := slices.IndexFunc(lb.Rules, func(r goVPSie.LBRuleDetail) bool { return r.FrontPort == rule.FrontPort })
+		if i < 0 {
+			return fmt.Errorf("added rule on port %d not found", rule.FrontPort)
+		}
+		return p.updateRule(ctx, st, lb.Rules[i].RuleID, rule)
+	}
+}
+
+// updateRule sets the scheme, back port and backends of the rule with the
+// given identifier to the ones of rule.
+func (p *planner) updateRule(ctx context.Context, st *state, ruleID string, rule LBRule) error {
+	backends := make([]goVPSie.Backend, len(rule.Backends))
+	for i, hostname := range rule.Backends {
+		backends[i] = goVPSie.Backend{VmIdentifier: st.servers[hostname]}
+	}
+	return p.client.LB.UpdateLBRules(ctx, &goVPSie.RuleUpdateReq{
+		RuleID:    ruleID,
+		Backends:  backends,
+		BackPort:  rule.BackPort,
+		Scheme:    rule.Scheme,
+		FrontPort: rule.FrontPort,
+	})
+}
@@ -0,0 +1,168 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"maps"
+	"strings"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+)
+
+// live is the state of the resources a manifest mentions, keyed by name.
+type live struct {
+	vpcs    map[string]goVPSie.VPC
+	servers map[string]goVPSie.VmData
+	groups  map[string]goVPSie.FirewallGroupListData
+	domains map[string]goVPSie.Domain
+	lbs     map[string]*goVPSie.LBDetails
+}
+
+// fetch reads the live state of the resources m mentions. Servers are
+// listed within project, if set.
+func fetch(ctx context.Context, client *goVPSie.Client, m *Manifest, project string) (*live, error) {
+	names := m.names()
+	l := &live{}
+
+	var err error
+	if len(names[KindVPC]) > 0 {
+		if l.vpcs, err = index(client.VPC.All(ctx), func(v goVPSie.VPC) string { return v.Name }, KindVPC, names); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(names[KindServer]) > 0 {
+		servers := client.Server.All(ctx)
+		if project != "" {
+			servers = client.Server.AllByProject(ctx, project)
+		}
+		if l.servers, err = index(servers, func(vm goVPSie.VmData) string { return vm.Hostname }, KindServer, names); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(names[KindFirewallGroup]) > 0 {
+		if l.groups, err = index(client.FirewallGroup.All(ctx), func(g goVPSie.FirewallGroupListData) string { return g.GroupName }, KindFirewallGroup, names); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(names[KindDomain]) > 0 {
+		if l.domains, err = index(client.Domain.DomainsAll(ctx), func(d goVPSie.Domain) string { return d.DomainName }, KindDomain, names); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(m.LoadBalancers) > 0 {
+		lbs, err := index(client.LB.LBsAll(ctx), func(lb goVPSie.LB) string { return lb.LBName }, kindLoadBalancer, names)
+		if err != nil {
+			return nil, err
+		}
+		l.lbs = make(map[string]*goVPSie.LBDetails)
+		for _, want := range m.LoadBalancers {
+			lb, ok := lbs[want.Name]
+			if !ok {
+				return nil, fmt.Errorf("load balancer %s not found: create it before managing its rules", want.Name)
+			}
+			if l.lbs[want.Name], err = client.LB.GetLB(ctx, lb.Identifier); err != nil {
+				return nil, fmt.Errorf("load balancer %s: %w", want.Name, err)
+			}
+		}
+	}
+
+	return l, nil
+}
+
+// index collects seq by name. Several resources with a name m mentions are
+// an error, since they cannot be told apart.
+func index[T any](seq iter.Seq2[T, error], name func(T) string, kind Kind, names map[Kind]map[string]bool) (map[string]T, error) {
+	items := make(map[string]T)
+	for item, err := range seq {
+		if err != nil {
+			return nil, fmt.Errorf("listing %ss: %w", strings.ReplaceAll(string(kind), "_", " "), err)
+		}
+		n := name(item)
+		if _, dup := items[n]; dup && names[kind][n] {
+			return nil, fmt.Errorf("%s %s is ambiguous: several live resources have this name", kind, n)
+		}
+		items[n] = item
+	}
+	return items, nil
+}
+
+// names returns the names m declares or references, by kind.
+func (m *Manifest) names() map[Kind]map[string]bool {
+	names := make(map[Kind]map[string]bool)
+	add := func(kind Kind, name string) {
+		if names[kind] == nil {
+			names[kind] = make(map[string]bool)
+		}
+		names[kind][name] = true
+	}
+
+	for _, vpc := range m.VPCs {
+		add(KindVPC, vpc.Name)
+	}
+	for _, server := range m.Servers {
+		add(KindServer, server.Hostname)
+		if server.VPC != "" {
+			add(KindVPC, server.VPC)
+		}
+	}
+	for _, group := range m.FirewallGroups {
+		add(KindFirewallGroup, group.Name)
+		for _, hostname := range group.Servers {
+			add(KindServer, hostname)
+		}
+	}
+	for _, domain := range m.Domains {
+		add(KindDomain, domain.Domain)
+	}
+	for _, lb := range m.LoadBalancers {
+		add(kindLoadBalancer, lb.Name)
+		for _, rule := range lb.Rules {
+			for _, hostname := range rule.Backends {
+				add(KindServer, hostname)
+			}
+		}
+	}
+	return names
+}
+
+// state tracks the identifiers of resources by name while a plan is applied,
+// so that a change can find the resources created by the changes it
+// requires.
+type state struct {
+	vpcs    map[string]goVPSie.VPC
+	servers map[string]string
+	groups  map[string]string
+	domains map[string]string
+	lbs     map[string]string
+}
+
+func (l *live) state() *state {
+	st := &state{
+		vpcs:    maps.Clone(l.vpcs),
+		servers: make(map[string]string),
+		groups:  make(map[string]string),
+		domains: make(map[string]string),
+		lbs:     make(map[string]string),
+	}
+	if st.vpcs == nil {
+		st.vpcs = make(map[string]goVPSie.VPC)
+	}
+	for name, vm := range l.servers {
+		st.servers[name] = vm.Identifier
+	}
+	for name, group := range l.groups {
+		st.groups[name] = group.Identifier
+	}
+	for name, domain := range l.domains {
+		st.domains[name] = domain.Identifier
+	}
+	for name, lb := range l.lbs {
+		st.lbs[name] = lb.Identifier
+	}
+	return st
+}
@@ -0,0 +1,262 @@
+package reconcile
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Manifest describes the desired infrastructure of one project. Resources not
+// in the manifest are left alone; to delete one, declare it with Absent set.
+type Manifest struct {
+	// Project is the identifier of the project servers and domains are
+	// created in. Defaults to Options.Project, then to the client's default
+	// project.
+	Project string `json:"project,omitempty" yaml:"project,omitempty"`
+
+	VPCs           []VPC           `json:"vpcs,omitempty" yaml:"vpcs,omitempty"`
+	Servers        []Server        `json:"servers,omitempty" yaml:"servers,omitempty"`
+	FirewallGroups []FirewallGroup `json:"firewall_groups,omitempty" yaml:"firewall_groups,omitempty"`
+	Domains        []Domain        `json:"domains,omitempty" yaml:"domains,omitempty"`
+	LoadBalancers  []LoadBalancer  `json:"load_balancers,omitempty" yaml:"load_balancers,omitempty"`
+}
+
+// VPC is a private network, identified by its name.
+type VPC struct {
+	Name         string `json:"name" yaml:"name"`
+	DC           string `json:"dc,omitempty" yaml:"dc,omitempty"`
+	NetworkRange string `json:"network_range,omitempty" yaml:"network_range,omitempty"`
+	NetworkSize  string `json:"network_size,omitempty" yaml:"network_size,omitempty"`
+	Description  string `json:"description,omitempty" yaml:"description,omitempty"`
+	Absent       bool   `json:"absent,omitempty" yaml:"absent,omitempty"`
+}
+
+// Server is a server, identified by its hostname within the project.
+//
+// The API does not return the tags, OS, SSH key or script of a server, so
+// they are applied when the server is created and not compared afterwards.
+// Neither is its VPC. CPU and RAM, if set, are resized in place; a change of
+// data center replaces the server.
+type Server struct {
+	Hostname string   `json:"hostname" yaml:"hostname"`
+	Resource string   `json:"resource,omitempty" yaml:"resource,omitempty"`
+	OS       string   `json:"os,omitempty" yaml:"os,omitempty"`
+	DC       string   `json:"dc,omitempty" yaml:"dc,omitempty"`
+	VPC      string   `json:"vpc,omitempty" yaml:"vpc,omitempty"`
+	SSHKey   string   `json:"ssh_key,omitempty" yaml:"ssh_key,omitempty"`
+	Script   string   `json:"script,omitempty" yaml:"script,omitempty"`
+	Notes    string   `json:"notes,omitempty" yaml:"notes,omitempty"`
+	Tags     []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	CPU      int      `json:"cpu,omitempty" yaml:"cpu,omitempty"`
+	RAM      int      `json:"ram,omitempty" yaml:"ram,omitempty"`
+	Absent   bool     `json:"absent,omitempty" yaml:"absent,omitempty"`
+}
+
+// FirewallGroup is a firewall group, identified by its name, with its rules
+// in order and the hostnames of the servers it is attached to. Groups cannot
+// be edited rule by rule, so a change of rules replaces the group.
+type FirewallGroup struct {
+	Name    string         `json:"name" yaml:"name"`
+	Rules   []FirewallRule `json:"rules,omitempty" yaml:"rules,omitempty"`
+	Servers []string       `json:"servers,omitempty" yaml:"servers,omitempty"`
+	Absent  bool           `json:"absent,omitempty" yaml:"absent,omitempty"`
+}
+
+// FirewallRule is a rule of a firewall group. Type defaults to "in" and
+// Action to "ACCEPT".
+type FirewallRule struct {
+	Type     string   `json:"type,omitempty" yaml:"type,omitempty"`
+	Action   string   `json:"action,omitempty" yaml:"action,omitempty"`
+	Proto    string   `json:"proto,omitempty" yaml:"proto,omitempty"`
+	Source   []string `json:"source,omitempty" yaml:"source,omitempty"`
+	Sport    string   `json:"sport,omitempty" yaml:"sport,omitempty"`
+	Dest     []string `json:"dest,omitempty" yaml:"dest,omitempty"`
+	Dport    string   `json:"dport,omitempty" yaml:"dport,omitempty"`
+	Macro    string   `json:"macro,omitempty" yaml:"macro,omitempty"`
+	Comment  string   `json:"comment,omitempty" yaml:"comment,omitempty"`
+	Disabled bool     `json:"disabled,omitempty" yaml:"disabled,omitempty"`
+}
+
+// Domain is a DNS zone, identified by its name.
+//
+// Records are not supported yet: the API can create, update and delete the
+// records of a domain but has no endpoint listing them, so they could not be
+// compared with the manifest. A domain declaring records is rejected rather
+// than having them silently ignored.
+type Domain struct {
+	Domain  string `json:"domain" yaml:"domain"`
+	Records []any  `json:"records,omitempty" yaml:"records,omitempty"`
+	Absent  bool   `json:"absent,omitempty" yaml:"absent,omitempty"`
+}
+
+// LoadBalancer lists the rules of an existing load balancer, identified by
+// its name. Load balancers are not created or deleted; their rules are
+// managed as a whole, identified by front port.
+type LoadBalancer struct {
+	Name  string   `json:"name" yaml:"name"`
+	Rules []LBRule `json:"rules,omitempty" yaml:"rules,omitempty"`
+}
+
+// LBRule forwards a front port to the back port of servers, given by
+// hostname.
+type LBRule struct {
+	Scheme    string   `json:"scheme" yaml:"scheme"`
+	FrontPort int      `json:"front_port" yaml:"front_port"`
+	BackPort  int      `json:"back_port" yaml:"back_port"`
+	Backends  []string `json:"backends,omitempty" yaml:"backends,omitempty"`
+}
+
+// Load reads the manifest at path. See Parse.
+func Load(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return m, nil
+}
+
+// Parse decodes a YAML or JSON manifest, fills in defaults and validates it.
+// Unknown fields are an error.
+func Parse(data []byte) (*Manifest, error) {
+	m := &Manifest{}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(m); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	m.setDefaults()
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *Manifest) setDefaults() {
+	for i := range m.FirewallGroups {
+		for j := range m.FirewallGroups[i].Rules {
+			rule := &m.FirewallGroups[i].Rules[j]
+			if rule.Type == "" {
+				rule.Type = "in"
+			}
+			if rule.Action == "" {
+				rule.Action = "ACCEPT"
+			}
+		}
+	}
+}
+
+// Validate reports missing names, duplicates and references to absent
+// resources. References to resources the manifest does not declare are
+// checked against the live state by NewPlan.
+func (m *Manifest) Validate() error {
+	var errs []error
+	fail := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	vpcs := make(map[string]VPC)
+	for i, vpc := range m.VPCs {
+		switch _, dup := vpcs[vpc.Name]; {
+		case vpc.Name == "":
+			fail("vpcs[%d]: name is required", i)
+		case dup:
+			fail("vpcs[%d]: duplicate name %q", i, vpc.Name)
+		case !vpc.Absent && vpc.DC == "":
+			fail("vpc %s: dc is required", vpc.Name)
+		}
+		vpcs[vpc.Name] = vpc
+	}
+
+	servers := make(map[string]Server)
+	for i, server := range m.Servers {
+		switch _, dup := servers[server.Hostname]; {
+		case server.Hostname == "":
+			fail("servers[%d]: hostname is required", i)
+		case dup:
+			fail("servers[%d]: duplicate hostname %q", i, server.Hostname)
+		case !server.Absent && (server.Resource == "" || server.OS == "" || server.DC == ""):
+			fail("server %s: resource, os and dc are required", server.Hostname)
+		case !server.Absent && server.VPC != "" && vpcs[server.VPC].Absent:
+			fail("server %s: vpc %s is declared absent", server.Hostname, server.VPC)
+		}
+		servers[server.Hostname] = server
+	}
+
+	groups := make(map[string]bool)
+	for i, group := range m.FirewallGroups {
+		switch {
+		case group.Name == "":
+			fail("firewall_groups[%d]: name is required", i)
+		case groups[group.Name]:
+			fail("firewall_groups[%d]: duplicate name %q", i, group.Name)
+		}
+		groups[group.Name] = true
+
+		if group.Absent {
+			continue
+		}
+		for _, hostname := range group.Servers {
+			if servers[hostname].Absent {
+				fail("firewall group %s: server %s is declared absent", group.Name, hostname)
+			}
+		}
+		for j, rule := range group.Rules {
+			if rule.Type != "in" && rule.Type != "out" {
+				fail("firewall group %s: rules[%d]: type must be in or out, got %q", group.Name, j, rule.Type)
+			}
+		}
+	}
+
+	domains := make(map[string]bool)
+	for i, domain := range m.Domains {
+		switch {
+		case domain.Domain == "":
+			fail("domains[%d]: domain is required", i)
+		case domains[domain.Domain]:
+			fail("domains[%d]: duplicate domain %q", i, domain.Domain)
+		case len(domain.Records) > 0:
+			fail("domain %s: records are not supported, since the API cannot list them", domain.Domain)
+		}
+		domains[domain.Domain] = true
+	}
+
+	lbs := make(map[string]bool)
+	for i, lb := range m.LoadBalancers {
+		switch {
+		case lb.Name == "":
+			fail("load_balancers[%d]: name is required", i)
+		case lbs[lb.Name]:
+			fail("load_balancers[%d]: duplicate name %q", i, lb.Name)
+		}
+		lbs[lb.Name] = true
+
+		ports := make(map[int]bool)
+		for j, rule := range lb.Rules {
+			switch {
+			case rule.Scheme == "" || rule.FrontPort == 0 || rule.BackPort == 0:
+				fail("load balancer %s: rules[%d]: scheme, front_port and back_port are required", lb.Name, j)
+			case ports[rule.FrontPort]:
+				fail("load balancer %s: rules[%d]: duplicate front port %d", lb.Name, j, rule.FrontPort)
+			}
+			ports[rule.FrontPort] = true
+
+			for _, hostname := range rule.Backends {
+				if servers[hostname].Absent {
+					fail("load balancer %s: server %s is declared absent", lb.Name, hostname)
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
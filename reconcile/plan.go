@@ -0,0 +1,312 @@
+// Package reconcile brings VPSie resources in line with a declarative
+// manifest.
+//
+// A Manifest lists VPCs, servers, firewall groups with their rules, domains
+// and the rules of load balancers. NewPlan reads the
+// live state through the List and Get methods of a goVPSie.Client and
+// compares it with the manifest, and Plan.Apply makes the changes with the
+// create, update and delete methods:
+//
+//	m, err := reconcile.Load("infra.yaml")
+//	...
+//	plan, err := reconcile.NewPlan(ctx, client, m, nil)
+//	...
+//	fmt.Print(plan)
+//	results, err := plan.Apply(ctx)
+//
+// Resources are identified by name only: VPCs, firewall groups and load
+// balancers by their name, servers by their hostname within the project and
+// domains by their domain name. Servers cannot be matched by tag instead: the
+// server list carries no tags, and goVPSie.VmTags, the tags returned for a
+// single server, declares no fields, so the tags of a live server cannot be
+// read back. Resources the manifest does not mention are never touched;
+// declare one with Absent set to delete it.
+//
+// Changes are applied one at a time in dependency order: VPCs before the
+// servers in them, servers before the firewall groups and load balancer
+// rules that use them, and deletions last in
+// the reverse order. A failed change does not stop the others, but the
+// changes depending on it are skipped; Apply reports both in an *ApplyError.
+package reconcile
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+)
+
+// Action is what a change does to a resource. Its value is the symbol
+// printed in front of the change.
+type Action string
+
+const (
+	Create  Action = "+"
+	Update  Action = "~"
+	Replace Action = "-/+"
+	Delete  Action = "-"
+)
+
+// Kind is the type of resource a change applies to.
+type Kind string
+
+const (
+	KindVPC           Kind = "vpc"
+	KindServer        Kind = "server"
+	KindFirewallGroup Kind = "firewall_group"
+	KindDomain        Kind = "domain"
+	KindLBRule        Kind = "lb_rule"
+
+	// kindLoadBalancer names load balancers in errors. They are not changed
+	// themselves.
+	kindLoadBalancer Kind = "load_balancer"
+)
+
+// kindOrder is the order in which kinds are created and updated. Deletions
+// run in the reverse order.
+var kindOrder = []Kind{KindVPC, KindServer, KindFirewallGroup, KindDomain, KindLBRule}
+
+// Change is one step of a plan.
+type Change struct {
+	Kind   Kind
+	Name   string
+	Action Action
+
+	// Diff describes the change in lines such as "ram: 2048 -> 4096" or
+	// "+ backend: web-1".
+	Diff []string
+
+	// Requires lists the changes that must succeed before this one.
+	Requires []*Change
+
+	apply func(context.Context, *state) error
+}
+
+func (c *Change) String() string {
+	return fmt.Sprintf("%s %s %s", c.Action, c.Kind, c.Name)
+}
+
+// Options configures NewPlan. The zero value is ready to use.
+type Options struct {
+	// Project is the project of the manifest if it names none. Defaults to
+	// the client's default project.
+	Project string
+
+	// Wait configures the wait for new servers to be created, which their
+	// dependents need. Nil polls every five seconds without a timeout.
+	Wait *goVPSie.WaitOptions
+
+	// ReplaceServers allows the plan to replace servers declared in another
+	// data center than their live one, deleting them with their data.
+	// Without it, NewPlan fails for such servers.
+	ReplaceServers bool
+
+	// Password confirms the deletion of servers. Reason and Note are
+	// recorded with the deletion of servers, VPCs and domains.
+	Password string
+	Reason   string
+	Note     string
+}
+
+// Plan is the list of changes bringing the live state in line with a
+// manifest, in the order Apply makes them.
+type Plan struct {
+	Changes []*Change
+
+	state *state
+}
+
+// Empty reports whether the live state already matches the manifest.
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String describes the changes, one resource per paragraph with its diff
+// indented below it, followed by a summary line.
+func (p *Plan) String() string {
+	if p.Empty() {
+		return "No changes.\n"
+	}
+
+	var b strings.Builder
+	counts := make(map[Action]int)
+	for _, c := range p.Changes {
+		counts[c.Action]++
+		fmt.Fprintln(&b, c)
+		for _, line := range c.Diff {
+			fmt.Fprintf(&b, "    %s\n", line)
+		}
+	}
+	fmt.Fprintf(&b, "\nPlan: %d to create, %d to update, %d to replace, %d to delete.\n",
+		counts[Create], counts[Update], counts[Replace], counts[Delete])
+	return b.String()
+}
+
+// NewPlan compares m with the live state read through client and returns the
+// changes to apply. It fails if the manifest references resources that are
+// neither declared nor live, or if a name matches several live resources.
+func NewPlan(ctx context.Context, client *goVPSie.Client, m *Manifest, opts *Options) (*Plan, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	p := &planner{client: client, m: m, byKey: make(map[string]*Change)}
+	if opts != nil {
+		p.opts = *opts
+	}
+	p.project = cmp.Or(m.Project, p.opts.Project, client.DefaultProject())
+
+	live, err := fetch(ctx, client, m, p.project)
+	if err != nil {
+		return nil, err
+	}
+	p.live = live
+
+	for _, plan := range []func() error{p.planVPCs, p.planServers, p.planFirewallGroups, p.planDomains, p.planLBs} {
+		if err := plan(); err != nil {
+			return nil, err
+		}
+	}
+
+	slices.SortStableFunc(p.changes, func(a, b *Change) int {
+		if c := cmp.Compare(phase(a), phase(b)); c != 0 {
+			return c
+		}
+		if a.Action == Delete {
+			return cmp.Compare(rank(b.Kind), rank(a.Kind))
+		}
+		return cmp.Compare(rank(a.Kind), rank(b.Kind))
+	})
+
+	return &Plan{Changes: p.changes, state: live.state()}, nil
+}
+
+func phase(c *Change) int {
+	if c.Action == Delete {
+		return 1
+	}
+	return 0
+}
+
+func rank(k Kind) int {
+	return slices.Index(kindOrder, k)
+}
+
+// planner accumulates the changes of a plan.
+type planner struct {
+	client  *goVPSie.Client
+	m       *Manifest
+	opts    Options
+	project string
+	live    *live
+	changes []*Change
+
+	// byKey finds the change of a resource by kind and name, so that its
+	// dependents can require it.
+	byKey map[string]*Change
+}
+
+func (p *planner) add(c *Change) {
+	p.changes = append(p.changes, c)
+	p.byKey[string(c.Kind)+"/"+c.Name] = c
+}
+
+// creating returns the change creating or replacing the resource, or nil if
+// the resource is left in place.
+func (p *planner) creating(kind Kind, name string) *Change {
+	c := p.byKey[string(kind)+"/"+name]
+	if c == nil || (c.Action != Create && c.Action != Replace) {
+		return nil
+	}
+	return c
+}
+
+// requireProject fails if the plan creates resources without a project to
+// put them in.
+func (p *planner) requireProject(kind Kind, name string) error {
+	if p.project == "" {
+		return fmt.Errorf("%s %s: no project: set it in the manifest, the options or the client", kind, name)
+	}
+	return nil
+}
+
+// requires appends to deps the change creating each resource in names, if
+// any.
+func (p *planner) requires(deps []*Change, kind Kind, names ...string) []*Change {
+	for _, name := range names {
+		if c := p.creating(kind, name); c != nil && !slices.Contains(deps, c) {
+			deps = append(deps, c)
+		}
+	}
+	return deps
+}
+
+// attrs returns the diff lines of the non-empty values of a new resource.
+func attrs(kv ...any) []string {
+	var lines []string
+	for i := 0; i < len(kv); i += 2 {
+		if v := fmt.Sprint(kv[i+1]); v != "" && v != "0" && v != "[]" {
+			lines = append(lines, fmt.Sprintf("%s: %s", kv[i], v))
+		}
+	}
+	return lines
+}
+
+// changed returns the diff line of a value going from have to want.
+func changed(name string, have, want any) string {
+	return fmt.Sprintf("%s: %v -> %v", name, have, want)
+}
+
+// listDiff returns the diff lines turning the ordered list have into want,
+// using their longest common subsequence.
+func listDiff(name string, have, want []string) []string {
+	lcs := make([][]int, len(have)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(want)+1)
+	}
+	for i := len(have) - 1; i >= 0; i-- {
+		for j := len(want) - 1; j >= 0; j-- {
+			if have[i] == want[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var lines []string
+	i, j := 0, 0
+	for i < len(have) || j < len(want) {
+		switch {
+		case i < len(have) && j < len(want) && have[i] == want[j]:
+			i, j = i+1, j+1
+		case i < len(have) && (j == len(want) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, fmt.Sprintf("- %s: %s", name, have[i]))
+			i++
+		default:
+			lines = append(lines, fmt.Sprintf("+ %s: %s", name, want[j]))
+			j++
+		}
+	}
+	return lines
+}
+
+// setDiff returns the diff lines turning the set have into want, in the
+// order of want and then of have.
+func setDiff(name string, have, want []string) []string {
+	var lines []string
+	for _, v := range want {
+		if !slices.Contains(have, v) {
+			lines = append(lines, fmt.Sprintf("+ %s: %s", name, v))
+		}
+	}
+	for _, v := range have {
+		if !slices.Contains(want, v) {
+			lines = append(lines, fmt.Sprintf("- %s: %s", name, v))
+		}
+	}
+	return lines
+}
@@ -0,0 +1,294 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+	"github.com/ahmedabdelkader99/goVPSie/govpsietest"
+)
+
+const manifest = `
+project: proj-1
+vpcs:
+  - name: private
+    dc: dc-1
+    network_range: 10.0.0.0
+    network_size: "24"
+servers:
+  - hostname: web-1
+    resource: plan-1
+    os: os-1
+    dc: dc-1
+    vpc: private
+    cpu: 2
+    ram: 2048
+  - hostname: web-2
+    resource: plan-1
+    os: os-1
+    dc: dc-1
+firewall_groups:
+  - name: web
+    rules:
+      - {proto: tcp, dport: "22", source: [10.0.0.0/24]}
+      - {proto: tcp, dport: "443"}
+    servers: [web-1, web-2]
+domains:
+  - domain: example.com
+load_balancers:
+  - name: front
+    rules:
+      - {scheme: http, front_port: 80, back_port: 8080, backends: [web-1, web-2]}
+`
+
+var testOptions = &Options{Wait: &goVPSie.WaitOptions{PollInterval: time.Millisecond}}
+
+func newFake(t *testing.T) (*govpsietest.Server, *goVPSie.Client) {
+	t.Helper()
+	fake := govpsietest.NewServer()
+	t.Cleanup(fake.Close)
+	fake.AddLB(goVPSie.LBDetails{LBName: "front"})
+	return fake, fake.Client()
+}
+
+func mustParse(t *testing.T, data string) *Manifest {
+	t.Helper()
+	m, err := Parse([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// plan returns the plan of m against client, failing the test on error.
+func plan(t *testing.T, client *goVPSie.Client, m *Manifest) *Plan {
+	t.Helper()
+	p, err := NewPlan(context.Background(), client, m, testOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// assertOrder checks that the lines appear in text in order.
+func assertOrder(t *testing.T, text string, lines ...string) {
+	t.Helper()
+	last := -1
+	for _, line := range lines {
+		i := strings.Index(text, line)
+		if i < 0 {
+			t.Errorf("missing %q in:\n%s", line, text)
+			return
+		}
+		if i < last {
+			t.Errorf("%q out of order in:\n%s", line, text)
+		}
+		last = i
+	}
+}
+
+func TestApply(t *testing.T) {
+	ctx := context.Background()
+	fake, client := newFake(t)
+
+	p := plan(t, client, mustParse(t, manifest))
+	assertOrder(t, p.String(),
+		"+ vpc private\n    dc: dc-1\n    network_range: 10.0.0.0\n",
+		"+ server web-1\n",
+		"+ server web-2\n",
+		"+ firewall_group web\n    + rule: in ACCEPT proto=tcp source=10.0.0.0/24 dport=22\n",
+		"+ domain example.com\n",
+		"+ lb_rule front:80\n    scheme: http\n    back_port: 8080\n    + backend: web-1\n",
+		"Plan: 6 to create, 0 to update, 0 to replace, 0 to delete.",
+	)
+	if _, err := p.Apply(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if p := plan(t, client, mustParse(t, manifest)); !p.Empty() {
+		t.Fatalf("expected no changes after apply, got:\n%s", p)
+	}
+	if servers := fake.Servers(); len(servers) != 2 || servers[0].Cpu != 2 || servers[0].Ram != 2048 {
+		t.Errorf("unexpected servers %+v", servers)
+	}
+	if groups := fake.FirewallGroups(); len(groups) != 1 || len(groups[0].Vms) != 2 {
+		t.Errorf("unexpected firewall groups %+v", groups)
+	}
+	if lb := fake.LBs()[0]; len(lb.Rules) != 1 || len(lb.Rules[0].Backends) != 2 {
+		t.Errorf("unexpected load balancer %+v", lb)
+	}
+
+	changed := strings.NewReplacer(
+		"ram: 2048", "ram: 4096",
+		`dport: "443"`, `dport: "8443"`,
+		"servers: [web-1, web-2]", "servers: [web-1]",
+		"back_port: 8080, backends: [web-1, web-2]", "back_port: 9090, backends: [web-1]",
+		"    dc: dc-1\nfirewall_groups", "    dc: dc-1\n    absent: true\nfirewall_groups",
+	).Replace(manifest)
+	p = plan(t, client, mustParse(t, changed))
+	assertOrder(t, p.String(),
+		"~ server web-1\n    ram: 2048 -> 4096\n",
+		"-/+ firewall_group web\n    - rule: in ACCEPT proto=tcp dport=443\n    + rule: in ACCEPT proto=tcp dport=8443\n    - server: web-2\n",
+		"~ lb_rule front:80\n    back_port: 8080 -> 9090\n    - backend: web-2\n",
+		"- server web-2\n",
+		"Plan: 0 to create, 2 to update, 1 to replace, 1 to delete.",
+	)
+	if _, err := p.Apply(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if p := plan(t, client, mustParse(t, changed)); !p.Empty() {
+		t.Fatalf("expected no changes after apply, got:\n%s", p)
+	}
+}
+
+func TestApplyPartialFailure(t *testing.T) {
+	ctx := context.Background()
+	fake, client := newFake(t)
+	fake.AddFault(govpsietest.Fault{Method: http.MethodPost, PathPrefix: "/apps/v2/vpc/add", Status: http.StatusBadRequest, Times: 1})
+
+	results, err := plan(t, client, mustParse(t, manifest)).Apply(ctx)
+	var applyErr *ApplyError
+	if !errors.As(err, &applyErr) {
+		t.Fatalf("expected an ApplyError, got %v", err)
+	}
+	if len(results) != 6 || len(applyErr.Failed) != 4 {
+		t.Fatalf("expected 4 of 6 changes to fail, got %v", err)
+	}
+	if !errors.Is(err, goVPSie.ErrSkipped) {
+		t.Errorf("expected skipped changes in %v", err)
+	}
+
+	failed := make(map[string]error)
+	for _, r := range applyErr.Failed {
+		failed[r.Change.String()] = r.Err
+	}
+	for _, skipped := range []string{"+ server web-1", "+ firewall_group web", "+ lb_rule front:80"} {
+		if !errors.Is(failed[skipped], goVPSie.ErrSkipped) {
+			t.Errorf("%s: expected it to be skipped, got %v", skipped, failed[skipped])
+		}
+	}
+	if err := failed["+ vpc private"]; err == nil || errors.Is(err, goVPSie.ErrSkipped) {
+		t.Errorf("vpc: expected the injected failure, got %v", err)
+	}
+
+	// The changes that succeeded are not planned again.
+	p := plan(t, client, mustParse(t, manifest))
+	var changes []string
+	for _, c := range p.Changes {
+		changes = append(changes, c.String())
+	}
+	if got := strings.Join(changes, ", "); got != "+ vpc private, + server web-1, + firewall_group web, + lb_rule front:80" {
+		t.Errorf("unexpected plan after a partial failure: %s", got)
+	}
+	if _, err := p.Apply(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	m := mustParse(t, `{"firewall_groups": [{"name": "web", "rules": [{"proto": "tcp", "dport": "22"}]}]}`)
+	if r := m.FirewallGroups[0].Rules[0]; r.Type != "in" || r.Action != "ACCEPT" {
+		t.Errorf("defaults not applied: %+v", r)
+	}
+
+	_, err := Parse([]byte(`
+servers:
+  - {hostname: web-1, resource: r, os: o, dc: d, vpc: private}
+  - {hostname: web-1, resource: r, os: o, dc: d}
+vpcs:
+  - {name: private, absent: true}
+load_balancers:
+  - name: front
+    rules: [{scheme: http, front_port: 80}]
+domains:
+  - domain: example.com
+    records: [{name: www, type: A, content: 192.0.2.1}]
+`))
+	for _, want := range []string{
+		`servers[1]: duplicate hostname "web-1"`,
+		"server web-1: vpc private is declared absent",
+		"load balancer front: rules[0]: scheme, front_port and back_port are required",
+		"domain example.com: records are not supported",
+	} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("expected %q in %v", want, err)
+		}
+	}
+
+	for _, data := range []string{
+		"servers: [{hostname: web-1, size: big}]",
+	} {
+		if _, err := Parse([]byte(data)); err == nil {
+			t.Errorf("%s: expected an error for an unknown field", data)
+		}
+	}
+}
+
+func TestReplaceFirewallGroup(t *testing.T) {
+	ctx := context.Background()
+	fake, client := newFake(t)
+	if _, err := plan(t, client, mustParse(t, manifest)).Apply(ctx); err != nil {
+		t.Fatal(err)
+	}
+	old := fake.FirewallGroups()[0].Group.Identifier
+
+	// The old group stays attached when the new one cannot be.
+	changed := strings.Replace(manifest, `dport: "443"`, `dport: "8443"`, 1)
+	fake.AddFault(govpsietest.Fault{Method: http.MethodPost, PathPrefix: "/apps/v2/firewall/attach/group", Status: http.StatusBadRequest, Times: 1})
+	if _, err := plan(t, client, mustParse(t, changed)).Apply(ctx); err == nil {
+		t.Fatal("expected the failed attach to fail the replacement")
+	}
+	var kept bool
+	for _, group := range fake.FirewallGroups() {
+		kept = kept || (group.Group.Identifier == old && len(group.Vms) == 2)
+	}
+	if !kept {
+		t.Errorf("the replaced group was not kept: %+v", fake.FirewallGroups())
+	}
+}
+
+func TestReplaceServer(t *testing.T) {
+	ctx := context.Background()
+	fake, client := newFake(t)
+	fake.AddServer(goVPSie.VmData{Hostname: "db", DcIdentifier: "dc-1", ProjectID: "proj-1"})
+	fake.AddServer(goVPSie.VmData{Hostname: "cache", ProjectID: "proj-1"})
+	m := mustParse(t, `
+project: proj-1
+servers:
+  - {hostname: db, resource: plan-1, os: os-1, dc: dc-2}
+  - {hostname: cache, resource: plan-1, os: os-1, dc: dc-2}
+`)
+
+	_, err := NewPlan(ctx, client, m, testOptions)
+	if err == nil || !strings.Contains(err.Error(), "server db: moving it from dc dc-1 to dc-2 replaces it") {
+		t.Fatalf("expected the replacement to need the option, got %v", err)
+	}
+
+	opts := *testOptions
+	opts.ReplaceServers = true
+	p, err := NewPlan(ctx, client, m, &opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := p.String(); !strings.Contains(got, "-/+ server db\n    dc: dc-1 -> dc-2\n") || strings.Contains(got, "cache") {
+		t.Errorf("unexpected plan:\n%s", got)
+	}
+}
+
+func TestReplaceVPC(t *testing.T) {
+	ctx := context.Background()
+	_, client := newFake(t)
+	if _, err := plan(t, client, mustParse(t, manifest)).Apply(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	changed := strings.Replace(manifest, "network_range: 10.0.0.0", "network_range: 10.1.0.0", 1)
+	_, err := NewPlan(ctx, client, mustParse(t, changed), testOptions)
+	if err == nil || !strings.Contains(err.Error(), "vpc private: replacing it would disconnect server web-1") {
+		t.Errorf("expected the replacement to be refused, got %v", err)
+	}
+}
@@ -0,0 +1,198 @@
+package reconcile
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+)
+
+func (p *planner) planServers() error {
+	for _, want := range p.m.Servers {
+		have, exists := p.live.servers[want.Hostname]
+		switch {
+		case want.Absent && exists:
+			c := &Change{Kind: KindServer, Name: want.Hostname, Action: Delete, apply: p.deleteServer(want.Hostname)}
+			p.add(c)
+			// The VPC of the server, if deleted too, must wait for it.
+			if vpc := p.byKey[string(KindVPC)+"/"+want.VPC]; vpc != nil && vpc.Action == Delete {
+				vpc.Requires = append(vpc.Requires, c)
+			}
+
+		case want.Absent:
+
+		case !exists:
+			if err := p.checkServer(want); err != nil {
+				return err
+			}
+			p.add(&Change{
+				Kind:   KindServer,
+				Name:   want.Hostname,
+				Action: Create,
+				Diff: attrs("resource", want.Resource, "os", want.OS, "dc", want.DC, "vpc", want.VPC,
+					"cpu", want.CPU, "ram", want.RAM, "tags", strings.Join(want.Tags, ", ")),
+				Requires: p.requires(nil, KindVPC, want.VPC),
+				apply:    p.createServer(want),
+			})
+
+		// A server moves to another data center only by being deleted with
+		// its data and created again, which the options must allow. A live
+		// server without a data center is left in place.
+		case have.DcIdentifier != "" && want.DC != have.DcIdentifier:
+			if !p.opts.ReplaceServers {
+				return fmt.Errorf("server %s: moving it from dc %s to %s replaces it; set ReplaceServers in the options to allow it",
+					want.Hostname, have.DcIdentifier, want.DC)
+			}
+			if err := p.checkServer(want); err != nil {
+				return err
+			}
+			deleteServer, createServer := p.deleteServer(want.Hostname), p.createServer(want)
+			p.add(&Change{
+				Kind:     KindServer,
+				Name:     want.Hostname,
+				Action:   Replace,
+				Diff:     []string{changed("dc", have.DcIdentifier, want.DC)},
+				Requires: p.requires(nil, KindVPC, want.VPC),
+				apply: func(ctx context.Context, st *state) error {
+					if err := deleteServer(ctx, st); err != nil {
+						return err
+					}
+					return createServer(ctx, st)
+				},
+			})
+
+		default:
+			if diff := resizeDiff(want, have); len(diff) > 0 {
+				p.add(&Change{Kind: KindServer, Name: want.Hostname, Action: Update, Diff: diff, apply: func(ctx context.Context, st *state) error {
+					return p.resize(ctx, st.servers[want.Hostname], want, have)
+				}})
+			}
+		}
+	}
+	return nil
+}
+
+// checkServer reports what keeps want from being created.
+func (p *planner) checkServer(want Server) error {
+	if err := p.requireProject(KindServer, want.Hostname); err != nil {
+		return err
+	}
+	if want.VPC == "" {
+		return nil
+	}
+	if _, ok := p.live.vpcs[want.VPC]; !ok && p.creating(KindVPC, want.VPC) == nil {
+		return fmt.Errorf("server %s: vpc %s is neither declared nor live", want.Hostname, want.VPC)
+	}
+	return nil
+}
+
+// resizeDiff returns the diff of the CPU and RAM of have to the ones want
+// sets.
+func resizeDiff(want Server, have goVPSie.VmData) []string {
+	var diff []string
+	if want.CPU != 0 && int64(want.CPU) != have.Cpu {
+		diff = append(diff, changed("cpu", have.Cpu, want.CPU))
+	}
+	if want.RAM != 0 && int64(want.RAM) != have.Ram {
+		diff = append(diff, changed("ram", have.Ram, want.RAM))
+	}
+	return diff
+}
+
+// resize sets the CPU and RAM of the server to the ones want sets, keeping
+// the ones of have otherwise.
+func (p *planner) resize(ctx context.Context, identifier string, want Server, have goVPSie.VmData) error {
+	cpu := cmp.Or(int64(want.CPU), have.Cpu)
+	ram := cmp.Or(int64(want.RAM), have.Ram)
+	return p.client.Server.ResizeServer(ctx, identifier, strconv.FormatInt(cpu, 10), strconv.FormatInt(ram, 10))
+}
+
+func (p *planner) createServer(want Server) func(context.Context, *state) error {
+	return func(ctx context.Context, st *state) error {
+		req := &goVPSie.CreateServerRequest{
+			ResourceIdentifier: want.Resource,
+			OsIdentifier:       want.OS,
+			DcIdentifier:       want.DC,
+			Hostname:           want.Hostname,
+			ProjectID:          p.project,
+		}
+		if want.Notes != "" {
+			req.Notes = &want.Notes
+		}
+		if want.SSHKey != "" {
+			req.SshKeyIdentifier = &want.SSHKey
+		}
+		if want.Script != "" {
+			req.ScriptIdentifier = &want.Script
+		}
+		for _, tag := range want.Tags {
+			req.Tags = append(req.Tags, &tag)
+		}
+
+		result, err := p.client.Server.CreateServerWithResult(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		identifier := result.Identifier
+		if result.ProcessID != "" || identifier != "" {
+			vm, err := p.client.Server.WaitForCreation(ctx, result, p.opts.Wait)
+			if err != nil {
+				return err
+			}
+			identifier = vm.Identifier
+		} else if identifier, err = p.findServer(ctx, want.Hostname); err != nil {
+			return err
+		}
+		st.servers[want.Hostname] = identifier
+
+		if want.VPC != "" {
+			if err := p.client.VPC.AssignServer(ctx, &goVPSie.AssignServerReq{
+				VmIdentifier: identifier,
+				VpcID:        st.vpcs[want.VPC].ID,
+				DcIdentifier: want.DC,
+			}); err != nil {
+				return fmt.Errorf("assigning vpc %s: %w", want.VPC, err)
+			}
+		}
+
+		if want.CPU == 0 && want.RAM == 0 {
+			return nil
+		}
+		have, err := p.client.Server.GetServerByIdentifier(ctx, identifier)
+		if err != nil {
+			return err
+		}
+		if len(resizeDiff(want, *have)) == 0 {
+			return nil
+		}
+		return p.resize(ctx, identifier, want, *have)
+	}
+}
+
+// findServer returns the identifier of the server with hostname in the
+// project, for creations that did not return one.
+func (p *planner) findServer(ctx context.Context, hostname string) (string, error) {
+	for vm, err := range p.client.Server.AllByProject(ctx, p.project) {
+		if err != nil {
+			return "", err
+		}
+		if vm.Hostname == hostname {
+			return vm.Identifier, nil
+		}
+	}
+	return "", fmt.Errorf("created server %s not found", hostname)
+}
+
+func (p *planner) deleteServer(hostname string) func(context.Context, *state) error {
+	return func(ctx context.Context, st *state) error {
+		if err := p.client.Server.DeleteServer(ctx, st.servers[hostname], p.opts.Password, p.opts.Reason, p.opts.Note); err != nil {
+			return err
+		}
+		delete(st.servers, hostname)
+		return nil
+	}
+}
@@ -0,0 +1,110 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	goVPSie "github.com/ahmedabdelkader99/goVPSie"
+)
+
+func (p *planner) planVPCs() error {
+	for _, want := range p.m.VPCs {
+		have, exists := p.live.vpcs[want.Name]
+		switch {
+		case want.Absent && exists:
+			p.add(&Change{Kind: KindVPC, Name: want.Name, Action: Delete, apply: p.deleteVPC(want.Name)})
+
+		case want.Absent:
+
+		case !exists:
+			p.add(&Change{
+				Kind:   KindVPC,
+				Name:   want.Name,
+				Action: Create,
+				Diff:   attrs("dc", want.DC, "network_range", want.NetworkRange, "network_size", want.NetworkSize, "description", want.Description),
+				apply:  p.createVPC(want),
+			})
+
+		default:
+			// VPCs cannot be edited, so any difference in their network
+			// replaces them.
+			var diff []string
+			if want.DC != have.DcIdentifier {
+				diff = append(diff, changed("dc", have.DcIdentifier, want.DC))
+			}
+			if want.NetworkRange != "" && want.NetworkRange != have.NetworkRange {
+				diff = append(diff, changed("network_range", have.NetworkRange, want.NetworkRange))
+			}
+			if want.NetworkSize != "" && want.NetworkSize != have.NetworkSize {
+				diff = append(diff, changed("network_size", have.NetworkSize, want.NetworkSize))
+			}
+			if len(diff) > 0 {
+				if err := p.checkVPCMembers(want.Name); err != nil {
+					return err
+				}
+				deleteVPC, createVPC := p.deleteVPC(want.Name), p.createVPC(want)
+				p.add(&Change{Kind: KindVPC, Name: want.Name, Action: Replace, Diff: diff, apply: func(ctx context.Context, st *state) error {
+					if err := deleteVPC(ctx, st); err != nil {
+						return err
+					}
+					return createVPC(ctx, st)
+				}})
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkVPCMembers fails if a live server declared in the VPC called name
+// would be cut off by replacing it. Servers the manifest does not mention are
+// not known to be members, since the API does not list them.
+func (p *planner) checkVPCMembers(name string) error {
+	for _, server := range p.m.Servers {
+		if _, live := p.live.servers[server.Hostname]; live && server.VPC == name {
+			return fmt.Errorf("vpc %s: replacing it would disconnect server %s; move its servers out of it first", name, server.Hostname)
+		}
+	}
+	return nil
+}
+
+func (p *planner) createVPC(want VPC) func(context.Context, *state) error {
+	return func(ctx context.Context, st *state) error {
+		req := &goVPSie.CreateVpcReq{
+			Name:         want.Name,
+			Description:  want.Description,
+			DcIdentifier: want.DC,
+			NetworkRange: want.NetworkRange,
+			NetworkSize:  want.NetworkSize,
+		}
+		if want.NetworkRange == "" {
+			req.AutoGenerate = 1
+		}
+		if err := p.client.VPC.CreateVpc(ctx, req); err != nil {
+			return err
+		}
+
+		// CreateVpc does not return the new VPC, so look it up by name.
+		for vpc, err := range p.client.VPC.All(ctx) {
+			if err != nil {
+				return err
+			}
+			if vpc.Name == want.Name && vpc.DcIdentifier == want.DC {
+				st.vpcs[want.Name] = vpc
+				return nil
+			}
+		}
+		return fmt.Errorf("created vpc %s not found", want.Name)
+	}
+}
+
+func (p *planner) deleteVPC(name string) func(context.Context, *state) error {
+	return func(ctx context.Context, st *state) error {
+		if err := p.client.VPC.DeleteVpc(ctx, strconv.Itoa(st.vpcs[name].ID), p.opts.Reason, p.opts.Note); err != nil {
+			return err
+		}
+		delete(st.vpcs, name)
+		return nil
+	}
+}
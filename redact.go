@@ -0,0 +1,96 @@
+package goVPSie
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// Redacted replaces secret values in logged and recorded payloads.
+const Redacted = "REDACTED"
+
+// secretFields are the JSON fields, compared case-insensitively and ignoring
+// underscores, whose string values are secrets: VmData.InitialPassword,
+// Bucket.SecretKey, SShKey.PrivateKey, LoginReq.ClientSecret and the login
+// tokens. Every field whose name contains "password" is a secret as well.
+var secretFields = []string{"secretkey", "privatekey", "clientsecret", "token", "refreshtoken", "accesstoken"}
+
+// secretHeaders carry credentials.
+var secretHeaders = []string{authHeader, "Authorization", "Cookie", "Set-Cookie"}
+
+// RedactJSON returns body with the string values of secret fields replaced
+// by Redacted, at any depth. A body that is not JSON is returned unchanged.
+func RedactJSON(body []byte) []byte {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return body
+	}
+
+	var v any
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return body
+	}
+
+	if !redactValue(v) {
+		return body
+	}
+
+	redacted, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+
+	return redacted
+}
+
+// RedactHeader returns a copy of h with credential headers replaced by
+// Redacted.
+func RedactHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for _, name := range secretHeaders {
+		if h.Get(name) != "" {
+			h.Set(name, Redacted)
+		}
+	}
+
+	return h
+}
+
+// redactValue redacts v in place and reports whether anything changed.
+func redactValue(v any) bool {
+	changed := false
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if _, ok := value.(string); ok && secretField(key) {
+				v[key] = Redacted
+				changed = true
+				continue
+			}
+			changed = redactValue(value) || changed
+		}
+	case []any:
+		for _, value := range v {
+			changed = redactValue(value) || changed
+		}
+	}
+
+	return changed
+}
+
+func secretField(name string) bool {
+	name = strings.ToLower(strings.ReplaceAll(name, "_", ""))
+	if strings.Contains(name, "password") {
+		return true
+	}
+
+	for _, field := range secretFields {
+		if name == field {
+			return true
+		}
+	}
+
+	return false
+}
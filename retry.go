@@ -0,0 +1,181 @@
+package goVPSie
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// maxRetryAfter bounds the delay a Retry-After header can impose when the
+// policy sets no MaxBackoff.
+const maxRetryAfter = 5 * time.Minute
+
+// RetryPolicy controls how Client.Do retries failed requests. The zero value
+// disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values below 2 disable retries.
+	MaxAttempts int
+
+	// BaseBackoff is the delay before the first retry. It doubles on every
+	// following attempt up to MaxBackoff, which also bounds the delay asked
+	// for by a Retry-After header.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+
+	// Jitter is the fraction (0..1) of each backoff that is randomized to keep
+	// concurrent clients from retrying in lockstep.
+	Jitter float64
+
+	// RetryableStatuses lists the HTTP statuses that are retried.
+	RetryableStatuses []int
+
+	// RetryableMethods lists the HTTP methods that are retried. POST is left out
+	// of the default policy because creating resources is not idempotent; see
+	// Client.SetIdempotency for retrying create calls safely.
+	RetryableMethods []string
+}
+
+// DefaultRetryPolicy returns a policy that retries idempotent requests on
+// throttling and transient gateway errors.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 4,
+		BaseBackoff: 500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+		Jitter:      0.2,
+		RetryableStatuses: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryableMethods: []string{
+			http.MethodGet,
+			http.MethodHead,
+			http.MethodOptions,
+			http.MethodPut,
+			http.MethodDelete,
+		},
+	}
+}
+
+// SetRetryPolicy Overrides the retry policy used by Do
+func (c *Client) SetRetryPolicy(policy RetryPolicy) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.retry = policy
+}
+
+// enabled reports whether a request may be retried after attempt. Create
+// calls safe to retry, see Client.SetIdempotency, are retried whatever their
+// method.
+func (p RetryPolicy) enabled(method string, attempt int, idempotent bool) bool {
+	return attempt < p.MaxAttempts && (idempotent || slices.Contains(p.RetryableMethods, method))
+}
+
+func (p RetryPolicy) retryableStatus(status int) bool {
+	return slices.Contains(p.RetryableStatuses, status)
+}
+
+// retryableError reports whether a transport error is worth another attempt:
+// a timeout, as with http.Client.Timeout, a connection refused or reset, or a
+// response cut short. Errors that would fail the same way again, such as a
+// malformed URL, an untrusted certificate or a body that cannot be replayed,
+// are not retried, and neither is any error once the caller's context is done.
+func (p RetryPolicy) retryableError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var netErr net.Error
+	switch {
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return true
+	case errors.Is(err, syscall.ECONNREFUSED), errors.Is(err, syscall.ECONNRESET):
+		return true
+	case errors.Is(err, io.ErrUnexpectedEOF), errors.Is(err, io.EOF):
+		return true
+	}
+	return false
+}
+
+// retryAfterDelay bounds a delay asked for by a Retry-After header to
+// MaxBackoff, or to maxRetryAfter if the policy has no MaxBackoff.
+func (p RetryPolicy) retryAfterDelay(after time.Duration) time.Duration {
+	limit := p.MaxBackoff
+	if limit <= 0 {
+		limit = maxRetryAfter
+	}
+	return min(after, limit)
+}
+
+// backoff returns the delay before the given retry attempt (1 for the first retry).
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.BaseBackoff
+	for i := 1; i < retry && (p.MaxBackoff <= 0 || delay < p.MaxBackoff); i++ {
+		delay *= 2
+	}
+
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+
+	if p.Jitter > 0 && delay > 0 {
+		delay -= time.Duration(rand.Float64() * p.Jitter * float64(delay))
+	}
+
+	return delay
+}
+
+// retryAfter parses the Retry-After header, which is either a number of
+// seconds or an HTTP date.
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+
+	value := res.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
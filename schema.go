@@ -0,0 +1,313 @@
+package goVPSie
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// SchemaDrift describes how an API response differed from the Go type it was
+// decoded into. Field paths are dotted JSON names, with "[]" marking the
+// elements of an array: "data[].vmData.hostname".
+type SchemaDrift struct {
+	// Endpoint is the method and URL path of the request, with identifiers
+	// replaced by {id}: "GET /apps/v2/vm/{id}".
+	Endpoint string
+
+	// Type is the Go type the response was decoded into.
+	Type string
+
+	// Unknown lists the response fields that no struct field receives.
+	Unknown []string
+
+	// Missing lists the struct fields, other than omitempty ones, that the
+	// response did not contain.
+	Missing []string
+}
+
+func (d SchemaDrift) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s (%s)", d.Endpoint, d.Type)
+	if len(d.Unknown) > 0 {
+		fmt.Fprintf(&b, " unknown: %s", strings.Join(d.Unknown, ", "))
+	}
+	if len(d.Missing) > 0 {
+		fmt.Fprintf(&b, " missing: %s", strings.Join(d.Missing, ", "))
+	}
+	return b.String()
+}
+
+// SetStrictDecoding compares every decoded response with the type it was
+// decoded into and calls report when fields are unknown or missing. The
+// response is decoded as usual either way. A nil report turns strict
+// decoding off.
+//
+// Strict decoding buffers and decodes each response twice, so it is meant for
+// tests and CI runs against the fake or recorded API rather than production.
+func (c *Client) SetStrictDecoding(report func(SchemaDrift)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.strict = report
+}
+
+// SchemaReport collects drift per endpoint. Pass its Record method to
+// SetStrictDecoding or WithStrictDecoding. It is safe for concurrent use.
+type SchemaReport struct {
+	mu     sync.Mutex
+	drifts map[string]*SchemaDrift
+}
+
+// Record merges d into the report.
+func (r *SchemaReport) Record(d SchemaDrift) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.drifts == nil {
+		r.drifts = make(map[string]*SchemaDrift)
+	}
+
+	key := d.Endpoint + " " + d.Type
+	existing, ok := r.drifts[key]
+	if !ok {
+		r.drifts[key] = &SchemaDrift{Endpoint: d.Endpoint, Type: d.Type}
+		existing = r.drifts[key]
+	}
+	existing.Unknown = mergeSorted(existing.Unknown, d.Unknown)
+	existing.Missing = mergeSorted(existing.Missing, d.Missing)
+}
+
+// Drifts returns the collected drift sorted by endpoint.
+func (r *SchemaReport) Drifts() []SchemaDrift {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	drifts := make([]SchemaDrift, 0, len(r.drifts))
+	for _, d := range r.drifts {
+		drifts = append(drifts, SchemaDrift{
+			Endpoint: d.Endpoint,
+			Type:     d.Type,
+			Unknown:  slices.Clone(d.Unknown),
+			Missing:  slices.Clone(d.Missing),
+		})
+	}
+	sort.Slice(drifts, func(i, j int) bool {
+		if drifts[i].Endpoint != drifts[j].Endpoint {
+			return drifts[i].Endpoint < drifts[j].Endpoint
+		}
+		return drifts[i].Type < drifts[j].Type
+	})
+	return drifts
+}
+
+// String lists the collected drift, one endpoint per line.
+func (r *SchemaReport) String() string {
+	var b strings.Builder
+	for _, d := range r.Drifts() {
+		b.WriteString(d.String())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func mergeSorted(a, b []string) []string {
+	merged := append(slices.Clone(a), b...)
+	sort.Strings(merged)
+	return slices.Compact(merged)
+}
+
+// checkSchema reports the drift between body and the type of v, if any.
+func checkSchema(req *http.Request, body []byte, v interface{}, report func(SchemaDrift)) {
+	var raw any
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&raw); err != nil {
+		return
+	}
+
+	t := reflect.TypeOf(v)
+	d := &schemaDiff{unknown: map[string]bool{}, missing: map[string]bool{}}
+	d.compare(raw, t, "")
+	if len(d.unknown) == 0 && len(d.missing) == 0 {
+		return
+	}
+
+	report(SchemaDrift{
+		Endpoint: req.Method + " " + endpointPattern(req.URL.Path),
+		Type:     t.String(),
+		Unknown:  sortedKeys(d.unknown),
+		Missing:  sortedKeys(d.missing),
+	})
+}
+
+type schemaDiff struct {
+	unknown map[string]bool
+	missing map[string]bool
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+func (d *schemaDiff) compare(raw any, t reflect.Type, path string) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if raw == nil {
+		return
+	}
+
+	// Types that decode themselves define their own shape.
+	if reflect.PointerTo(t).Implements(jsonUnmarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := raw.(map[string]any)
+		if !ok {
+			return
+		}
+
+		fields := jsonFields(t)
+		seen := make(map[string]bool, len(fields))
+		for key, value := range object {
+			f, ok := matchField(fields, key)
+			if !ok {
+				d.unknown[joinPath(path, key)] = true
+				continue
+			}
+			seen[f.name] = true
+			d.compare(value, f.typ, joinPath(path, f.name))
+		}
+
+		for _, f := range fields {
+			if !seen[f.name] && !f.omitempty {
+				d.missing[joinPath(path, f.name)] = true
+			}
+		}
+
+	case reflect.Slice, reflect.Array:
+		if items, ok := raw.([]any); ok {
+			for _, item := range items {
+				d.compare(item, t.Elem(), path+"[]")
+			}
+		}
+
+	case reflect.Map:
+		if object, ok := raw.(map[string]any); ok {
+			for _, value := range object {
+				d.compare(value, t.Elem(), path+"{}")
+			}
+		}
+	}
+}
+
+type jsonField struct {
+	name      string
+	typ       reflect.Type
+	omitempty bool
+}
+
+// jsonFields returns the fields encoding/json decodes into for struct type t,
+// with embedded structs flattened.
+func jsonFields(t reflect.Type) []jsonField {
+	var fields []jsonField
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		ft := sf.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+
+		if sf.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			fields = append(fields, jsonFields(ft)...)
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = sf.Name
+		}
+		fields = append(fields, jsonField{name: name, typ: sf.Type, omitempty: strings.Contains(opts, "omitempty")})
+	}
+	return fields
+}
+
+// matchField finds the field receiving key, preferring an exact match over a
+// case-insensitive one as encoding/json does.
+func matchField(fields []jsonField, key string) (jsonField, bool) {
+	for _, f := range fields {
+		if f.name == key {
+			return f, true
+		}
+	}
+	for _, f := range fields {
+		if strings.EqualFold(f.name, key) {
+			return f, true
+		}
+	}
+	return jsonField{}, false
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// endpointPattern replaces the identifiers in path with {id}, so that drift
+// from calls on different resources is grouped together.
+func endpointPattern(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if looksLikeID(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func looksLikeID(segment string) bool {
+	if segment == "" || isVersion(segment) {
+		return false
+	}
+
+	digits := 0
+	for _, r := range segment {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+		case r == '-' || r == '_' || (r >= 'a' && r <= 'f') || (r >= 'A' && r <= 'F'):
+		default:
+			return false
+		}
+	}
+	return digits > 0 && (digits == len(segment) || len(segment) >= 8)
+}
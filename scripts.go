@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"time"
 )
 
 var scriptsBasePath = "/apps/v2"
@@ -29,7 +28,7 @@ type Script struct {
 	BoxIdentifier string    `json:"box_identifier"`
 	ScriptName    string    `json:"script_name"`
 	Script        string    `json:"script"`
-	CreatedOn     time.Time `json:"created_on"`
+	CreatedOn     Timestamp `json:"created_on"`
 	Identifier    string    `json:"identifier"`
 	CreatedBy     string    `json:"created_by"`
 }
@@ -41,7 +40,7 @@ type ScriptDetail struct {
 	BoxIdentifier string    `json:"box_identifier"`
 	Name          string    `json:"name"`
 	Script        string    `json:"script"`
-	CreatedOn     time.Time `json:"created_on"`
+	CreatedOn     Timestamp `json:"created_on"`
 	Identifier    string    `json:"identifier"`
 	ScriptName    string    `json:"script_name"`
 	Type          string    `json:"type"`
@@ -54,6 +53,14 @@ type CreateScriptRequest struct {
 	Tags          []string `json:"tags"`
 }
 
+// Validate reports the invalid fields of r.
+func (r CreateScriptRequest) Validate() error {
+	var errs fieldErrors
+	errs.required("name", r.Name)
+	errs.required("scriptContent", r.ScriptContent)
+	return errs.err()
+}
+
 type ListScriptRoot struct {
 	Error bool     `json:"error"`
 	Data  []Script `json:"data"`
@@ -67,6 +74,15 @@ type ScriptUpdateRequest struct {
 	ScriptType       string `json:"scriptType"`
 }
 
+// Validate reports the invalid fields of r.
+func (r ScriptUpdateRequest) Validate() error {
+	var errs fieldErrors
+	errs.required("scriptIdentifier", r.ScriptIdentifier)
+	errs.required("name", r.Name)
+	errs.required("scriptContent", r.ScriptContent)
+	return errs.err()
+}
+
 type ScriptRoot struct {
 	Error bool         `json:"error"`
 	Data  ScriptDetail `json:"data"`
@@ -2,7 +2,9 @@ package goVPSie
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"time"
 )
@@ -11,15 +13,21 @@ var serverBasePath = "/apps/v2/vm"
 
 type ServerService interface {
 	ListServer(context.Context, *ListOptions, string) ([]VmData, error)
+	AllByProject(ctx context.Context, projectId string, opts ...IterOption) iter.Seq2[VmData, error]
 	List(context.Context, *ListOptions) ([]VmData, error)
+	All(ctx context.Context, opts ...IterOption) iter.Seq2[VmData, error]
 	GetServerByIdentifier(context.Context, string) (*VmData, error)
 	GetServerStatusByIdentifier(context.Context, string) (*Status, error)
 	GetServerConsole(ctx context.Context, identifierId string) (*ServerConsole, error)
 	CreateServer(context.Context, *CreateServerRequest) error
+	CreateServerWithResult(context.Context, *CreateServerRequest) (*CreateResult, error)
 	DeleteServer(ctx context.Context, identifierId, password, reason, note string) error
 	StartServer(ctx context.Context, identifierId string) error
 	StopServer(ctx context.Context, identifierId string) error
 	RestartServer(ctx context.Context, identifierId string) error
+	StartMany(ctx context.Context, identifiers []string, opts *BulkOptions) ([]BulkResult, error)
+	StopMany(ctx context.Context, identifiers []string, opts *BulkOptions) ([]BulkResult, error)
+	RestartMany(ctx context.Context, identifiers []string, opts *BulkOptions) ([]BulkResult, error)
 	ChangePassword(ctx context.Context, identifierId string, newPassword string) error
 	ChangeHostName(ctx context.Context, identifierId string, newHostname string) error
 	AddVPC(ctx context.Context, request *VpcRequest) error
@@ -41,6 +49,8 @@ type ServerService interface {
 	ListVirtualMachines(ctx context.Context) ([]VirtualMachine, error)
 	ListAllNodesOfUser(ctx context.Context) ([]VmData, error)
 	CheckAgentStatus(ctx context.Context, vmIdentifier string) (bool, error)
+	WaitForState(ctx context.Context, identifierId, state string, opts *WaitOptions) (*Status, error)
+	WaitForCreation(ctx context.Context, result *CreateResult, opts *WaitOptions) (*VmData, error)
 }
 
 type serverServiceHandler struct {
@@ -76,75 +86,75 @@ type PrivateIpData struct {
 type FloatingIpData struct {
 }
 type VmData struct {
-	ID                  int64   `json:"id"`
-	UserID              int64   `json:"user_id"`
-	BoxSizeID           int64   `json:"boxsize_id"`
-	BoxImageID          int64   `json:"boximage_id"`
-	DataCenterID        int64   `json:"datacenter_id"`
-	NodeID              int64   `json:"node_id"`
-	BoxdIsCountID       *int64  `json:"boxdiscount_id"`
-	Hostname            string  `json:"hostname"`
-	DefaultIP           string  `json:"default_ip"`
-	DefaultIPv6         string  `json:"default_ipv6"`
-	PrivateIP           string  `json:"private_ip"`
-	IsAutoBackup        int64   `json:"is_autobackup"`
-	BoxVirtualization   string  `json:"box_virtualization_id"`
-	Ram                 int64   `json:"ram"`
-	Cpu                 int64   `json:"cpu"`
-	Ssd                 int64   `json:"ssd"`
-	Traffic             int64   `json:"traffic"`
-	AddedIpAddresses    *string `json:"added_ip_addresses"`
-	InitialPassword     string  `json:"initial_password"`
-	Notes               *string `json:"notes"`
-	CreatedOn           string  `json:"created_on"`
-	LastUpdated         string  `json:"last_updated"`
-	DroppedOn           *string `json:"dropped_on"`
-	IsActive            int64   `json:"is_active"`
-	IsDeleted           int64   `json:"is_deleted"`
-	Identifier          string  `json:"identifier"`
-	Power               int64   `json:"power"`
-	ProjectID           string  `json:"project_id"`
-	IsCustom            int64   `json:"is_custom"`
-	NrAddedIps          int64   `json:"nr_added_ips"`
-	InPcs               int64   `json:"in_pcs"`
-	CustomPrice         *int64  `json:"custom_price"`
-	PayableLicense      int64   `json:"payable_license"`
-	LastLicensePay      *string `json:"last_license_pay"`
-	ScriptID            *string `json:"script_id"`
-	SshKeyID            *string `json:"sshkey_id"`
-	IsLocked            int64   `json:"is_locked"`
-	IsWorkWithNew       int64   `json:"is_work_with_new_version"`
-	IsSuspended         int64   `json:"is_suspended"`
-	IsTerminated        int64   `json:"is_terminated"`
-	OldID               int64   `json:"old_id"`
-	CustomIsoID         *int64  `json:"custom_iso_id"`
-	IsIsoImageBootAble  int64   `json:"is_iso_image_bootable"`
-	HasSsl              int64   `json:"has_ssl"`
-	LastActionDate      *string `json:"last_action_date,omitempty"`
-	IsCreatedFromLegacy int64   `json:"is_created_from_legacy"`
-	IsSmtpAllowed       int64   `json:"is_smtp_allowed"`
-	WeeklyBackup        int64   `json:"weekly_backup"`
-	MonthlyBackup       int64   `json:"monthly_backup"`
-	LibIsoID            *int64  `json:"lib_iso_id,omitempty"`
-	DailySnapshot       int64   `json:"daily_snapshot"`
-	WeeklySnapshot      int64   `json:"weekly_snapshot"`
-	MonthlySnapshot     int64   `json:"monthly_snap"`
-	LastActionInMin     int64   `json:"last_action_in_min"`
-	FirstName           string  `json:"firstname"`
-	LastName            string  `json:"lastname"`
-	Username            string  `json:"username"`
-	State               string  `json:"state"`
-	IsFipAvailable      int64   `json:"is_fip_available"`
-	IsBucketAvailable   int64   `json:"is_bucket_available"`
-	DcIdentifier        string  `json:"dcIdentifier"`
-	Category            string  `json:"category"`
-	FullName            string  `json:"fullname"`
-	VmDescription       string  `json:"vmDescription"`
-	BoxesSuspended      int64   `json:"boxes_suspended"`
-	IsSataAvailable     int64   `json:"is_sata_available"`
-	IsSsdAvailable      int64   `json:"is_ssd_available"`
-	PublicIp            *string `json:"publicIp,omitempty"`
-	VMType              string  `json:"vmType,omitempty"`
+	ID                  int64     `json:"id"`
+	UserID              int64     `json:"user_id"`
+	BoxSizeID           int64     `json:"boxsize_id"`
+	BoxImageID          int64     `json:"boximage_id"`
+	DataCenterID        int64     `json:"datacenter_id"`
+	NodeID              int64     `json:"node_id"`
+	BoxdIsCountID       *int64    `json:"boxdiscount_id"`
+	Hostname            string    `json:"hostname"`
+	DefaultIP           string    `json:"default_ip"`
+	DefaultIPv6         string    `json:"default_ipv6"`
+	PrivateIP           string    `json:"private_ip"`
+	IsAutoBackup        Flag      `json:"is_autobackup"`
+	BoxVirtualization   string    `json:"box_virtualization_id"`
+	Ram                 int64     `json:"ram"`
+	Cpu                 int64     `json:"cpu"`
+	Ssd                 int64     `json:"ssd"`
+	Traffic             int64     `json:"traffic"`
+	AddedIpAddresses    *string   `json:"added_ip_addresses"`
+	InitialPassword     string    `json:"initial_password"`
+	Notes               *string   `json:"notes"`
+	CreatedOn           Timestamp `json:"created_on"`
+	LastUpdated         Timestamp `json:"last_updated"`
+	DroppedOn           Timestamp `json:"dropped_on"`
+	IsActive            Flag      `json:"is_active"`
+	IsDeleted           Flag      `json:"is_deleted"`
+	Identifier          string    `json:"identifier"`
+	Power               int64     `json:"power"`
+	ProjectID           string    `json:"project_id"`
+	IsCustom            Flag      `json:"is_custom"`
+	NrAddedIps          int64     `json:"nr_added_ips"`
+	InPcs               int64     `json:"in_pcs"`
+	CustomPrice         Decimal   `json:"custom_price"`
+	PayableLicense      int64     `json:"payable_license"`
+	LastLicensePay      *string   `json:"last_license_pay"`
+	ScriptID            *string   `json:"script_id"`
+	SshKeyID            *string   `json:"sshkey_id"`
+	IsLocked            Flag      `json:"is_locked"`
+	IsWorkWithNew       Flag      `json:"is_work_with_new_version"`
+	IsSuspended         Flag      `json:"is_suspended"`
+	IsTerminated        Flag      `json:"is_terminated"`
+	OldID               int64     `json:"old_id"`
+	CustomIsoID         *int64    `json:"custom_iso_id"`
+	IsIsoImageBootAble  Flag      `json:"is_iso_image_bootable"`
+	HasSsl              Flag      `json:"has_ssl"`
+	LastActionDate      Timestamp `json:"last_action_date,omitempty"`
+	IsCreatedFromLegacy Flag      `json:"is_created_from_legacy"`
+	IsSmtpAllowed       Flag      `json:"is_smtp_allowed"`
+	WeeklyBackup        int64     `json:"weekly_backup"`
+	MonthlyBackup       int64     `json:"monthly_backup"`
+	LibIsoID            *int64    `json:"lib_iso_id,omitempty"`
+	DailySnapshot       int64     `json:"daily_snapshot"`
+	WeeklySnapshot      int64     `json:"weekly_snapshot"`
+	MonthlySnapshot     int64     `json:"monthly_snap"`
+	LastActionInMin     int64     `json:"last_action_in_min"`
+	FirstName           string    `json:"firstname"`
+	LastName            string    `json:"lastname"`
+	Username            string    `json:"username"`
+	State               string    `json:"state"`
+	IsFipAvailable      Flag      `json:"is_fip_available"`
+	IsBucketAvailable   Flag      `json:"is_bucket_available"`
+	DcIdentifier        string    `json:"dcIdentifier"`
+	Category            string    `json:"category"`
+	FullName            string    `json:"fullname"`
+	VmDescription       string    `json:"vmDescription"`
+	BoxesSuspended      int64     `json:"boxes_suspended"`
+	IsSataAvailable     Flag      `json:"is_sata_available"`
+	IsSsdAvailable      Flag      `json:"is_ssd_available"`
+	PublicIp            *string   `json:"publicIp,omitempty"`
+	VMType              string    `json:"vmType,omitempty"`
 }
 
 type Status struct {
@@ -198,16 +208,56 @@ type CreateServerRequest struct {
 	ScriptIdentifier   *string   `json:"scriptIdentifier,omitempty"`
 }
 
+// Validate reports the invalid fields of r.
+func (r CreateServerRequest) Validate() error {
+	var errs fieldErrors
+	errs.required("resourceIdentifier", r.ResourceIdentifier)
+	errs.required("osIdentifier", r.OsIdentifier)
+	errs.required("dcIdentifier", r.DcIdentifier)
+	errs.required("projectId", r.ProjectID)
+	errs.check(isHostname(r.Hostname), "hostname", "must be a valid host name, got %q", r.Hostname)
+
+	for _, f := range []struct {
+		field string
+		value *int64
+	}{
+		{"backupEnabled", r.BackupEnabled},
+		{"addPublicIpV4", r.AddPublicIpV4},
+		{"addPublicIpV6", r.AddPublicIpV6},
+		{"addPrivateIp", r.AddPrivateIp},
+	} {
+		if f.value != nil {
+			errs.flag(f.field, *f.value)
+		}
+	}
+	return errs.err()
+}
+
 type ActionRequest struct {
 	VmIdentifier string `json:"vmIdentifier"`
 }
 
+// Validate reports whether r names a server.
+func (r ActionRequest) Validate() error {
+	var errs fieldErrors
+	errs.required("vmIdentifier", r.VmIdentifier)
+	return errs.err()
+}
+
 type VpcRequest struct {
 	VmIdentifier string `json:"vmIdentifier"`
 	VpcId        string `json:"vpcId"`
 	DcIdentifier string `json:"dcIdentifier"`
 }
 
+// Validate reports the invalid fields of r.
+func (r VpcRequest) Validate() error {
+	var errs fieldErrors
+	errs.required("vmIdentifier", r.VmIdentifier)
+	errs.required("vpcId", r.VpcId)
+	return errs.err()
+}
+
 type ResumeReq struct {
 	VmIdentifier     string `json:"vmIdentifier"`
 	OsIdentifier     string `json:"osIdentifier"`
@@ -218,6 +268,17 @@ type ResumeReq struct {
 	IsOnPremise      bool   `json:"isOnPremise"`
 }
 
+// Validate reports the invalid fields of r.
+func (r ResumeReq) Validate() error {
+	var errs fieldErrors
+	errs.required("vmIdentifier", r.VmIdentifier)
+	errs.required("osIdentifier", r.OsIdentifier)
+	if r.HostName != "" {
+		errs.check(isHostname(r.HostName), "hostname", "must be a valid host name, got %q", r.HostName)
+	}
+	return errs.err()
+}
+
 type ListAllNodesOfUserRoot struct {
 	Error bool     `json:"error"`
 	Data  []VmData `json:"data"`
@@ -232,15 +293,15 @@ type VirtualMachine struct {
 	RAM               int         `json:"ram"`
 	CPU               int         `json:"cpu"`
 	Ssd               int         `json:"ssd"`
-	IsSuspended       int         `json:"is_suspended"`
-	IsLocked          int         `json:"is_locked"`
-	IsActive          int         `json:"is_active"`
-	CreatedOn         time.Time   `json:"created_on"`
+	IsSuspended       Flag        `json:"is_suspended"`
+	IsLocked          Flag        `json:"is_locked"`
+	IsActive          Flag        `json:"is_active"`
+	CreatedOn         Timestamp   `json:"created_on"`
 	UserID            int         `json:"user_id"`
 	PrivateIP         interface{} `json:"private_ip"`
 	Power             int         `json:"power"`
 	Traffic           int         `json:"traffic"`
-	IsAgentActive     int         `json:"is_agent_active"`
+	IsAgentActive     Flag        `json:"is_agent_active"`
 	Firstname         string      `json:"firstname"`
 	Lastname          string      `json:"lastname"`
 	Username          string      `json:"username"`
@@ -249,8 +310,8 @@ type VirtualMachine struct {
 	APIConfID         string      `json:"api_conf_id"`
 	VMDescription     string      `json:"vmDescription"`
 	State             string      `json:"state"`
-	IsFipAvailable    int         `json:"is_fip_available"`
-	IsBucketAvailable int         `json:"is_bucket_available"`
+	IsFipAvailable    Flag        `json:"is_fip_available"`
+	IsBucketAvailable Flag        `json:"is_bucket_available"`
 	DcIdentifier      string      `json:"dcIdentifier"`
 }
 
@@ -265,7 +326,10 @@ type AgentStatus struct {
 }
 
 func (v *serverServiceHandler) ListServer(ctx context.Context, options *ListOptions, projectId string) ([]VmData, error) {
-	path := fmt.Sprintf("%s?projectId=%s", serverBasePath, projectId)
+	path, err := addOptions(fmt.Sprintf("%s?projectId=%s", serverBasePath, projectId), options)
+	if err != nil {
+		return nil, err
+	}
 	req, err := v.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -276,11 +340,24 @@ func (v *serverServiceHandler) ListServer(ctx context.Context, options *ListOpti
 		return nil, err
 	}
 
+	options.setMeta(int(servers.Total), len(servers.Data))
 	return servers.Data, nil
 }
 
+// AllByProject iterates over every server of the project, fetching pages lazily.
+func (v *serverServiceHandler) AllByProject(ctx context.Context, projectId string, opts ...IterOption) iter.Seq2[VmData, error] {
+	return paginate(ctx, func(ctx context.Context, options *ListOptions) ([]VmData, error) {
+		return v.ListServer(ctx, options, projectId)
+	}, opts)
+}
+
 func (v *serverServiceHandler) List(ctx context.Context, options *ListOptions) ([]VmData, error) {
-	req, err := v.client.NewRequest(ctx, http.MethodGet, serverBasePath, nil)
+	path, err := addOptions(serverBasePath, options)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := v.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -290,9 +367,15 @@ func (v *serverServiceHandler) List(ctx context.Context, options *ListOptions) (
 		return nil, err
 	}
 
+	options.setMeta(int(Servers.Total), len(Servers.Data))
 	return Servers.Data, nil
 }
 
+// All iterates over every server, fetching pages lazily.
+func (v *serverServiceHandler) All(ctx context.Context, opts ...IterOption) iter.Seq2[VmData, error] {
+	return paginate(ctx, v.List, opts)
+}
+
 func (v *serverServiceHandler) GetServerByIdentifier(ctx context.Context, identifierId string) (*VmData, error) {
 	path := fmt.Sprintf("%s/%s", serverBasePath, identifierId)
 	req, err := v.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -309,7 +392,7 @@ func (v *serverServiceHandler) GetServerByIdentifier(ctx context.Context, identi
 }
 
 func (v *serverServiceHandler) GetServerStatusByIdentifier(ctx context.Context, identifierId string) (*Status, error) {
-	path := fmt.Sprintf("%s/status/ %s", serverBasePath, identifierId)
+	path := fmt.Sprintf("%s/status/%s", serverBasePath, identifierId)
 	req, err := v.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -339,16 +422,44 @@ func (v *serverServiceHandler) GetServerConsole(ctx context.Context, identifierI
 }
 
 func (v *serverServiceHandler) CreateServer(ctx context.Context, server *CreateServerRequest) error {
+	_, err := v.CreateServerWithResult(ctx, server)
+	return err
+}
+
+// CreateServerWithResult is like CreateServer but also returns the identifier
+// or process ID of the new server.
+func (v *serverServiceHandler) CreateServerWithResult(ctx context.Context, server *CreateServerRequest) (*CreateResult, error) {
+	started := time.Now()
+	server = withDefaultProject(v.client, server, func(r *CreateServerRequest) *string { return &r.ProjectID })
 	req, err := v.client.NewRequest(ctx, http.MethodPost, serverBasePath, server)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	ctx = v.client.idempotent(ctx, req, v.existingServer(server))
 
-	if err = v.client.Do(ctx, req, nil); err != nil {
-		return err
+	var raw json.RawMessage
+	if err = v.client.Do(ctx, req, &raw); err != nil {
+		return nil, err
 	}
 
-	return nil
+	result := newCreateResult(raw)
+	result.started, result.hostname = started, server.Hostname
+	return result, nil
+}
+
+// existingServer finds a server with the hostname of server in its project.
+func (v *serverServiceHandler) existingServer(server *CreateServerRequest) existingFunc {
+	return func(ctx context.Context, since time.Time) (string, error) {
+		for vm, err := range v.AllByProject(ctx, server.ProjectID) {
+			if err != nil {
+				return "", err
+			}
+			if vm.Hostname == server.Hostname && createdSince(vm.CreatedOn, since) {
+				return vm.Identifier, nil
+			}
+		}
+		return "", nil
+	}
 }
 
 func (v *serverServiceHandler) DeleteServer(ctx context.Context, identifierId, password, reason, note string) error {
@@ -437,6 +548,21 @@ func (v *serverServiceHandler) RestartServer(ctx context.Context, identifierId s
 	return nil
 }
 
+// StartMany starts every server concurrently. See Client.Bulk.
+func (v *serverServiceHandler) StartMany(ctx context.Context, identifiers []string, opts *BulkOptions) ([]BulkResult, error) {
+	return v.client.Bulk(ctx, identifiers, v.StartServer, opts)
+}
+
+// StopMany stops every server concurrently. See Client.Bulk.
+func (v *serverServiceHandler) StopMany(ctx context.Context, identifiers []string, opts *BulkOptions) ([]BulkResult, error) {
+	return v.client.Bulk(ctx, identifiers, v.StopServer, opts)
+}
+
+// RestartMany restarts every server concurrently. See Client.Bulk.
+func (v *serverServiceHandler) RestartMany(ctx context.Context, identifiers []string, opts *BulkOptions) ([]BulkResult, error) {
+	return v.client.Bulk(ctx, identifiers, v.RestartServer, opts)
+}
+
 func (v *serverServiceHandler) ChangePassword(ctx context.Context, identifierId string, newPassword string) error {
 	changePassReq := struct {
 		VmIdentifier string `json:"vmIdentifier"`
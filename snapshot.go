@@ -3,8 +3,8 @@ package goVPSie
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
-	"time"
 )
 
 var snapshotBasePath = "/apps/v2/snapshot"
@@ -13,11 +13,14 @@ var backupBasePath = "/apps/v2/backup"
 
 type SnapshotService interface {
 	List(ctx context.Context, options *ListOptions) ([]Snapshot, error)
+	All(ctx context.Context, opts ...IterOption) iter.Seq2[Snapshot, error]
 	Create(ctx context.Context, name, vmIdentifier, note string) error
 	ListByVm(ctx context.Context, options *ListOptions, vmIdentifier string) ([]Snapshot, error)
+	AllByVm(ctx context.Context, vmIdentifier string, opts ...IterOption) iter.Seq2[Snapshot, error]
 	Rollback(ctx context.Context, snapshotIdentifier string) error
 	EnableAuto(ctx context.Context, enableReq *EnableAutoSnapshotReq) error
 	Delete(ctx context.Context, snapshotIdentifier, reason, note string) error
+	DeleteMany(ctx context.Context, snapshotIdentifiers []string, reason, note string, opts *BulkOptions) ([]BulkResult, error)
 	Update(ctx context.Context, snapshotIdentifier, newNote string) error
 	Get(ctx context.Context, buckupIdentifier string) (*Snapshot, error)
 	GetSnapShotPolicy(ctx context.Context, identifier string) (*SnapShotPolicy, error)
@@ -27,6 +30,7 @@ type SnapshotService interface {
 	AttachSnapShotPolicy(ctx context.Context, policyId string, vms []string) error
 	DetachSnapShotPolicy(ctx context.Context, policyId string, vms []string) error
 	ListSnapShotPolicies(ctx context.Context, options *ListOptions) ([]SnapShotPolicyListDetail, error)
+	SnapShotPoliciesAll(ctx context.Context, opts ...IterOption) iter.Seq2[SnapShotPolicyListDetail, error]
 }
 
 type snapshotServiceHandler struct {
@@ -43,11 +47,11 @@ type Snapshot struct {
 	State        string    `json:"state"`
 	DcIdentifier string    `json:"dcIdentifier"`
 	Daily        int64     `json:"daily"`
-	IsSnapshot   int64     `json:"is_snapshot"`
+	IsSnapshot   Flag      `json:"is_snapshot"`
 	VmIdentifier string    `json:"vmIdentifier"`
 	BackupSHA1   string    `json:"backupsha1"`
-	IsDeletedVM  int64     `json:"is_deleted_vm"`
-	CreatedOn    time.Time `json:"created_on"`
+	IsDeletedVM  Flag      `json:"is_deleted_vm"`
+	CreatedOn    Timestamp `json:"created_on"`
 	Note         string    `json:"note"`
 	BackupSize   int64     `json:"backup_size"`
 	DcName       string    `json:"dcName"`
@@ -84,8 +88,21 @@ type EnableAutoSnapshotReq struct {
 	Tags            []string `json:"tags"`
 }
 
+// Validate reports the invalid fields of r.
+func (r EnableAutoSnapshotReq) Validate() error {
+	var errs fieldErrors
+	errs.required("vmIdentifier", r.VMIdentifier)
+	errs.flag("dailySnapshot", r.DailySnapshot)
+	errs.flag("weeklySnapshot", r.WeeklySnapshot)
+	errs.flag("monthlySnapshot", r.MonthlySnapshot)
+	return errs.err()
+}
+
 func (s *snapshotServiceHandler) List(ctx context.Context, options *ListOptions) ([]Snapshot, error) {
-	path := fmt.Sprintf("%s?offset=%d&limit%d", snapshotBasePath, options.Page, options.PerPage)
+	path, err := addOptions(snapshotBasePath, options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -98,10 +115,16 @@ func (s *snapshotServiceHandler) List(ctx context.Context, options *ListOptions)
 		return nil, err
 	}
 
+	options.setMeta(int(snapshots.Total), len(snapshots.Data))
 	return snapshots.Data, nil
 
 }
 
+// All iterates over every snapshot, fetching pages lazily.
+func (s *snapshotServiceHandler) All(ctx context.Context, opts ...IterOption) iter.Seq2[Snapshot, error] {
+	return paginate(ctx, s.List, opts)
+}
+
 func (s *snapshotServiceHandler) Create(ctx context.Context, name, vmIdentifier, note string) error {
 	path := fmt.Sprintf("%s/add", snapshotBasePath)
 	createSnapshotReq := struct {
@@ -159,7 +182,10 @@ func (s *snapshotServiceHandler) Update(ctx context.Context, snapshotIdentifier,
 }
 
 func (s *snapshotServiceHandler) ListByVm(ctx context.Context, options *ListOptions, vmIdentifier string) ([]Snapshot, error) {
-	path := fmt.Sprintf("/apps/v2/vm/snapshot/%s?offset=%d&limit%d", vmIdentifier, options.Page, options.PerPage)
+	path, err := addOptions(fmt.Sprintf("/apps/v2/vm/snapshot/%s", vmIdentifier), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -172,10 +198,18 @@ func (s *snapshotServiceHandler) ListByVm(ctx context.Context, options *ListOpti
 		return nil, err
 	}
 
+	options.setMeta(int(snapshots.Total), len(snapshots.Data))
 	return snapshots.Data, nil
 
 }
 
+// AllByVm iterates over every snapshot of the server, fetching pages lazily.
+func (s *snapshotServiceHandler) AllByVm(ctx context.Context, vmIdentifier string, opts ...IterOption) iter.Seq2[Snapshot, error] {
+	return paginate(ctx, func(ctx context.Context, options *ListOptions) ([]Snapshot, error) {
+		return s.ListByVm(ctx, options, vmIdentifier)
+	}, opts)
+}
+
 func (s *snapshotServiceHandler) Delete(ctx context.Context, snapshotIdentifier, reason, note string) error {
 	deleteReq := struct {
 		SnapshotIdentifier string `json:"snapshotIdentifier"`
@@ -202,6 +236,13 @@ func (s *snapshotServiceHandler) Delete(ctx context.Context, snapshotIdentifier,
 	return s.client.Do(ctx, req, nil)
 }
 
+// DeleteMany deletes every snapshot concurrently. See Client.Bulk.
+func (s *snapshotServiceHandler) DeleteMany(ctx context.Context, snapshotIdentifiers []string, reason, note string, opts *BulkOptions) ([]BulkResult, error) {
+	return s.client.Bulk(ctx, snapshotIdentifiers, func(ctx context.Context, identifier string) error {
+		return s.Delete(ctx, identifier, reason, note)
+	}, opts)
+}
+
 func (s *snapshotServiceHandler) Rollback(ctx context.Context, snapshotIdentifier string) error {
 	path := fmt.Sprintf("%s/rollback", snapshotBasePath)
 
@@ -241,7 +282,7 @@ type SnapShotVms struct {
 type SnapShotPolicy struct {
 	Name       string        `json:"name"`
 	Identifier string        `json:"identifier"`
-	CreatedOn  string        `json:"created_on"`
+	CreatedOn  Timestamp     `json:"created_on"`
 	CreatedBy  string        `json:"created_by"`
 	BackupPlan string        `json:"backupPlan"`
 	PlanEvery  int64         `json:"planEvery"`
@@ -252,16 +293,16 @@ type SnapShotPolicy struct {
 }
 
 type SnapShotPolicyListDetail struct {
-	Name       string `json:"name"`
-	Identifier string `json:"identifier"`
-	CreatedOn  string `json:"created_on"`
-	CreatedBy  string `json:"created_by"`
-	BackupPlan string `json:"backupPlan"`
-	PlanEvery  int64  `json:"planEvery"`
-	Keep       int64  `json:"keep"`
-	Disabled   int64  `json:"disabled"`
-	VmsCount   int64  `json:"vmsCount"`
-	UserId     int64  `json:"userId"`
+	Name       string    `json:"name"`
+	Identifier string    `json:"identifier"`
+	CreatedOn  Timestamp `json:"created_on"`
+	CreatedBy  string    `json:"created_by"`
+	BackupPlan string    `json:"backupPlan"`
+	PlanEvery  int64     `json:"planEvery"`
+	Keep       int64     `json:"keep"`
+	Disabled   int64     `json:"disabled"`
+	VmsCount   int64     `json:"vmsCount"`
+	UserId     int64     `json:"userId"`
 }
 type ListSnapShotPoliciesRoot struct {
 	Error bool `json:"error"`
@@ -284,8 +325,18 @@ type CreateSnapShotPolicyReq struct {
 	Tags       []string `json:"tags"`
 }
 
+// Validate reports the invalid fields of r.
+func (r CreateSnapShotPolicyReq) Validate() error {
+	var errs fieldErrors
+	validatePolicy(&errs, r.Name, r.BackupPlan, r.PlanEvery, r.Keep)
+	return errs.err()
+}
+
 func (s *snapshotServiceHandler) ListSnapShotPolicies(ctx context.Context, options *ListOptions) ([]SnapShotPolicyListDetail, error) {
-	path := fmt.Sprintf("%s/policy/all", snapshotBasePath)
+	path, err := addOptions(fmt.Sprintf("%s/policy/all", snapshotBasePath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -297,9 +348,15 @@ func (s *snapshotServiceHandler) ListSnapShotPolicies(ctx context.Context, optio
 		return nil, err
 	}
 
+	options.setMeta(0, len(policies.Data.Rows))
 	return policies.Data.Rows, nil
 }
 
+// SnapShotPoliciesAll iterates over every snapshot policy, fetching pages lazily.
+func (s *snapshotServiceHandler) SnapShotPoliciesAll(ctx context.Context, opts ...IterOption) iter.Seq2[SnapShotPolicyListDetail, error] {
+	return paginate(ctx, s.ListSnapShotPolicies, opts)
+}
+
 func (s *snapshotServiceHandler) GetSnapShotPolicy(ctx context.Context, identifier string) (*SnapShotPolicy, error) {
 	path := fmt.Sprintf("%s/policy/%s", snapshotBasePath, identifier)
 
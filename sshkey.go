@@ -32,13 +32,13 @@ type SshKeysListRoot struct {
 }
 
 type SShKey struct {
-	Id         int64  `json:"id"`
-	UserId     int64  `json:"user_id"`
-	Name       string `json:"name"`
-	PrivateKey string `json:"private_key"`
-	CreatedOn  string `json:"created_on"`
-	Identifier string `json:"identifier"`
-	CreatedBy  string `json:"created_by"`
+	Id         int64     `json:"id"`
+	UserId     int64     `json:"user_id"`
+	Name       string    `json:"name"`
+	PrivateKey string    `json:"private_key"`
+	CreatedOn  Timestamp `json:"created_on"`
+	Identifier string    `json:"identifier"`
+	CreatedBy  string    `json:"created_by"`
 }
 
 var _ SshkeysService = &sshkeysServiceHandler{}
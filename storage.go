@@ -2,15 +2,17 @@ package goVPSie
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
-	"time"
 )
 
 var storageBasePath = "/apps/v2"
 
 type StorageService interface {
 	List(ctx context.Context, options *ListOptions) ([]Storage, error)
+	All(ctx context.Context, opts ...IterOption) iter.Seq2[Storage, error]
 	Delete(ctx context.Context, storageIdentifier string) error
 	AttachToServer(ctx context.Context, storageIdentifier, vmIdentifier string, vmType string) error
 	DetachToServer(ctx context.Context, storageIdentifier, vmIdentifier string, vmType string) error
@@ -19,12 +21,14 @@ type StorageService interface {
 	Create(ctx context.Context, createReq *StorageCreateRequest, vmIdentifier string, vmType string) error
 	ListVmsToAttach(ctx context.Context) ([]VmToAttach, error)
 	CreateVolume(ctx context.Context, creatReq *StorageCreateRequest) error
+	CreateVolumeWithResult(ctx context.Context, creatReq *StorageCreateRequest) (*CreateResult, error)
 	CreateStorage(ctx context.Context, createReq *StorageCreateRequest) error
 	DetachAllFromServer(ctx context.Context, vmIdentifier string, vmType string) error
 	UpdateSize(ctx context.Context, storageIdentifier, size string) error
 	UpdateName(ctx context.Context, storageIdentifier, name string) error
 	CreateSnapshot(ctx context.Context, storageIdentifier, name, storageType string) error
 	ListSnapshots(ctx context.Context, options *ListOptions) ([]StorageSnapShot, error)
+	SnapshotsAll(ctx context.Context, opts ...IterOption) iter.Seq2[StorageSnapShot, error]
 	UpdateSnapshotName(ctx context.Context, snapshotIdentifier, name string) error
 	RollbackSnapshot(ctx context.Context, snapshotIdentifier, snapType string) error
 	CloneSnapshot(ctx context.Context, snapshotIdentifier, snapType string) error
@@ -51,19 +55,19 @@ type StorageDetail struct {
 	StorageType       string    `json:"storage_type"`
 	DiskFormat        string    `json:"disk_format"`
 	BusDevice         string    `json:"bus_device"`
-	IsAutomatic       int       `json:"is_automatic"`
+	IsAutomatic       Flag      `json:"is_automatic"`
 	Size              int       `json:"size"`
-	IsDeleted         int       `json:"is_deleted"`
+	IsDeleted         Flag      `json:"is_deleted"`
 	BusNumber         int       `json:"bus_number"`
 	DatacenterID      int       `json:"datacenter_id"`
-	UpdatedAt         time.Time `json:"updated_at"`
+	UpdatedAt         Timestamp `json:"updated_at"`
 	StorageID         int       `json:"storage_id"`
 	DiskKey           string    `json:"disk_key"`
-	CreatedOn         time.Time `json:"created_on"`
+	CreatedOn         Timestamp `json:"created_on"`
 	IndexTemplateProp string    `json:"index_template_prop"`
 	EntityType        string    `json:"entity_type"`
 	Hostname          string    `json:"hostname"`
-	IsDeletedVM       int       `json:"is_deleted_vm"`
+	IsDeletedVM       Flag      `json:"is_deleted_vm"`
 	State             string    `json:"state"`
 	DcName            string    `json:"dcName"`
 	DcIdentifier      string    `json:"dcIdentifier"`
@@ -79,27 +83,27 @@ type GetStorageRoot struct {
 	Data  StorageDetail `json:"data"`
 }
 type Storage struct {
-	ID             int    `json:"id"`
-	Name           string `json:"name"`
-	Description    string `json:"description"`
-	UserID         int    `json:"user_id"`
-	BoxID          int    `json:"box_id"`
-	Identifier     string `json:"identifier"`
-	UserTemplateID int    `json:"user_template_id"`
-	StorageType    string `json:"storage_type"`
-	DiskFormat     string `json:"disk_format"`
-	IsAutomatic    int    `json:"is_automatic"`
-	Size           int    `json:"size"`
-	StorageID      int    `json:"storage_id"`
-	DiskKey        string `json:"disk_key"`
-	CreatedOn      string `json:"created_on"`
-	VmIdentifier   string `json:"vmIdentifier"`
-	Hostname       string `json:"hostname"`
-	OsIdentifier   string `json:"osIdentifier"`
-	State          string `json:"state"`
-	DcIdentifier   string `json:"dcIdentifier"`
-	BusDevice      string `json:"bus_device"`
-	BusNumber      int    `json:"bus_number"`
+	ID             int       `json:"id"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	UserID         int       `json:"user_id"`
+	BoxID          int       `json:"box_id"`
+	Identifier     string    `json:"identifier"`
+	UserTemplateID int       `json:"user_template_id"`
+	StorageType    string    `json:"storage_type"`
+	DiskFormat     string    `json:"disk_format"`
+	IsAutomatic    Flag      `json:"is_automatic"`
+	Size           int       `json:"size"`
+	StorageID      int       `json:"storage_id"`
+	DiskKey        string    `json:"disk_key"`
+	CreatedOn      Timestamp `json:"created_on"`
+	VmIdentifier   string    `json:"vmIdentifier"`
+	Hostname       string    `json:"hostname"`
+	OsIdentifier   string    `json:"osIdentifier"`
+	State          string    `json:"state"`
+	DcIdentifier   string    `json:"dcIdentifier"`
+	BusDevice      string    `json:"bus_device"`
+	BusNumber      int       `json:"bus_number"`
 }
 
 type ListStorageRoot struct {
@@ -115,6 +119,14 @@ type StorageUpdateRequest struct {
 	Size              int    `json:"size"`
 }
 
+// Validate reports the invalid fields of r.
+func (r StorageUpdateRequest) Validate() error {
+	var errs fieldErrors
+	errs.required("storageIdentifier", r.StorageIdentifier)
+	errs.check(r.Size >= 0, "size", "must not be negative, got %d", r.Size)
+	return errs.err()
+}
+
 type StorageCreateRequest struct {
 	Name         string `json:"name"`
 	DcIdentifier string `json:"dcIdentifier"`
@@ -126,6 +138,16 @@ type StorageCreateRequest struct {
 	K8sTrials    int    `json:"k8sTrials"`
 }
 
+// Validate reports the invalid fields of r.
+func (r StorageCreateRequest) Validate() error {
+	var errs fieldErrors
+	errs.required("name", r.Name)
+	errs.required("dcIdentifier", r.DcIdentifier)
+	errs.check(r.Size > 0, "size", "must be positive, got %d", r.Size)
+	errs.flag("isAutomatic", int64(r.IsAutomatic))
+	return errs.err()
+}
+
 type VmToAttach struct {
 	Hostname          string      `json:"hostname"`
 	Identifier        string      `json:"identifier"`
@@ -135,8 +157,8 @@ type VmToAttach struct {
 	PrivateIP         interface{} `json:"private_ip"`
 	Ssd               int         `json:"ssd"`
 	State             string      `json:"state"`
-	IsFipAvailable    int         `json:"is_fip_available"`
-	IsBucketAvailable int         `json:"is_bucket_available"`
+	IsFipAvailable    Flag        `json:"is_fip_available"`
+	IsBucketAvailable Flag        `json:"is_bucket_available"`
 	DcIdentifier      string      `json:"dcIdentifier"`
 	Fullname          string      `json:"fullname"`
 	Category          string      `json:"category"`
@@ -155,9 +177,9 @@ type StorageSnapShot struct {
 	Identifier  string    `json:"identifier"`
 	Name        string    `json:"name"`
 	Size        int       `json:"size"`
-	CreatedOn   time.Time `json:"created_on"`
+	CreatedOn   Timestamp `json:"created_on"`
 	UserID      int       `json:"user_id"`
-	IsDeleted   int       `json:"is_deleted"`
+	IsDeleted   Flag      `json:"is_deleted"`
 	SnapshotKey string    `json:"snapshot_key"`
 	StorageName string    `json:"storage_name"`
 	StorageType string    `json:"storage_type"`
@@ -178,7 +200,10 @@ type ListStorageDataCenterRoot struct {
 }
 
 func (s *storageServiceHandler) List(ctx context.Context, options *ListOptions) ([]Storage, error) {
-	path := fmt.Sprintf("%s/storages?offset=%d&limit%d", storageBasePath, options.Page, options.PerPage)
+	path, err := addOptions(fmt.Sprintf("%s/storages", storageBasePath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -190,9 +215,15 @@ func (s *storageServiceHandler) List(ctx context.Context, options *ListOptions)
 		return nil, err
 	}
 
+	options.setMeta(storages.Total, len(storages.Data))
 	return storages.Data, nil
 }
 
+// All iterates over every storage, fetching pages lazily.
+func (s *storageServiceHandler) All(ctx context.Context, opts ...IterOption) iter.Seq2[Storage, error] {
+	return paginate(ctx, s.List, opts)
+}
+
 func (s *storageServiceHandler) Delete(ctx context.Context, storageIdentifier string) error {
 	path := fmt.Sprintf("%s/storages", storageBasePath)
 
@@ -311,7 +342,10 @@ func (s *storageServiceHandler) UpdateName(ctx context.Context, storageIdentifie
 }
 
 func (s *storageServiceHandler) ListAll(ctx context.Context, options *ListOptions) ([]Storage, error) {
-	path := fmt.Sprintf("%s/storages", storageBasePath)
+	path, err := addOptions(fmt.Sprintf("%s/storages", storageBasePath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -322,6 +356,7 @@ func (s *storageServiceHandler) ListAll(ctx context.Context, options *ListOption
 	if err = s.client.Do(ctx, req, &storages); err != nil {
 		return nil, err
 	}
+	options.setMeta(storages.Total, len(storages.Data))
 	return storages.Data, nil
 }
 
@@ -399,6 +434,13 @@ func (s *storageServiceHandler) CreateStorage(ctx context.Context, createReq *St
 }
 
 func (s *storageServiceHandler) CreateVolume(ctx context.Context, creatReq *StorageCreateRequest) error {
+	_, err := s.CreateVolumeWithResult(ctx, creatReq)
+	return err
+}
+
+// CreateVolumeWithResult is like CreateVolume but also returns the identifier
+// of the new volume.
+func (s *storageServiceHandler) CreateVolumeWithResult(ctx context.Context, creatReq *StorageCreateRequest) (*CreateResult, error) {
 	path := fmt.Sprintf("%s/storage/create", storageBasePath)
 	fullReq := struct {
 		Storages []StorageCreateRequest `json:"storages"`
@@ -410,10 +452,15 @@ func (s *storageServiceHandler) CreateVolume(ctx context.Context, creatReq *Stor
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, fullReq)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	var raw json.RawMessage
+	if err = s.client.Do(ctx, req, &raw); err != nil {
+		return nil, err
+	}
+
+	return newCreateResult(raw), nil
 }
 
 func (s *storageServiceHandler) DetachAllFromServer(ctx context.Context, vmIdentifier string, vmType string) error {
@@ -458,8 +505,10 @@ func (s *storageServiceHandler) CreateSnapshot(ctx context.Context, storageIdent
 }
 
 func (s *storageServiceHandler) ListSnapshots(ctx context.Context, options *ListOptions) ([]StorageSnapShot, error) {
-	//path := fmt.Sprintf("%s/storage/snapshots?offset=%s&limit=%s", storageBasePath, options.Page, options.PerPage)
-	path := fmt.Sprintf("%s/storage/snapshots?offset=%d&limit=%d", storageBasePath, options.Page, options.PerPage)
+	path, err := addOptions(fmt.Sprintf("%s/storage/snapshots", storageBasePath), options)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -471,9 +520,15 @@ func (s *storageServiceHandler) ListSnapshots(ctx context.Context, options *List
 		return nil, err
 	}
 
+	options.setMeta(snapshots.Total, len(snapshots.Data))
 	return snapshots.Data, nil
 }
 
+// SnapshotsAll iterates over every storage snapshot, fetching pages lazily.
+func (s *storageServiceHandler) SnapshotsAll(ctx context.Context, opts ...IterOption) iter.Seq2[StorageSnapShot, error] {
+	return paginate(ctx, s.ListSnapshots, opts)
+}
+
 func (s *storageServiceHandler) UpdateSnapshotName(ctx context.Context, snapshotIdentifier, name string) error {
 	path := fmt.Sprintf("%s/storages/snapshot/rename", storageBasePath)
 
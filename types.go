@@ -0,0 +1,240 @@
+package goVPSie
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Timestamp is a time decoded from any of the formats the API sends: RFC 3339,
+// MySQL "2006-01-02 15:04:05" datetimes and plain dates, and Unix seconds or
+// milliseconds, quoted or not. null, "" and MySQL's zero date decode to the
+// zero Timestamp. So does a value in any other format, whose text is kept
+// for Raw.
+type Timestamp struct {
+	time.Time
+
+	raw string
+}
+
+// Raw returns the text of a value t was decoded from without being
+// recognised, or "" if it was.
+func (t Timestamp) Raw() string {
+	return t.raw
+}
+
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	value, err := unquote(data)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp %s: %w", data, err)
+	}
+
+	parsed, err := parseTime(value)
+	if err != nil {
+		*t = Timestamp{raw: value}
+		return nil
+	}
+
+	*t = Timestamp{Time: parsed}
+	return nil
+}
+
+// MarshalJSON encodes t in RFC 3339 format, or as null if it is zero.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.Time.Format(time.RFC3339Nano))
+}
+
+// Flag is a boolean the API sends as 0 or 1, "0" or "1", or true or false.
+// Any other value decodes to false, and its text is kept for Raw.
+type Flag struct {
+	value bool
+	raw   string
+}
+
+// NewFlag returns the Flag holding b.
+func NewFlag(b bool) Flag {
+	return Flag{value: b}
+}
+
+// Bool returns f as a bool.
+func (f Flag) Bool() bool {
+	return f.value
+}
+
+// Raw returns the text of a value f was decoded from without being
+// recognised, or "" if it was.
+func (f Flag) Raw() string {
+	return f.raw
+}
+
+func (f Flag) String() string {
+	return strconv.FormatBool(f.value)
+}
+
+func (f *Flag) UnmarshalJSON(data []byte) error {
+	value, err := unquote(data)
+	if err != nil {
+		return fmt.Errorf("invalid flag %s: %w", data, err)
+	}
+
+	switch strings.ToLower(value) {
+	case "", "0", "false", "no", "off":
+		*f = Flag{}
+	case "1", "true", "yes", "on":
+		*f = Flag{value: true}
+	default:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			*f = Flag{raw: value}
+			return nil
+		}
+		*f = Flag{value: n != 0}
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes f as 1 or 0, as the API does.
+func (f Flag) MarshalJSON() ([]byte, error) {
+	if f.value {
+		return []byte("1"), nil
+	}
+	return []byte("0"), nil
+}
+
+// Decimal is an exact decimal number, such as a price, that the API sends
+// either as a JSON number or as a string. It keeps the digits as sent, so no
+// precision is lost to floating point. null and "" decode to the empty
+// Decimal, which reads as zero. So does a value that is not a finite number,
+// such as "NaN" or "Infinity", whose text is kept for Raw.
+type Decimal struct {
+	digits string
+	raw    string
+}
+
+// ParseDecimal returns the Decimal holding the number s, which must be
+// finite: "Inf" and "NaN" are rejected.
+func ParseDecimal(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+	if f, err := strconv.ParseFloat(s, 64); err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	return Decimal{digits: s}, nil
+}
+
+// IsZero reports whether d is empty or equal to zero.
+func (d Decimal) IsZero() bool {
+	r := d.Rat()
+	return r == nil || r.Sign() == 0
+}
+
+// Float64 returns d as the nearest float64, or 0 if d is empty.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.digits, 64)
+	return f
+}
+
+// Rat returns d as an exact rational number, or nil if d is empty.
+func (d Decimal) Rat() *big.Rat {
+	if d.digits == "" {
+		return nil
+	}
+
+	r, ok := new(big.Rat).SetString(d.digits)
+	if !ok {
+		return nil
+	}
+	return r
+}
+
+// Raw returns the text of a value d was decoded from without being a
+// number, or "" if it was one.
+func (d Decimal) Raw() string {
+	return d.raw
+}
+
+// String returns the digits of d as sent, or "" if it is empty.
+func (d Decimal) String() string {
+	return d.digits
+}
+
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	value, err := unquote(data)
+	if err != nil {
+		return fmt.Errorf("invalid decimal %s: %w", data, err)
+	}
+
+	if value == "" {
+		*d = Decimal{}
+		return nil
+	}
+	if *d, err = ParseDecimal(value); err != nil {
+		*d = Decimal{raw: value}
+	}
+	return nil
+}
+
+// MarshalJSON encodes d as a JSON string, as the API does, or as null if it
+// is empty.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	if d.digits == "" {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.digits)
+}
+
+// unquote returns the trimmed text of a JSON string, number, boolean or null,
+// with null as "".
+func unquote(data []byte) (string, error) {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		return "", nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(s), nil
+	}
+
+	return string(data), nil
+}
+
+// parseTime parses the timestamps used across the API: RFC 3339, the
+// "2006-01-02 15:04:05" layout, plain dates and unix seconds or milliseconds.
+func parseTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.HasPrefix(value, "0000-00-00") {
+		return time.Time{}, nil
+	}
+
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		switch {
+		case n == 0:
+			return time.Time{}, nil
+		case n > 1e12:
+			return time.UnixMilli(n), nil
+		}
+		return time.Unix(n, 0), nil
+	}
+
+	var err error
+	for _, layout := range []string{time.RFC3339Nano, time.DateTime, "2006-01-02T15:04:05", time.DateOnly, time.RFC1123} {
+		var t time.Time
+		if t, err = time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, err
+}
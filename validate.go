@@ -0,0 +1,288 @@
+package goVPSie
+
+import (
+	"fmt"
+	"net/mail"
+	"net/netip"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Validator is implemented by the request structs, which check their fields
+// before they are sent. See Client.SetValidation.
+type Validator interface {
+	Validate() error
+}
+
+// FieldError describes one invalid field of a request.
+type FieldError struct {
+	// Field is the JSON path of the field, e.g. "rules[0].frontPort".
+	Field string
+
+	// Message says what is wrong with the value.
+	Message string
+}
+
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ValidationError is returned by Validate, and by every service call when
+// validation is enabled, for a request with invalid fields. It lists all of
+// them, not just the first.
+type ValidationError struct {
+	Errors []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, fe := range e.Errors {
+		msgs[i] = fe.Error()
+	}
+	return "invalid request: " + strings.Join(msgs, "; ")
+}
+
+// Field returns the error for field, if there is one.
+func (e *ValidationError) Field(field string) (FieldError, bool) {
+	for _, fe := range e.Errors {
+		if fe.Field == field {
+			return fe, true
+		}
+	}
+	return FieldError{}, false
+}
+
+// SetValidation makes the client validate every request body that implements
+// Validator, including the request structs nested one level down in the
+// payloads the services build, and return a *ValidationError without calling
+// the API when one is invalid.
+func (c *Client) SetValidation(enabled bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.validate = enabled
+}
+
+// validateBody validates body, or the Validator fields and slice elements of
+// the struct body points to.
+func validateBody(body interface{}) error {
+	if v, ok := body.(Validator); ok {
+		return v.Validate()
+	}
+
+	rv := reflect.ValueOf(body)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var errs fieldErrors
+	for i := range rv.NumField() {
+		sf := rv.Type().Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if name == "" {
+			name = sf.Name
+		}
+
+		fv := rv.Field(i)
+		switch fv.Kind() {
+		case reflect.Slice, reflect.Array:
+			for j := range fv.Len() {
+				errs.nested(fmt.Sprintf("%s[%d]", name, j), validateValue(fv.Index(j)))
+			}
+		default:
+			errs.nested(name, validateValue(fv))
+		}
+	}
+	return errs.err()
+}
+
+func validateValue(v reflect.Value) error {
+	if (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) && v.IsNil() {
+		return nil
+	}
+	if validator, ok := v.Interface().(Validator); ok {
+		return validator.Validate()
+	}
+	return nil
+}
+
+// fieldErrors collects the invalid fields of a request.
+type fieldErrors []FieldError
+
+func (e *fieldErrors) add(field, format string, args ...any) {
+	*e = append(*e, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// check adds an error for field unless ok.
+func (e *fieldErrors) check(ok bool, field, format string, args ...any) {
+	if !ok {
+		e.add(field, format, args...)
+	}
+}
+
+func (e *fieldErrors) required(field, value string) {
+	e.check(strings.TrimSpace(value) != "", field, "is required")
+}
+
+// flag checks a 0 or 1 field.
+func (e *fieldErrors) flag(field string, value int64) {
+	e.check(value == 0 || value == 1, field, "must be 0 or 1, got %d", value)
+}
+
+// nested adds the errors of a nested request struct under prefix.
+func (e *fieldErrors) nested(prefix string, err error) {
+	if err == nil {
+		return
+	}
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		e.add(prefix, "%s", err)
+		return
+	}
+	for _, fe := range verr.Errors {
+		*e = append(*e, FieldError{Field: prefix + "." + fe.Field, Message: fe.Message})
+	}
+}
+
+func (e fieldErrors) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return &ValidationError{Errors: e}
+}
+
+// isHostname reports whether s is a valid RFC 1123 host name.
+func isHostname(s string) bool {
+	s = strings.TrimSuffix(s, ".")
+	if s == "" || len(s) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(s, ".") {
+		if !isLabel(label) {
+			return false
+		}
+	}
+	return true
+}
+
+// isLabel reports whether s is a valid DNS label: 1 to 63 letters, digits and
+// hyphens, not starting or ending with a hyphen.
+func isLabel(s string) bool {
+	if s == "" || len(s) > 63 || s[0] == '-' || s[len(s)-1] == '-' {
+		return false
+	}
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// isDomainName is like isHostname but also allows the underscores of service
+// records and a leading wildcard label.
+func isDomainName(s string) bool {
+	s = strings.TrimPrefix(s, "*.")
+	return isHostname(strings.ReplaceAll(s, "_", "a"))
+}
+
+func isEmail(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Address == s
+}
+
+func isPort(n int) bool {
+	return n >= 1 && n <= 65535
+}
+
+// isPortString reports whether s is a single port number.
+func isPortString(s string) bool {
+	n, err := strconv.Atoi(s)
+	return err == nil && isPort(n)
+}
+
+// isPortSpec reports whether s is a firewall port specification: a comma
+// separated list of ports, ranges written "low:high" or "low-high", and
+// service names such as "ssh".
+func isPortSpec(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if isServiceName(part) {
+			continue
+		}
+
+		low, high, isRange := strings.Cut(part, ":")
+		if !isRange {
+			low, high, isRange = strings.Cut(part, "-")
+		}
+		if !isRange {
+			high = low
+		}
+
+		l, errL := strconv.Atoi(low)
+		h, errH := strconv.Atoi(high)
+		if errL != nil || errH != nil || !isPort(l) || !isPort(h) || l > h {
+			return false
+		}
+	}
+	return true
+}
+
+func isServiceName(s string) bool {
+	if s == "" || s[0] < 'a' || s[0] > 'z' {
+		return false
+	}
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// isAddressSpec reports whether s is an IP address, a CIDR prefix or a range
+// written "first-last". Anything not starting like an address, such as an
+// ipset or alias name, is left for the API to resolve.
+func isAddressSpec(s string) bool {
+	if s == "" {
+		return false
+	}
+	if !strings.ContainsAny(s[:1], "0123456789:") {
+		return true
+	}
+
+	if first, last, ok := strings.Cut(s, "-"); ok {
+		a, errA := netip.ParseAddr(first)
+		b, errB := netip.ParseAddr(last)
+		return errA == nil && errB == nil && a.Is4() == b.Is4() && a.Compare(b) <= 0
+	}
+	if strings.Contains(s, "/") {
+		_, err := netip.ParsePrefix(s)
+		return err == nil
+	}
+	_, err := netip.ParseAddr(s)
+	return err == nil
+}
+
+// isOneOf reports whether s is one of values, ignoring case.
+func isOneOf(s string, values ...string) bool {
+	for _, v := range values {
+		if strings.EqualFold(s, v) {
+			return true
+		}
+	}
+	return false
+}
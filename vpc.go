@@ -3,14 +3,17 @@ package goVPSie
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
-	"time"
+	"net/netip"
+	"strconv"
 )
 
 var vpcPath = "/apps/v2"
 
 type VPCService interface {
 	List(ctx context.Context, options *ListOptions) ([]VPC, error)
+	All(ctx context.Context, opts ...IterOption) iter.Seq2[VPC, error]
 	Get(ctx context.Context, id string) (*VPC, error)
 	AssignServer(ctx context.Context, assignReq *AssignServerReq) error
 	MoveServer(ctx context.Context, assignReq *AssignServerReq) error
@@ -46,6 +49,14 @@ type AssignServerReq struct {
 	DcIdentifier string `json:"dcIdentifier"`
 }
 
+// Validate reports the invalid fields of r.
+func (r AssignServerReq) Validate() error {
+	var errs fieldErrors
+	errs.required("vmIdentifier", r.VmIdentifier)
+	errs.check(r.VpcID > 0, "vpcId", "is required")
+	return errs.err()
+}
+
 type CreateVpcReq struct {
 	Name         string `json:"name"`
 	Description  string `json:"description"`
@@ -55,6 +66,33 @@ type CreateVpcReq struct {
 	AutoGenerate int    `json:"autoGenerate"`
 }
 
+// Validate reports the invalid fields of r. Unless AutoGenerate is set, the
+// network is given as an IPv4 network address and a prefix length, such as
+// "10.10.0.0" and "24".
+func (r CreateVpcReq) Validate() error {
+	var errs fieldErrors
+	errs.required("name", r.Name)
+	errs.required("dcIdentifier", r.DcIdentifier)
+	errs.flag("autoGenerate", int64(r.AutoGenerate))
+	if r.AutoGenerate == 1 {
+		return errs.err()
+	}
+
+	addr, err := netip.ParseAddr(r.NetworkRange)
+	rangeOK := err == nil && addr.Is4()
+	errs.check(rangeOK, "networkRange", "must be an IPv4 network address, got %q", r.NetworkRange)
+
+	size, err := strconv.Atoi(r.NetworkSize)
+	sizeOK := err == nil && size >= 1 && size <= 32
+	errs.check(sizeOK, "networkSize", "must be a prefix length between 1 and 32, got %q", r.NetworkSize)
+
+	if rangeOK && sizeOK {
+		prefix := netip.PrefixFrom(addr, size)
+		errs.check(prefix.Masked().Addr() == addr, "networkRange", "%s is not the network address of %s", addr, prefix.Masked())
+	}
+	return errs.err()
+}
+
 type VPC struct {
 	ID               int       `json:"id"`
 	UserID           int       `json:"user_id"`
@@ -66,14 +104,14 @@ type VPC struct {
 	NetworkTagNumber int       `json:"network_tag_number"`
 	NetworkRange     string    `json:"network_range"`
 	NetworkSize      string    `json:"network_size"`
-	IsDefault        int       `json:"is_default"`
+	IsDefault        Flag      `json:"is_default"`
 	CreatedBy        int       `json:"created_by"`
 	UpdatedBy        int       `json:"updated_by"`
-	CreatedOn        time.Time `json:"created_on"`
-	LastUpdated      time.Time `json:"last_updated"`
+	CreatedOn        Timestamp `json:"created_on"`
+	LastUpdated      Timestamp `json:"last_updated"`
 	LowIPNum         int       `json:"low_ip_num"`
 	HightIPNum       int       `json:"hight_ip_num"`
-	IsUpcNetwork     int       `json:"is_upc_network"`
+	IsUpcNetwork     Flag      `json:"is_upc_network"`
 	Firstname        string    `json:"firstname"`
 	Lastname         string    `json:"lastname"`
 	Username         string    `json:"username"`
@@ -83,7 +121,10 @@ type VPC struct {
 }
 
 func (s *vpcServiceHandler) List(ctx context.Context, options *ListOptions) ([]VPC, error) {
-	path := fmt.Sprintf("%s/vpc", vpcPath)
+	path, err := addOptions(fmt.Sprintf("%s/vpc", vpcPath), options)
+	if err != nil {
+		return nil, err
+	}
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, err
@@ -94,9 +135,15 @@ func (s *vpcServiceHandler) List(ctx context.Context, options *ListOptions) ([]V
 		return nil, err
 	}
 
+	options.setMeta(root.Total, len(root.Data))
 	return root.Data, nil
 }
 
+// All iterates over every VPC, fetching pages lazily.
+func (s *vpcServiceHandler) All(ctx context.Context, opts ...IterOption) iter.Seq2[VPC, error] {
+	return paginate(ctx, s.List, opts)
+}
+
 func (s *vpcServiceHandler) Get(ctx context.Context, id string) (*VPC, error) {
 	path := fmt.Sprintf("%s/vpc/%s", vpcPath, id)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
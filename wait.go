@@ -0,0 +1,274 @@
+package goVPSie
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const defaultPollInterval = 5 * time.Second
+
+// WaitOptions configures the WaitFor methods. The zero value polls every five
+// seconds until ctx is done.
+type WaitOptions struct {
+	// PollInterval is the delay between the first polls. Defaults to five
+	// seconds.
+	PollInterval time.Duration
+
+	// Multiplier grows the delay after every poll, up to MaxInterval. Values
+	// of 1 or less keep polling at PollInterval.
+	Multiplier  float64
+	MaxInterval time.Duration
+
+	// Timeout bounds the whole wait. Zero waits until ctx is done.
+	Timeout time.Duration
+
+	// OnProgress, if set, is called after every poll.
+	OnProgress func(WaitProgress)
+}
+
+// WaitProgress reports one poll of a WaitFor method.
+type WaitProgress struct {
+	Attempt int
+	Elapsed time.Duration
+
+	// Status is the last server status seen by WaitForState.
+	Status *Status
+
+	// Pending is the pending creation seen by WaitForCreation, or nil once
+	// the creation has finished.
+	Pending *PendingVm
+
+	// Err is the transient error of this poll, if any. Server errors and rate
+	// limiting do not end the wait.
+	Err error
+}
+
+// WaitTimeoutError is returned when a WaitFor method runs out of time before
+// the resource reaches the expected state.
+type WaitTimeoutError struct {
+	// Identifier is the server identifier or creation process ID waited on,
+	// and Target the state that was expected.
+	Identifier string
+	Target     string
+
+	Elapsed  time.Duration
+	Attempts int
+
+	// LastStatus and LastPending hold the last observation, if any.
+	LastStatus  *Status
+	LastPending *PendingVm
+
+	// Err is the context error that ended the wait.
+	Err error
+}
+
+func (e *WaitTimeoutError) Error() string {
+	last := "nothing observed"
+	switch {
+	case e.LastStatus != nil:
+		last = fmt.Sprintf("last status %q", e.LastStatus.Status)
+	case e.LastPending != nil:
+		last = "still pending"
+	}
+
+	return fmt.Sprintf("timed out after %s waiting for %s to be %s: %s", e.Elapsed.Round(time.Millisecond), e.Identifier, e.Target, last)
+}
+
+func (e *WaitTimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// WaitForState polls the status of the server until it equals state, e.g.
+// "running" or "stopped". The comparison ignores case.
+func (v *serverServiceHandler) WaitForState(ctx context.Context, identifierId, state string, opts *WaitOptions) (*Status, error) {
+	var last *Status
+	err := poll(ctx, opts, func(ctx context.Context, progress *WaitProgress) (bool, error) {
+		status, err := v.GetServerStatusByIdentifier(ctx, identifierId)
+		if err != nil {
+			return false, err
+		}
+
+		last, progress.Status = status, status
+		return strings.EqualFold(status.Status, state), nil
+	}, func(timeout *WaitTimeoutError) {
+		timeout.Identifier, timeout.Target, timeout.LastStatus = identifierId, state, last
+	})
+
+	return last, err
+}
+
+// WaitForCreation waits for the server creation described by result, as
+// returned by Server.CreateServerWithResult or
+// Images.CreateServerByImageWithResult, and returns the new server.
+//
+// Polling goes on while the creation process of result is pending. Once it
+// is not, which may be at the first poll, the server is looked up by the
+// identifier of result or, lacking one, by hostname among the servers created
+// since the create call began. A server not found yet is polled for again,
+// unless its creation was seen pending before, which means it failed.
+func (v *serverServiceHandler) WaitForCreation(ctx context.Context, result *CreateResult, opts *WaitOptions) (*VmData, error) {
+	if result.ProcessID == "" && result.Identifier == "" {
+		return nil, errors.New("create result has neither an identifier nor a process ID")
+	}
+
+	// A result not made by a create method only knows servers created since
+	// the wait began.
+	since := result.started
+	if since.IsZero() {
+		since = time.Now()
+	}
+	since = since.Add(-defaultMaxClockSkew)
+	hostname := result.hostname
+
+	var last *PendingVm
+	var seen bool
+	var vm *VmData
+	err := poll(ctx, opts, func(ctx context.Context, progress *WaitProgress) (bool, error) {
+		last = nil
+		if result.ProcessID != "" {
+			pending, err := v.client.Pending.GetPendingVms(ctx)
+			if err != nil {
+				return false, err
+			}
+			for i := range pending {
+				if pending[i].Data.ProcessID == result.ProcessID {
+					last = &pending[i]
+					break
+				}
+			}
+		}
+
+		progress.Pending = last
+		if last != nil {
+			seen = true
+			hostname = cmp.Or(hostname, last.Data.Hostname)
+			return false, nil
+		}
+
+		// A failed lookup is polled again like a failed listing.
+		var err error
+		switch vm, err = v.createdServer(ctx, result.Identifier, hostname, since); {
+		case err != nil:
+			return false, err
+		case vm != nil:
+			return true, nil
+		case seen:
+			return false, fmt.Errorf("creation process %s finished without a server", result.ProcessID)
+		}
+		return false, nil
+	}, func(timeout *WaitTimeoutError) {
+		timeout.Identifier, timeout.Target, timeout.LastPending = cmp.Or(result.ProcessID, result.Identifier), "created", last
+	})
+	if err != nil {
+		return nil, err
+	}
+	return vm, nil
+}
+
+// createdServer returns the server with the given identifier or, without
+// one, the server named hostname created since the given time. It returns nil
+// if there is none yet.
+func (v *serverServiceHandler) createdServer(ctx context.Context, identifier, hostname string, since time.Time) (*VmData, error) {
+	if identifier != "" {
+		vm, err := v.GetServerByIdentifier(ctx, identifier)
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		return vm, err
+	}
+	if hostname == "" {
+		return nil, nil
+	}
+
+	for vm, err := range v.All(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		if vm.Hostname == hostname && createdSince(vm.CreatedOn, since) {
+			return &vm, nil
+		}
+	}
+	return nil, nil
+}
+
+// WaitFor polls check until it reports done, for resources without a WaitFor
+// method of their own. Errors of check end the wait unless they are server
+// errors or rate limiting. A timeout is reported as a *WaitTimeoutError for
+// identifier and target.
+func WaitFor(ctx context.Context, identifier, target string, opts *WaitOptions, check func(context.Context) (bool, error)) error {
+	return poll(ctx, opts, func(ctx context.Context, _ *WaitProgress) (bool, error) {
+		return check(ctx)
+	}, func(timeout *WaitTimeoutError) {
+		timeout.Identifier, timeout.Target = identifier, target
+	})
+}
+
+// poll calls check until it reports done, a non-transient error occurs or
+// the wait times out. describe fills in the resource details of a timeout.
+func poll(ctx context.Context, opts *WaitOptions, check func(context.Context, *WaitProgress) (bool, error), describe func(*WaitTimeoutError)) error {
+	var o WaitOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.PollInterval <= 0 {
+		o.PollInterval = defaultPollInterval
+	}
+
+	if o.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	interval := o.PollInterval
+	for attempt := 1; ; attempt++ {
+		progress := WaitProgress{Attempt: attempt}
+		done, err := check(ctx, &progress)
+		progress.Elapsed = time.Since(start)
+
+		if err != nil && ctx.Err() == nil && !transientWaitError(err) {
+			return err
+		}
+		progress.Err = err
+
+		if o.OnProgress != nil {
+			o.OnProgress(progress)
+		}
+		if done {
+			return nil
+		}
+
+		if ctx.Err() == nil {
+			err = sleepContext(ctx, interval)
+		} else {
+			err = ctx.Err()
+		}
+		if err != nil {
+			if !errors.Is(err, context.DeadlineExceeded) {
+				return err
+			}
+
+			timeout := &WaitTimeoutError{Elapsed: time.Since(start), Attempts: attempt, Err: err}
+			describe(timeout)
+			return timeout
+		}
+
+		if o.Multiplier > 1 {
+			interval = time.Duration(float64(interval) * o.Multiplier)
+			if o.MaxInterval > 0 && interval > o.MaxInterval {
+				interval = o.MaxInterval
+			}
+		}
+	}
+}
+
+// transientWaitError reports whether a failed poll should be retried by the
+// next one instead of ending the wait.
+func transientWaitError(err error) bool {
+	return IsServerError(err) || IsRateLimited(err)
+}